@@ -0,0 +1,5 @@
+# A product category can be imported by id.
+terraform import medusa_product_category.example pcat_01H8Y0T4BXQYS5BTR0AQ6X3S2Q
+
+# Or by its handle, when it is unique.
+terraform import medusa_product_category.example handle:shoes
@@ -0,0 +1,8 @@
+# The categories below a product category can be imported by the id of the
+# parent category. All the categories below it become part of the tree.
+terraform import medusa_product_category_tree.example pcat_01H8Y0T4BXQYS5BTR0AQ6X3S2Q
+
+# A tree at the root is imported with the handles of its root categories.
+# The categories below them become part of the tree, and the other root
+# categories are left untouched.
+terraform import medusa_product_category_tree.example root:shoes,bags
@@ -0,0 +1,25 @@
+resource "medusa_product_category_tree" "catalog" {
+  category {
+    name      = "Shoes"
+    handle    = "shoes"
+    is_active = true
+
+    children {
+      name      = "Boots"
+      handle    = "boots"
+      is_active = true
+    }
+
+    children {
+      name      = "Sneakers"
+      handle    = "sneakers"
+      is_active = true
+    }
+  }
+
+  category {
+    name        = "Returns"
+    handle      = "returns"
+    is_internal = true
+  }
+}
@@ -0,0 +1,5 @@
+# A product collection can be imported by id.
+terraform import medusa_product_collection.example pcol_01H8Y0T4BXQYS5BTR0AQ6X3S2Q
+
+# Or by its handle, when it is unique.
+terraform import medusa_product_collection.example handle:summer
@@ -0,0 +1,5 @@
+# A sales channel can be imported by id.
+terraform import medusa_sales_channel.example sc_01H8Y0T4BXQYS5BTR0AQ6X3S2Q
+
+# Or by its name, when it is unique.
+terraform import medusa_sales_channel.example name:Webshop
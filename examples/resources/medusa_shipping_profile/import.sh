@@ -0,0 +1,6 @@
+# A shipping profile can be imported by id.
+terraform import medusa_shipping_profile.example sp_01H8Y0T4BXQYS5BTR0AQ6X3S2Q
+
+# Or by its type, when it is unique. The default and gift card profiles are
+# imported as adopted.
+terraform import medusa_shipping_profile.example type:default
@@ -1,4 +1,12 @@
 resource "medusa_shipping_profile" "my-shipping-profile" {
   name = "my-profile"
-  type = "default"
+  type = "custom"
+}
+
+# Medusa creates the default and gift card profiles on install, and they
+# cannot be deleted, so they are adopted rather than created.
+resource "medusa_shipping_profile" "default" {
+  name           = "Standard"
+  type           = "default"
+  adopt_existing = true
 }
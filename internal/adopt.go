@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// adoptExistingAttribute returns the schema of the adopt_existing attribute
+// of the resources whose built-in object Medusa creates on install and does
+// not allow to delete. builtin names that object, and reset says what
+// destroying the resource does to it. The attribute only lives in the state.
+func adoptExistingAttribute(builtin, reset string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("If set to true, the resource takes over %s instead of creating a new one, "+
+			"and destroying it %s instead of deleting it. Defaults to false.", builtin, reset),
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// stateFlag returns the value to keep in the state for a flag that only
+// lives there, such as adopt_existing, which is false for imported objects
+// and states written before the flag.
+func stateFlag(flag types.Bool) types.Bool {
+	if flag.IsNull() || flag.IsUnknown() {
+		return types.BoolValue(false)
+	}
+	return flag
+}
+
+// adoptCandidate returns the id of the only built-in object in candidates,
+// or an error that lists them.
+func adoptCandidate(name string, candidates []importCandidate) (string, error) {
+	switch len(candidates) {
+	case 0:
+		return "", fmt.Errorf("could not find the built-in %s", name)
+	case 1:
+		return candidates[0].ID, nil
+	default:
+		var list strings.Builder
+		for _, c := range candidates {
+			fmt.Fprintf(&list, "\n  - %s (%s)", c.ID, c.Label)
+		}
+		return "", fmt.Errorf("found %d candidates for the built-in %s, import one of them by id instead:%s",
+			len(candidates), name, list.String())
+	}
+}
@@ -0,0 +1,126 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// cassetteRedactedFields are JSON object keys whose values are replaced
+// before an interaction is written to a cassette.
+var cassetteRedactedFields = append([]string{"email"}, redactedFields...)
+
+// matchCassetteRequest matches a request to a recorded interaction on the
+// method, the path, the query and the JSON body. Bodies are compared after
+// redaction and regardless of key order, so a login matches whatever
+// credentials are configured during replay.
+func matchCassetteRequest(r *http.Request, i cassette.Request) bool {
+	if r.Method != i.Method {
+		return false
+	}
+
+	recorded, err := url.Parse(i.URL)
+	if err != nil {
+		return false
+	}
+	if r.URL.Path != recorded.Path || !reflect.DeepEqual(r.URL.Query(), recorded.Query()) {
+		return false
+	}
+
+	body, err := readRequestBody(r)
+	if err != nil {
+		return false
+	}
+
+	return canonicalBody(string(body)) == canonicalBody(i.Body)
+}
+
+// redactInteraction scrubs credentials and access tokens from the request
+// and response bodies of an interaction.
+func redactInteraction(i *cassette.Interaction) error {
+	i.Request.Body = canonicalBody(i.Request.Body)
+	i.Request.ContentLength = int64(len(i.Request.Body))
+	i.Response.Body = canonicalBody(i.Response.Body)
+	i.Response.ContentLength = int64(len(i.Response.Body))
+	return nil
+}
+
+// canonicalBody redacts a JSON body and encodes it with sorted keys. Other
+// bodies are returned unchanged.
+func canonicalBody(body string) string {
+	var value any
+	if err := json.Unmarshal([]byte(body), &value); err != nil {
+		return body
+	}
+
+	b, err := json.Marshal(redactJSON(value, cassetteRedactedFields))
+	if err != nil {
+		return body
+	}
+	return string(b)
+}
+
+// cassetteSecrets returns a description of every secret left in the
+// cassette: redacted headers and JSON fields that still have a value, and
+// any of the given values appearing in a body or URL.
+func cassetteSecrets(c *cassette.Cassette, values ...string) []string {
+	var secrets []string
+
+	for n, i := range c.Interactions {
+		for _, header := range redactedHeaders {
+			if i.Request.Headers.Get(header) != "" || i.Response.Headers.Get(header) != "" {
+				secrets = append(secrets, fmt.Sprintf("interaction %d: %s header", n, header))
+			}
+		}
+
+		for _, body := range []string{i.Request.Body, i.Response.Body} {
+			var value any
+			if json.Unmarshal([]byte(body), &value) == nil {
+				for _, field := range unredactedFields(value) {
+					secrets = append(secrets, fmt.Sprintf("interaction %d: %s field", n, field))
+				}
+			}
+		}
+
+		for _, value := range values {
+			if value == "" {
+				continue
+			}
+			if strings.Contains(i.Request.URL, value) || strings.Contains(i.Request.Body, value) || strings.Contains(i.Response.Body, value) {
+				secrets = append(secrets, fmt.Sprintf("interaction %d: configured credential", n))
+			}
+		}
+	}
+
+	return secrets
+}
+
+// unredactedFields returns the redacted JSON object keys of a decoded value
+// that hold anything but the redaction marker.
+func unredactedFields(value any) []string {
+	var fields []string
+
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if isRedactedField(key, cassetteRedactedFields) {
+				if item != redacted {
+					fields = append(fields, key)
+				}
+				continue
+			}
+			fields = append(fields, unredactedFields(item)...)
+		}
+	case []any:
+		for _, item := range v {
+			fields = append(fields, unredactedFields(item)...)
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
+)
+
+// TestCassettesHaveNoSecrets fails when a committed cassette still holds
+// credentials or access tokens.
+func TestCassettesHaveNoSecrets(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "cassettes", "*.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range files {
+		checkCassetteSecrets(t, file)
+	}
+}
+
+func checkCassetteSecrets(t *testing.T, file string) {
+	t.Helper()
+
+	c, err := cassette.Load(strings.TrimSuffix(file, ".yaml"))
+	if err != nil {
+		t.Errorf("unable to load cassette %s: %s", file, err)
+		return
+	}
+
+	creds := testAccCredentials()
+	for _, secret := range cassetteSecrets(c, creds.Password) {
+		t.Errorf("cassette %s contains a secret: %s", file, secret)
+	}
+}
+
+func TestMatchCassetteRequest(t *testing.T) {
+	recorded := cassette.Request{
+		Method: http.MethodPost,
+		URL:    "http://localhost:9000/admin/regions/reg_1?expand=countries&fields=id",
+		Body:   `{"name":"EU","tax_rate":21}`,
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		body   string
+		match  bool
+	}{
+		{"same request", http.MethodPost, "http://localhost:9000/admin/regions/reg_1?expand=countries&fields=id", `{"name":"EU","tax_rate":21}`, true},
+		{"key order and host", http.MethodPost, "http://medusa:9000/admin/regions/reg_1?fields=id&expand=countries", `{"tax_rate":21, "name":"EU"}`, true},
+		{"different body", http.MethodPost, "http://localhost:9000/admin/regions/reg_1?expand=countries&fields=id", `{"name":"EU","tax_rate":9}`, false},
+		{"different method", http.MethodGet, "http://localhost:9000/admin/regions/reg_1?expand=countries&fields=id", `{"name":"EU","tax_rate":21}`, false},
+		{"different path", http.MethodPost, "http://localhost:9000/admin/regions/reg_2?expand=countries&fields=id", `{"name":"EU","tax_rate":21}`, false},
+		{"different query", http.MethodPost, "http://localhost:9000/admin/regions/reg_1?expand=countries", `{"name":"EU","tax_rate":21}`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := http.NewRequest(tt.method, tt.url, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := matchCassetteRequest(r, recorded); got != tt.match {
+				t.Errorf("expected match %v, got %v", tt.match, got)
+			}
+
+			// The body must stay readable for the next interaction.
+			body, err := readRequestBody(r)
+			if err != nil || string(body) != tt.body {
+				t.Errorf("expected the body to be kept, got %q (%v)", body, err)
+			}
+		})
+	}
+}
+
+func TestRedactInteraction(t *testing.T) {
+	i := &cassette.Interaction{
+		Request: cassette.Request{
+			Method: http.MethodPost,
+			URL:    "http://localhost:9000/admin/auth/token",
+			Body:   `{"email":"admin@example.com","password":"secret"}`,
+		},
+		Response: cassette.Response{
+			Body: `{"access_token":"eyJhbGciOi"}`,
+		},
+	}
+
+	c := &cassette.Cassette{Interactions: []*cassette.Interaction{i}}
+	if secrets := cassetteSecrets(c, "secret"); len(secrets) != 4 {
+		t.Errorf("expected the email, password, token and credential to be found, got %v", secrets)
+	}
+
+	if err := redactInteraction(i); err != nil {
+		t.Fatal(err)
+	}
+
+	if i.Request.Body != `{"email":"[REDACTED]","password":"[REDACTED]"}` {
+		t.Errorf("unexpected request body %s", i.Request.Body)
+	}
+	if i.Response.Body != `{"access_token":"[REDACTED]"}` {
+		t.Errorf("unexpected response body %s", i.Response.Body)
+	}
+	if secrets := cassetteSecrets(c, "secret"); len(secrets) != 0 {
+		t.Errorf("expected no secrets after redaction, got %v", secrets)
+	}
+
+	login, err := http.NewRequest(http.MethodPost, "http://localhost:9000/admin/auth/token",
+		strings.NewReader(`{"password":"other","email":"someone@example.com"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !matchCassetteRequest(login, i.Request) {
+		t.Error("expected a login with other credentials to match the redacted interaction")
+	}
+}
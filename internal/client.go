@@ -0,0 +1,197 @@
+package internal
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+
+	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+
+	basetypes "github.com/oapi-codegen/runtime/types"
+)
+
+// clientFactory creates authenticated Medusa API clients. It is kept apart from
+// the provider so that client creation can be exercised without Terraform.
+type clientFactory struct {
+	httpClient *http.Client
+}
+
+// clientCredentials holds the values needed to log in to the admin API.
+type clientCredentials struct {
+	URL      string
+	Email    string
+	Password string
+}
+
+// New logs in to the admin API and returns a client that sends the obtained
+// bearer token with every request. It stops at the first failure and returns a diagnostic describing
+// what went wrong.
+func (f *clientFactory) New(ctx context.Context, creds clientCredentials) (utils.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if _, err := url.ParseRequestURI(creds.URL); err != nil {
+		diags.AddError(
+			"Invalid Medusa API URL",
+			fmt.Sprintf("The URL %q is not a valid absolute URL: %s", creds.URL, err.Error()),
+		)
+		return nil, diags
+	}
+
+	client, err := medusa.NewClientWithResponses(creds.URL, medusa.WithHTTPClient(f.httpClient))
+	if err != nil {
+		diags.AddError("Unable to Create Medusa API Client", err.Error())
+		return nil, diags
+	}
+
+	token, d := f.login(ctx, client, creds)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tokenProvider, err := securityprovider.NewSecurityProviderBearerToken(token)
+	if err != nil {
+		diags.AddError("Unable to Create Medusa API Client", err.Error())
+		return nil, diags
+	}
+
+	client, err = medusa.NewClientWithResponses(
+		creds.URL,
+		medusa.WithHTTPClient(f.httpClient),
+		medusa.WithRequestEditorFn(tokenProvider.Intercept),
+	)
+	if err != nil {
+		diags.AddError(
+			"Unable to Create Medusa API Client",
+			"An unexpected error occurred when creating the Medusa API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"Medusa Error: "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	return utils.NewClient(client, creds.URL, nil), diags
+}
+
+// login exchanges the admin credentials for an access token.
+func (f *clientFactory) login(ctx context.Context, client *medusa.ClientWithResponses, creds clientCredentials) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "Logging in to Medusa API")
+
+	resp, err := client.PostTokenWithResponse(ctx, medusa.PostTokenJSONRequestBody{
+		Email:    basetypes.Email(creds.Email),
+		Password: creds.Password,
+	})
+	if err != nil {
+		diags.Append(requestErrorDiagnostic(creds.URL, err))
+		return "", diags
+	}
+
+	if resp.StatusCode() == http.StatusUnauthorized {
+		diags.AddError(
+			"Invalid Medusa Credentials",
+			"The Medusa API rejected the configured email and password (401 Unauthorized). "+
+				"Check the admin user credentials.",
+		)
+		return "", diags
+	}
+
+	if !isJSONResponse(resp.HTTPResponse) {
+		diags.AddError(
+			"Unexpected Response from Medusa API",
+			fmt.Sprintf("The endpoint %s did not answer with JSON (status %s, content type %q). "+
+				"Make sure the URL points to the Medusa server and not to the admin dashboard or a proxy page.",
+				creds.URL, resp.Status(), resp.HTTPResponse.Header.Get("Content-Type")),
+		)
+		return "", diags
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		diags.AddError(
+			"Unable to Login to Medusa API",
+			fmt.Sprintf("Login failed with status %s: %s", resp.Status(), string(resp.Body)),
+		)
+		return "", diags
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.AccessToken == nil || *resp.JSON200.AccessToken == "" {
+		diags.AddError(
+			"Unexpected Response from Medusa API",
+			fmt.Sprintf("The endpoint %s answered the login request without an access token. "+
+				"Make sure the URL points to a Medusa admin API.", creds.URL),
+		)
+		return "", diags
+	}
+
+	return *resp.JSON200.AccessToken, diags
+}
+
+// requestErrorDiagnostic turns a transport level error into a diagnostic that
+// names the kind of failure.
+func requestErrorDiagnostic(endpoint string, err error) diag.Diagnostic {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return diag.NewErrorDiagnostic(
+			"Unable to Resolve Medusa API Host",
+			fmt.Sprintf("The host %q of %s could not be resolved: %s", dnsErr.Name, endpoint, err.Error()),
+		)
+	}
+
+	if isTLSError(err) {
+		return diag.NewErrorDiagnostic(
+			"TLS Error Connecting to Medusa API",
+			fmt.Sprintf("The TLS handshake with %s failed: %s", endpoint, err.Error()),
+		)
+	}
+
+	var opErr *net.OpError
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.As(err, &opErr) {
+		return diag.NewErrorDiagnostic(
+			"Unable to Connect to Medusa API",
+			fmt.Sprintf("Could not connect to %s: %s", endpoint, err.Error()),
+		)
+	}
+
+	return diag.NewErrorDiagnostic(
+		"Unable to Login to Medusa API",
+		fmt.Sprintf("The login request to %s failed: %s", endpoint, err.Error()),
+	)
+}
+
+func isTLSError(err error) bool {
+	var (
+		recordErr   tls.RecordHeaderError
+		verifyErr   *tls.CertificateVerificationError
+		unknownCA   x509.UnknownAuthorityError
+		hostnameErr x509.HostnameError
+		invalidCert x509.CertificateInvalidError
+		alertErr    tls.AlertError
+	)
+
+	return errors.As(err, &recordErr) ||
+		errors.As(err, &verifyErr) ||
+		errors.As(err, &unknownCA) ||
+		errors.As(err, &hostnameErr) ||
+		errors.As(err, &invalidCert) ||
+		errors.As(err, &alertErr)
+}
+
+func isJSONResponse(r *http.Response) bool {
+	if r == nil {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == "application/json"
+}
@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClientFactoryNew(t *testing.T) {
+	// respond answers the login request.
+	respond := func(status int, contentType, body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/admin/auth/token" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", contentType)
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(body))
+		}
+	}
+
+	// refused is the URL of a port nothing listens on anymore.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	refused := "http://" + listener.Addr().String()
+	listener.Close()
+
+	tests := []struct {
+		name    string
+		url     string
+		handler http.HandlerFunc
+		tls     bool
+		want    string
+	}{
+		{
+			name:    "logged in",
+			handler: respond(http.StatusOK, "application/json", `{"access_token":"token"}`),
+		},
+		{
+			name: "invalid url",
+			url:  "medusa.example.com",
+			want: "Invalid Medusa API URL",
+		},
+		{
+			name: "dns failure",
+			url:  "http://medusa.invalid",
+			want: "Unable to Resolve Medusa API Host",
+		},
+		{
+			name:    "tls failure",
+			handler: respond(http.StatusOK, "application/json", `{"access_token":"token"}`),
+			tls:     true,
+			want:    "TLS Error Connecting to Medusa API",
+		},
+		{
+			name: "connection refused",
+			url:  refused,
+			want: "Unable to Connect to Medusa API",
+		},
+		{
+			name:    "unauthorized",
+			handler: respond(http.StatusUnauthorized, "application/json", `{"message":"Unauthorized"}`),
+			want:    "Invalid Medusa Credentials",
+		},
+		{
+			name:    "not json",
+			handler: respond(http.StatusOK, "text/html", `<html>Medusa Admin</html>`),
+			want:    "Unexpected Response from Medusa API",
+		},
+		{
+			name:    "server error",
+			handler: respond(http.StatusInternalServerError, "application/json", `{"message":"boom"}`),
+			want:    "Unable to Login to Medusa API",
+		},
+		{
+			name:    "no token",
+			handler: respond(http.StatusOK, "application/json", `{}`),
+			want:    "Unexpected Response from Medusa API",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := tt.url
+			if tt.handler != nil {
+				newServer := httptest.NewServer
+				if tt.tls {
+					// The client does not trust the certificate of the
+					// server.
+					newServer = httptest.NewTLSServer
+				}
+				server := newServer(tt.handler)
+				defer server.Close()
+				url = server.URL
+			}
+
+			f := &clientFactory{httpClient: &http.Client{}}
+			client, diags := f.New(context.Background(), clientCredentials{
+				URL:      url,
+				Email:    "admin@example.com",
+				Password: "secret",
+			})
+
+			if tt.want == "" {
+				if diags.HasError() || client == nil {
+					t.Fatalf("expected a client, got %v", diags)
+				}
+				return
+			}
+			if client != nil || len(diags) != 1 || diags[0].Summary() != tt.want {
+				t.Errorf("expected a single %q error, got %v", tt.want, diags)
+			}
+		})
+	}
+}
+
+func TestRequestErrorDiagnostic(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "dns",
+			err:  &net.DNSError{Name: "medusa.invalid", Err: "no such host", IsNotFound: true},
+			want: "Unable to Resolve Medusa API Host",
+		},
+		{
+			name: "connection",
+			err:  &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection reset")},
+			want: "Unable to Connect to Medusa API",
+		},
+		{
+			name: "other",
+			err:  errors.New("stopped after 10 redirects"),
+			want: "Unable to Login to Medusa API",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := requestErrorDiagnostic("http://localhost:9000", tt.err)
+			if d.Summary() != tt.want || !strings.Contains(d.Detail(), tt.err.Error()) {
+				t.Errorf("expected %q naming the error, got %q: %q", tt.want, d.Summary(), d.Detail())
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+// Package clientfake provides FakeClient, a generated fake of utils.Client
+// for unit tests of the resources. Every method calls the function in the
+// matching field, so a test only stubs the calls it expects:
+//
+//	fake := &clientfake.FakeClient{
+//		PostRegionsWithResponseFunc: func(ctx context.Context, body medusa.PostRegionsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsResponse, error) {
+//			...
+//		},
+//	}
+//
+// Calls to methods without a stub return ErrNotStubbed, or panic when the
+// method cannot return an error. Run go generate after changing utils.Client
+// or updating the SDK.
+package clientfake
+
+//go:generate go run generate.go
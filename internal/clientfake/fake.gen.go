@@ -0,0 +1,3371 @@
+// Code generated by generate.go; DO NOT EDIT.
+
+package clientfake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+)
+
+var _ utils.Client = &FakeClient{}
+
+// ErrNotStubbed is returned by methods called without a stub.
+var ErrNotStubbed = errors.New("clientfake: method is not stubbed")
+
+// FakeClient implements utils.Client with a stub function per method.
+type FakeClient struct {
+	mu    sync.Mutex
+	calls []string
+
+	BaseURLFunc                                                                        func() string
+	DeleteAuthWithResponseFunc                                                         func(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteAuthResponse, error)
+	DeleteCollectionsCollectionWithResponseFunc                                        func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteCollectionsCollectionResponse, error)
+	DeleteCustomerGroupsCustomerGroupWithResponseFunc                                  func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteCustomerGroupsCustomerGroupResponse, error)
+	DeleteCustomerGroupsGroupCustomerBatchWithBodyWithResponseFunc                     func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteCustomerGroupsGroupCustomerBatchResponse, error)
+	DeleteCustomerGroupsGroupCustomerBatchWithResponseFunc                             func(ctx context.Context, id string, body medusa.DeleteCustomerGroupsGroupCustomerBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteCustomerGroupsGroupCustomerBatchResponse, error)
+	DeleteDiscountsDiscountConditionsConditionBatchWithBodyWithResponseFunc            func(ctx context.Context, discountId string, conditionId string, params *medusa.DeleteDiscountsDiscountConditionsConditionBatchParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDiscountsDiscountConditionsConditionBatchResponse, error)
+	DeleteDiscountsDiscountConditionsConditionBatchWithResponseFunc                    func(ctx context.Context, discountId string, conditionId string, params *medusa.DeleteDiscountsDiscountConditionsConditionBatchParams, body medusa.DeleteDiscountsDiscountConditionsConditionBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDiscountsDiscountConditionsConditionBatchResponse, error)
+	DeleteDiscountsDiscountConditionsConditionWithResponseFunc                         func(ctx context.Context, discountId string, conditionId string, params *medusa.DeleteDiscountsDiscountConditionsConditionParams, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDiscountsDiscountConditionsConditionResponse, error)
+	DeleteDiscountsDiscountDynamicCodesCodeWithResponseFunc                            func(ctx context.Context, id string, code string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDiscountsDiscountDynamicCodesCodeResponse, error)
+	DeleteDiscountsDiscountRegionsRegionWithResponseFunc                               func(ctx context.Context, id string, regionId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDiscountsDiscountRegionsRegionResponse, error)
+	DeleteDiscountsDiscountWithResponseFunc                                            func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDiscountsDiscountResponse, error)
+	DeleteDraftOrdersDraftOrderLineItemsItemWithResponseFunc                           func(ctx context.Context, id string, lineId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDraftOrdersDraftOrderLineItemsItemResponse, error)
+	DeleteDraftOrdersDraftOrderWithResponseFunc                                        func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDraftOrdersDraftOrderResponse, error)
+	DeleteGiftCardsGiftCardWithResponseFunc                                            func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteGiftCardsGiftCardResponse, error)
+	DeleteInventoryItemsInventoryIteLocationLevelsLocationWithResponseFunc             func(ctx context.Context, id string, locationId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteInventoryItemsInventoryIteLocationLevelsLocationResponse, error)
+	DeleteInventoryItemsInventoryItemWithResponseFunc                                  func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteInventoryItemsInventoryItemResponse, error)
+	DeleteInvitesInviteWithResponseFunc                                                func(ctx context.Context, inviteId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteInvitesInviteResponse, error)
+	DeleteNotesNoteWithResponseFunc                                                    func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteNotesNoteResponse, error)
+	DeleteOrderEditsOrderEditItemChangeWithResponseFunc                                func(ctx context.Context, id string, changeId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteOrderEditsOrderEditItemChangeResponse, error)
+	DeleteOrderEditsOrderEditLineItemsLineItemWithResponseFunc                         func(ctx context.Context, id string, itemId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteOrderEditsOrderEditLineItemsLineItemResponse, error)
+	DeleteOrderEditsOrderEditWithResponseFunc                                          func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteOrderEditsOrderEditResponse, error)
+	DeletePaymentCollectionsPaymentCollectionWithResponseFunc                          func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePaymentCollectionsPaymentCollectionResponse, error)
+	DeletePriceListsPriceListPricesBatchWithBodyWithResponseFunc                       func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePriceListsPriceListPricesBatchResponse, error)
+	DeletePriceListsPriceListPricesBatchWithResponseFunc                               func(ctx context.Context, id string, body medusa.DeletePriceListsPriceListPricesBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePriceListsPriceListPricesBatchResponse, error)
+	DeletePriceListsPriceListProductsPricesBatchWithResponseFunc                       func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePriceListsPriceListProductsPricesBatchResponse, error)
+	DeletePriceListsPriceListProductsProductPricesWithResponseFunc                     func(ctx context.Context, id string, productId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePriceListsPriceListProductsProductPricesResponse, error)
+	DeletePriceListsPriceListVariantsVariantPricesWithResponseFunc                     func(ctx context.Context, id string, variantId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePriceListsPriceListVariantsVariantPricesResponse, error)
+	DeletePriceListsPriceListWithResponseFunc                                          func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePriceListsPriceListResponse, error)
+	DeleteProductCategoriesCategoryProductsBatchWithBodyWithResponseFunc               func(ctx context.Context, id string, params *medusa.DeleteProductCategoriesCategoryProductsBatchParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductCategoriesCategoryProductsBatchResponse, error)
+	DeleteProductCategoriesCategoryProductsBatchWithResponseFunc                       func(ctx context.Context, id string, params *medusa.DeleteProductCategoriesCategoryProductsBatchParams, body medusa.DeleteProductCategoriesCategoryProductsBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductCategoriesCategoryProductsBatchResponse, error)
+	DeleteProductCategoriesCategoryWithResponseFunc                                    func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductCategoriesCategoryResponse, error)
+	DeleteProductsFromCollectionWithBodyWithResponseFunc                               func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductsFromCollectionResponse, error)
+	DeleteProductsFromCollectionWithResponseFunc                                       func(ctx context.Context, id string, body medusa.DeleteProductsFromCollectionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductsFromCollectionResponse, error)
+	DeleteProductsProductOptionsOptionWithResponseFunc                                 func(ctx context.Context, id string, optionId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductsProductOptionsOptionResponse, error)
+	DeleteProductsProductVariantsVariantWithResponseFunc                               func(ctx context.Context, id string, variantId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductsProductVariantsVariantResponse, error)
+	DeleteProductsProductWithResponseFunc                                              func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductsProductResponse, error)
+	DeletePublishableApiKeySalesChannelsChannelsBatchWithBodyWithResponseFunc          func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePublishableApiKeySalesChannelsChannelsBatchResponse, error)
+	DeletePublishableApiKeySalesChannelsChannelsBatchWithResponseFunc                  func(ctx context.Context, id string, body medusa.DeletePublishableApiKeySalesChannelsChannelsBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePublishableApiKeySalesChannelsChannelsBatchResponse, error)
+	DeletePublishableApiKeysPublishableApiKeyWithResponseFunc                          func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePublishableApiKeysPublishableApiKeyResponse, error)
+	DeleteRegionsRegionWithResponseFunc                                                func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteRegionsRegionResponse, error)
+	DeleteReservationsReservationWithResponseFunc                                      func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteReservationsReservationResponse, error)
+	DeleteReturnReasonWithResponseFunc                                                 func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteReturnReasonResponse, error)
+	DeleteSalesChannelsChannelProductsBatchWithBodyWithResponseFunc                    func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteSalesChannelsChannelProductsBatchResponse, error)
+	DeleteSalesChannelsChannelProductsBatchWithResponseFunc                            func(ctx context.Context, id string, body medusa.DeleteSalesChannelsChannelProductsBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteSalesChannelsChannelProductsBatchResponse, error)
+	DeleteSalesChannelsSalesChannelStockLocationWithBodyWithResponseFunc               func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteSalesChannelsSalesChannelStockLocationResponse, error)
+	DeleteSalesChannelsSalesChannelStockLocationWithResponseFunc                       func(ctx context.Context, id string, body medusa.DeleteSalesChannelsSalesChannelStockLocationJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteSalesChannelsSalesChannelStockLocationResponse, error)
+	DeleteSalesChannelsSalesChannelWithResponseFunc                                    func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteSalesChannelsSalesChannelResponse, error)
+	DeleteShippingOptionsOptionWithResponseFunc                                        func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteShippingOptionsOptionResponse, error)
+	DeleteShippingProfilesProfileWithResponseFunc                                      func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteShippingProfilesProfileResponse, error)
+	DeleteStockLocationsStockLocationWithResponseFunc                                  func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteStockLocationsStockLocationResponse, error)
+	DeleteStoreCurrenciesCodeWithResponseFunc                                          func(ctx context.Context, code string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteStoreCurrenciesCodeResponse, error)
+	DeleteTaxRatesTaxRateProductTypesWithBodyWithResponseFunc                          func(ctx context.Context, id string, params *medusa.DeleteTaxRatesTaxRateProductTypesParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteTaxRatesTaxRateProductTypesResponse, error)
+	DeleteTaxRatesTaxRateProductTypesWithResponseFunc                                  func(ctx context.Context, id string, params *medusa.DeleteTaxRatesTaxRateProductTypesParams, body medusa.DeleteTaxRatesTaxRateProductTypesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteTaxRatesTaxRateProductTypesResponse, error)
+	DeleteTaxRatesTaxRateProductsWithBodyWithResponseFunc                              func(ctx context.Context, id string, params *medusa.DeleteTaxRatesTaxRateProductsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteTaxRatesTaxRateProductsResponse, error)
+	DeleteTaxRatesTaxRateProductsWithResponseFunc                                      func(ctx context.Context, id string, params *medusa.DeleteTaxRatesTaxRateProductsParams, body medusa.DeleteTaxRatesTaxRateProductsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteTaxRatesTaxRateProductsResponse, error)
+	DeleteTaxRatesTaxRateShippingOptionsWithBodyWithResponseFunc                       func(ctx context.Context, id string, params *medusa.DeleteTaxRatesTaxRateShippingOptionsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteTaxRatesTaxRateShippingOptionsResponse, error)
+	DeleteTaxRatesTaxRateShippingOptionsWithResponseFunc                               func(ctx context.Context, id string, params *medusa.DeleteTaxRatesTaxRateShippingOptionsParams, body medusa.DeleteTaxRatesTaxRateShippingOptionsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteTaxRatesTaxRateShippingOptionsResponse, error)
+	DeleteTaxRatesTaxRateWithResponseFunc                                              func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteTaxRatesTaxRateResponse, error)
+	DeleteUploadsWithBodyWithResponseFunc                                              func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteUploadsResponse, error)
+	DeleteUploadsWithResponseFunc                                                      func(ctx context.Context, body medusa.DeleteUploadsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteUploadsResponse, error)
+	DeleteUsersUserWithResponseFunc                                                    func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteUsersUserResponse, error)
+	FeatureFlagsFunc                                                                   func(ctx context.Context) (map[string]bool, error)
+	GetAppsWithResponseFunc                                                            func(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetAppsResponse, error)
+	GetAuthWithResponseFunc                                                            func(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetAuthResponse, error)
+	GetBatchJobsBatchJobWithResponseFunc                                               func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetBatchJobsBatchJobResponse, error)
+	GetBatchJobsWithResponseFunc                                                       func(ctx context.Context, params *medusa.GetBatchJobsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetBatchJobsResponse, error)
+	GetCollectionsCollectionWithResponseFunc                                           func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCollectionsCollectionResponse, error)
+	GetCollectionsWithResponseFunc                                                     func(ctx context.Context, params *medusa.GetCollectionsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCollectionsResponse, error)
+	GetCurrenciesWithResponseFunc                                                      func(ctx context.Context, params *medusa.GetCurrenciesParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCurrenciesResponse, error)
+	GetCustomerGroupsGroupCustomersWithResponseFunc                                    func(ctx context.Context, id string, params *medusa.GetCustomerGroupsGroupCustomersParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCustomerGroupsGroupCustomersResponse, error)
+	GetCustomerGroupsGroupWithResponseFunc                                             func(ctx context.Context, id string, params *medusa.GetCustomerGroupsGroupParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCustomerGroupsGroupResponse, error)
+	GetCustomerGroupsWithResponseFunc                                                  func(ctx context.Context, params *medusa.GetCustomerGroupsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCustomerGroupsResponse, error)
+	GetCustomersCustomerWithResponseFunc                                               func(ctx context.Context, id string, params *medusa.GetCustomersCustomerParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCustomersCustomerResponse, error)
+	GetCustomersWithResponseFunc                                                       func(ctx context.Context, params *medusa.GetCustomersParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCustomersResponse, error)
+	GetDiscountsDiscountCodeWithResponseFunc                                           func(ctx context.Context, code string, params *medusa.GetDiscountsDiscountCodeParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetDiscountsDiscountCodeResponse, error)
+	GetDiscountsDiscountConditionsConditionWithResponseFunc                            func(ctx context.Context, discountId string, conditionId string, params *medusa.GetDiscountsDiscountConditionsConditionParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetDiscountsDiscountConditionsConditionResponse, error)
+	GetDiscountsDiscountWithResponseFunc                                               func(ctx context.Context, id string, params *medusa.GetDiscountsDiscountParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetDiscountsDiscountResponse, error)
+	GetDiscountsWithResponseFunc                                                       func(ctx context.Context, params *medusa.GetDiscountsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetDiscountsResponse, error)
+	GetDraftOrdersDraftOrderWithResponseFunc                                           func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetDraftOrdersDraftOrderResponse, error)
+	GetDraftOrdersWithResponseFunc                                                     func(ctx context.Context, params *medusa.GetDraftOrdersParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetDraftOrdersResponse, error)
+	GetGiftCardsGiftCardWithResponseFunc                                               func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetGiftCardsGiftCardResponse, error)
+	GetGiftCardsWithResponseFunc                                                       func(ctx context.Context, params *medusa.GetGiftCardsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetGiftCardsResponse, error)
+	GetInventoryItemsInventoryItemLocationLevelsWithResponseFunc                       func(ctx context.Context, id string, params *medusa.GetInventoryItemsInventoryItemLocationLevelsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetInventoryItemsInventoryItemLocationLevelsResponse, error)
+	GetInventoryItemsInventoryItemWithResponseFunc                                     func(ctx context.Context, id string, params *medusa.GetInventoryItemsInventoryItemParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetInventoryItemsInventoryItemResponse, error)
+	GetInventoryItemsWithResponseFunc                                                  func(ctx context.Context, params *medusa.GetInventoryItemsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetInventoryItemsResponse, error)
+	GetInvitesWithResponseFunc                                                         func(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetInvitesResponse, error)
+	GetNotesNoteWithResponseFunc                                                       func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetNotesNoteResponse, error)
+	GetNotesWithResponseFunc                                                           func(ctx context.Context, params *medusa.GetNotesParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetNotesResponse, error)
+	GetNotificationsWithResponseFunc                                                   func(ctx context.Context, params *medusa.GetNotificationsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetNotificationsResponse, error)
+	GetOrderEditsOrderEditWithResponseFunc                                             func(ctx context.Context, id string, params *medusa.GetOrderEditsOrderEditParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetOrderEditsOrderEditResponse, error)
+	GetOrderEditsWithResponseFunc                                                      func(ctx context.Context, params *medusa.GetOrderEditsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetOrderEditsResponse, error)
+	GetOrdersOrderReservationsWithResponseFunc                                         func(ctx context.Context, id string, params *medusa.GetOrdersOrderReservationsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetOrdersOrderReservationsResponse, error)
+	GetOrdersOrderWithResponseFunc                                                     func(ctx context.Context, id string, params *medusa.GetOrdersOrderParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetOrdersOrderResponse, error)
+	GetOrdersWithResponseFunc                                                          func(ctx context.Context, params *medusa.GetOrdersParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetOrdersResponse, error)
+	GetPaymentCollectionsPaymentCollectionWithResponseFunc                             func(ctx context.Context, id string, params *medusa.GetPaymentCollectionsPaymentCollectionParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPaymentCollectionsPaymentCollectionResponse, error)
+	GetPaymentsPaymentWithResponseFunc                                                 func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPaymentsPaymentResponse, error)
+	GetPriceListsPriceListProductsWithResponseFunc                                     func(ctx context.Context, id string, params *medusa.GetPriceListsPriceListProductsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPriceListsPriceListProductsResponse, error)
+	GetPriceListsPriceListWithResponseFunc                                             func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPriceListsPriceListResponse, error)
+	GetPriceListsWithResponseFunc                                                      func(ctx context.Context, params *medusa.GetPriceListsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPriceListsResponse, error)
+	GetProductCategoriesCategoryWithResponseFunc                                       func(ctx context.Context, id string, params *medusa.GetProductCategoriesCategoryParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductCategoriesCategoryResponse, error)
+	GetProductCategoriesWithResponseFunc                                               func(ctx context.Context, params *medusa.GetProductCategoriesParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductCategoriesResponse, error)
+	GetProductTagsWithResponseFunc                                                     func(ctx context.Context, params *medusa.GetProductTagsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductTagsResponse, error)
+	GetProductTypesWithResponseFunc                                                    func(ctx context.Context, params *medusa.GetProductTypesParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductTypesResponse, error)
+	GetProductsProductVariantsWithResponseFunc                                         func(ctx context.Context, id string, params *medusa.GetProductsProductVariantsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductsProductVariantsResponse, error)
+	GetProductsProductWithResponseFunc                                                 func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductsProductResponse, error)
+	GetProductsTagUsageWithResponseFunc                                                func(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductsTagUsageResponse, error)
+	GetProductsTypesWithResponseFunc                                                   func(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductsTypesResponse, error)
+	GetProductsWithResponseFunc                                                        func(ctx context.Context, params *medusa.GetProductsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductsResponse, error)
+	GetPublishableApiKeySalesChannelsWithResponseFunc                                  func(ctx context.Context, id string, params *medusa.GetPublishableApiKeySalesChannelsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPublishableApiKeySalesChannelsResponse, error)
+	GetPublishableApiKeysPublishableApiKeyWithResponseFunc                             func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPublishableApiKeysPublishableApiKeyResponse, error)
+	GetPublishableApiKeysWithResponseFunc                                              func(ctx context.Context, params *medusa.GetPublishableApiKeysParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPublishableApiKeysResponse, error)
+	GetRegionsRegionFulfillmentOptionsWithResponseFunc                                 func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetRegionsRegionFulfillmentOptionsResponse, error)
+	GetRegionsRegionWithResponseFunc                                                   func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetRegionsRegionResponse, error)
+	GetRegionsWithResponseFunc                                                         func(ctx context.Context, params *medusa.GetRegionsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetRegionsResponse, error)
+	GetReservationsReservationWithResponseFunc                                         func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetReservationsReservationResponse, error)
+	GetReservationsWithResponseFunc                                                    func(ctx context.Context, params *medusa.GetReservationsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetReservationsResponse, error)
+	GetReturnReasonsReasonWithResponseFunc                                             func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetReturnReasonsReasonResponse, error)
+	GetReturnReasonsWithResponseFunc                                                   func(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetReturnReasonsResponse, error)
+	GetReturnsWithResponseFunc                                                         func(ctx context.Context, params *medusa.GetReturnsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetReturnsResponse, error)
+	GetSalesChannelsSalesChannelWithResponseFunc                                       func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetSalesChannelsSalesChannelResponse, error)
+	GetSalesChannelsWithResponseFunc                                                   func(ctx context.Context, params *medusa.GetSalesChannelsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetSalesChannelsResponse, error)
+	GetShippingOptionsOptionWithResponseFunc                                           func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetShippingOptionsOptionResponse, error)
+	GetShippingOptionsWithResponseFunc                                                 func(ctx context.Context, params *medusa.GetShippingOptionsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetShippingOptionsResponse, error)
+	GetShippingProfilesProfileWithResponseFunc                                         func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetShippingProfilesProfileResponse, error)
+	GetShippingProfilesWithResponseFunc                                                func(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetShippingProfilesResponse, error)
+	GetStockLocationsStockLocationWithResponseFunc                                     func(ctx context.Context, id string, params *medusa.GetStockLocationsStockLocationParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetStockLocationsStockLocationResponse, error)
+	GetStockLocationsWithResponseFunc                                                  func(ctx context.Context, params *medusa.GetStockLocationsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetStockLocationsResponse, error)
+	GetStorePaymentProvidersWithResponseFunc                                           func(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetStorePaymentProvidersResponse, error)
+	GetStoreTaxProvidersWithResponseFunc                                               func(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetStoreTaxProvidersResponse, error)
+	GetStoreWithResponseFunc                                                           func(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetStoreResponse, error)
+	GetSwapsSwapWithResponseFunc                                                       func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetSwapsSwapResponse, error)
+	GetSwapsWithResponseFunc                                                           func(ctx context.Context, params *medusa.GetSwapsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetSwapsResponse, error)
+	GetTaxRatesTaxRateWithResponseFunc                                                 func(ctx context.Context, id string, params *medusa.GetTaxRatesTaxRateParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetTaxRatesTaxRateResponse, error)
+	GetTaxRatesWithResponseFunc                                                        func(ctx context.Context, params *medusa.GetTaxRatesParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetTaxRatesResponse, error)
+	GetUsersUserWithResponseFunc                                                       func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetUsersUserResponse, error)
+	GetUsersWithResponseFunc                                                           func(ctx context.Context, params *medusa.GetUsersParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetUsersResponse, error)
+	GetVariantsVariantInventoryWithResponseFunc                                        func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetVariantsVariantInventoryResponse, error)
+	GetVariantsVariantWithResponseFunc                                                 func(ctx context.Context, id string, params *medusa.GetVariantsVariantParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetVariantsVariantResponse, error)
+	GetVariantsWithResponseFunc                                                        func(ctx context.Context, params *medusa.GetVariantsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetVariantsResponse, error)
+	PostAppsWithBodyWithResponseFunc                                                   func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostAppsResponse, error)
+	PostAppsWithResponseFunc                                                           func(ctx context.Context, body medusa.PostAppsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostAppsResponse, error)
+	PostAuthWithBodyWithResponseFunc                                                   func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostAuthResponse, error)
+	PostAuthWithResponseFunc                                                           func(ctx context.Context, body medusa.PostAuthJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostAuthResponse, error)
+	PostBatchJobsBatchJobCancelWithResponseFunc                                        func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostBatchJobsBatchJobCancelResponse, error)
+	PostBatchJobsBatchJobConfirmProcessingWithResponseFunc                             func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostBatchJobsBatchJobConfirmProcessingResponse, error)
+	PostBatchJobsWithBodyWithResponseFunc                                              func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostBatchJobsResponse, error)
+	PostBatchJobsWithResponseFunc                                                      func(ctx context.Context, body medusa.PostBatchJobsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostBatchJobsResponse, error)
+	PostCollectionsCollectionWithBodyWithResponseFunc                                  func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCollectionsCollectionResponse, error)
+	PostCollectionsCollectionWithResponseFunc                                          func(ctx context.Context, id string, body medusa.PostCollectionsCollectionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCollectionsCollectionResponse, error)
+	PostCollectionsWithBodyWithResponseFunc                                            func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCollectionsResponse, error)
+	PostCollectionsWithResponseFunc                                                    func(ctx context.Context, body medusa.PostCollectionsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCollectionsResponse, error)
+	PostCurrenciesCurrencyWithBodyWithResponseFunc                                     func(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCurrenciesCurrencyResponse, error)
+	PostCurrenciesCurrencyWithResponseFunc                                             func(ctx context.Context, code string, body medusa.PostCurrenciesCurrencyJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCurrenciesCurrencyResponse, error)
+	PostCustomerGroupsGroupCustomersBatchWithBodyWithResponseFunc                      func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomerGroupsGroupCustomersBatchResponse, error)
+	PostCustomerGroupsGroupCustomersBatchWithResponseFunc                              func(ctx context.Context, id string, body medusa.PostCustomerGroupsGroupCustomersBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomerGroupsGroupCustomersBatchResponse, error)
+	PostCustomerGroupsGroupWithBodyWithResponseFunc                                    func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomerGroupsGroupResponse, error)
+	PostCustomerGroupsGroupWithResponseFunc                                            func(ctx context.Context, id string, body medusa.PostCustomerGroupsGroupJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomerGroupsGroupResponse, error)
+	PostCustomerGroupsWithBodyWithResponseFunc                                         func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomerGroupsResponse, error)
+	PostCustomerGroupsWithResponseFunc                                                 func(ctx context.Context, body medusa.PostCustomerGroupsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomerGroupsResponse, error)
+	PostCustomersCustomerWithBodyWithResponseFunc                                      func(ctx context.Context, id string, params *medusa.PostCustomersCustomerParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomersCustomerResponse, error)
+	PostCustomersCustomerWithResponseFunc                                              func(ctx context.Context, id string, params *medusa.PostCustomersCustomerParams, body medusa.PostCustomersCustomerJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomersCustomerResponse, error)
+	PostCustomersWithBodyWithResponseFunc                                              func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomersResponse, error)
+	PostCustomersWithResponseFunc                                                      func(ctx context.Context, body medusa.PostCustomersJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomersResponse, error)
+	PostDiscountsDiscountConditionsConditionBatchWithBodyWithResponseFunc              func(ctx context.Context, discountId string, conditionId string, params *medusa.PostDiscountsDiscountConditionsConditionBatchParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountConditionsConditionBatchResponse, error)
+	PostDiscountsDiscountConditionsConditionBatchWithResponseFunc                      func(ctx context.Context, discountId string, conditionId string, params *medusa.PostDiscountsDiscountConditionsConditionBatchParams, body medusa.PostDiscountsDiscountConditionsConditionBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountConditionsConditionBatchResponse, error)
+	PostDiscountsDiscountConditionsConditionWithBodyWithResponseFunc                   func(ctx context.Context, discountId string, conditionId string, params *medusa.PostDiscountsDiscountConditionsConditionParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountConditionsConditionResponse, error)
+	PostDiscountsDiscountConditionsConditionWithResponseFunc                           func(ctx context.Context, discountId string, conditionId string, params *medusa.PostDiscountsDiscountConditionsConditionParams, body medusa.PostDiscountsDiscountConditionsConditionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountConditionsConditionResponse, error)
+	PostDiscountsDiscountConditionsWithBodyWithResponseFunc                            func(ctx context.Context, discountId string, params *medusa.PostDiscountsDiscountConditionsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountConditionsResponse, error)
+	PostDiscountsDiscountConditionsWithResponseFunc                                    func(ctx context.Context, discountId string, params *medusa.PostDiscountsDiscountConditionsParams, body medusa.PostDiscountsDiscountConditionsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountConditionsResponse, error)
+	PostDiscountsDiscountDynamicCodesWithBodyWithResponseFunc                          func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountDynamicCodesResponse, error)
+	PostDiscountsDiscountDynamicCodesWithResponseFunc                                  func(ctx context.Context, id string, body medusa.PostDiscountsDiscountDynamicCodesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountDynamicCodesResponse, error)
+	PostDiscountsDiscountRegionsRegionWithResponseFunc                                 func(ctx context.Context, id string, regionId string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountRegionsRegionResponse, error)
+	PostDiscountsDiscountWithBodyWithResponseFunc                                      func(ctx context.Context, id string, params *medusa.PostDiscountsDiscountParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountResponse, error)
+	PostDiscountsDiscountWithResponseFunc                                              func(ctx context.Context, id string, params *medusa.PostDiscountsDiscountParams, body medusa.PostDiscountsDiscountJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountResponse, error)
+	PostDiscountsWithBodyWithResponseFunc                                              func(ctx context.Context, params *medusa.PostDiscountsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsResponse, error)
+	PostDiscountsWithResponseFunc                                                      func(ctx context.Context, params *medusa.PostDiscountsParams, body medusa.PostDiscountsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsResponse, error)
+	PostDraftOrdersDraftOrderLineItemsItemWithBodyWithResponseFunc                     func(ctx context.Context, id string, lineId string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersDraftOrderLineItemsItemResponse, error)
+	PostDraftOrdersDraftOrderLineItemsItemWithResponseFunc                             func(ctx context.Context, id string, lineId string, body medusa.PostDraftOrdersDraftOrderLineItemsItemJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersDraftOrderLineItemsItemResponse, error)
+	PostDraftOrdersDraftOrderLineItemsWithBodyWithResponseFunc                         func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersDraftOrderLineItemsResponse, error)
+	PostDraftOrdersDraftOrderLineItemsWithResponseFunc                                 func(ctx context.Context, id string, body medusa.PostDraftOrdersDraftOrderLineItemsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersDraftOrderLineItemsResponse, error)
+	PostDraftOrdersDraftOrderRegisterPaymentWithResponseFunc                           func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersDraftOrderRegisterPaymentResponse, error)
+	PostDraftOrdersDraftOrderWithBodyWithResponseFunc                                  func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersDraftOrderResponse, error)
+	PostDraftOrdersDraftOrderWithResponseFunc                                          func(ctx context.Context, id string, body medusa.PostDraftOrdersDraftOrderJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersDraftOrderResponse, error)
+	PostDraftOrdersWithBodyWithResponseFunc                                            func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersResponse, error)
+	PostDraftOrdersWithResponseFunc                                                    func(ctx context.Context, body medusa.PostDraftOrdersJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersResponse, error)
+	PostGiftCardsGiftCardWithBodyWithResponseFunc                                      func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostGiftCardsGiftCardResponse, error)
+	PostGiftCardsGiftCardWithResponseFunc                                              func(ctx context.Context, id string, body medusa.PostGiftCardsGiftCardJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostGiftCardsGiftCardResponse, error)
+	PostGiftCardsWithBodyWithResponseFunc                                              func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostGiftCardsResponse, error)
+	PostGiftCardsWithResponseFunc                                                      func(ctx context.Context, body medusa.PostGiftCardsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostGiftCardsResponse, error)
+	PostInventoryItemsInventoryItemLocationLevelsLocationLevelWithBodyWithResponseFunc func(ctx context.Context, id string, locationId string, params *medusa.PostInventoryItemsInventoryItemLocationLevelsLocationLevelParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsInventoryItemLocationLevelsLocationLevelResponse, error)
+	PostInventoryItemsInventoryItemLocationLevelsLocationLevelWithResponseFunc         func(ctx context.Context, id string, locationId string, params *medusa.PostInventoryItemsInventoryItemLocationLevelsLocationLevelParams, body medusa.PostInventoryItemsInventoryItemLocationLevelsLocationLevelJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsInventoryItemLocationLevelsLocationLevelResponse, error)
+	PostInventoryItemsInventoryItemLocationLevelsWithBodyWithResponseFunc              func(ctx context.Context, id string, params *medusa.PostInventoryItemsInventoryItemLocationLevelsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsInventoryItemLocationLevelsResponse, error)
+	PostInventoryItemsInventoryItemLocationLevelsWithResponseFunc                      func(ctx context.Context, id string, params *medusa.PostInventoryItemsInventoryItemLocationLevelsParams, body medusa.PostInventoryItemsInventoryItemLocationLevelsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsInventoryItemLocationLevelsResponse, error)
+	PostInventoryItemsInventoryItemWithBodyWithResponseFunc                            func(ctx context.Context, id string, params *medusa.PostInventoryItemsInventoryItemParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsInventoryItemResponse, error)
+	PostInventoryItemsInventoryItemWithResponseFunc                                    func(ctx context.Context, id string, params *medusa.PostInventoryItemsInventoryItemParams, body medusa.PostInventoryItemsInventoryItemJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsInventoryItemResponse, error)
+	PostInventoryItemsWithBodyWithResponseFunc                                         func(ctx context.Context, params *medusa.PostInventoryItemsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsResponse, error)
+	PostInventoryItemsWithResponseFunc                                                 func(ctx context.Context, params *medusa.PostInventoryItemsParams, body medusa.PostInventoryItemsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsResponse, error)
+	PostInvitesInviteAcceptWithBodyWithResponseFunc                                    func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInvitesInviteAcceptResponse, error)
+	PostInvitesInviteAcceptWithResponseFunc                                            func(ctx context.Context, body medusa.PostInvitesInviteAcceptJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInvitesInviteAcceptResponse, error)
+	PostInvitesInviteResendWithResponseFunc                                            func(ctx context.Context, inviteId string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInvitesInviteResendResponse, error)
+	PostInvitesWithBodyWithResponseFunc                                                func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInvitesResponse, error)
+	PostInvitesWithResponseFunc                                                        func(ctx context.Context, body medusa.PostInvitesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInvitesResponse, error)
+	PostNotesNoteWithBodyWithResponseFunc                                              func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostNotesNoteResponse, error)
+	PostNotesNoteWithResponseFunc                                                      func(ctx context.Context, id string, body medusa.PostNotesNoteJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostNotesNoteResponse, error)
+	PostNotesWithBodyWithResponseFunc                                                  func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostNotesResponse, error)
+	PostNotesWithResponseFunc                                                          func(ctx context.Context, body medusa.PostNotesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostNotesResponse, error)
+	PostNotificationsNotificationResendWithBodyWithResponseFunc                        func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostNotificationsNotificationResendResponse, error)
+	PostNotificationsNotificationResendWithResponseFunc                                func(ctx context.Context, id string, body medusa.PostNotificationsNotificationResendJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostNotificationsNotificationResendResponse, error)
+	PostOrderEditsEditLineItemsLineItemWithBodyWithResponseFunc                        func(ctx context.Context, id string, itemId string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsEditLineItemsLineItemResponse, error)
+	PostOrderEditsEditLineItemsLineItemWithResponseFunc                                func(ctx context.Context, id string, itemId string, body medusa.PostOrderEditsEditLineItemsLineItemJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsEditLineItemsLineItemResponse, error)
+	PostOrderEditsEditLineItemsWithBodyWithResponseFunc                                func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsEditLineItemsResponse, error)
+	PostOrderEditsEditLineItemsWithResponseFunc                                        func(ctx context.Context, id string, body medusa.PostOrderEditsEditLineItemsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsEditLineItemsResponse, error)
+	PostOrderEditsOrderEditCancelWithResponseFunc                                      func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsOrderEditCancelResponse, error)
+	PostOrderEditsOrderEditConfirmWithResponseFunc                                     func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsOrderEditConfirmResponse, error)
+	PostOrderEditsOrderEditRequestWithResponseFunc                                     func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsOrderEditRequestResponse, error)
+	PostOrderEditsOrderEditWithBodyWithResponseFunc                                    func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsOrderEditResponse, error)
+	PostOrderEditsOrderEditWithResponseFunc                                            func(ctx context.Context, id string, body medusa.PostOrderEditsOrderEditJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsOrderEditResponse, error)
+	PostOrderEditsWithBodyWithResponseFunc                                             func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsResponse, error)
+	PostOrderEditsWithResponseFunc                                                     func(ctx context.Context, body medusa.PostOrderEditsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsResponse, error)
+	PostOrdersClaimCancelWithResponseFunc                                              func(ctx context.Context, id string, claimId string, params *medusa.PostOrdersClaimCancelParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersClaimCancelResponse, error)
+	PostOrdersClaimFulfillmentsCancelWithResponseFunc                                  func(ctx context.Context, id string, claimId string, fulfillmentId string, params *medusa.PostOrdersClaimFulfillmentsCancelParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersClaimFulfillmentsCancelResponse, error)
+	PostOrdersOrderArchiveWithResponseFunc                                             func(ctx context.Context, id string, params *medusa.PostOrdersOrderArchiveParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderArchiveResponse, error)
+	PostOrdersOrderCancelWithResponseFunc                                              func(ctx context.Context, id string, params *medusa.PostOrdersOrderCancelParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderCancelResponse, error)
+	PostOrdersOrderCaptureWithResponseFunc                                             func(ctx context.Context, id string, params *medusa.PostOrdersOrderCaptureParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderCaptureResponse, error)
+	PostOrdersOrderClaimsClaimFulfillmentsWithBodyWithResponseFunc                     func(ctx context.Context, id string, claimId string, params *medusa.PostOrdersOrderClaimsClaimFulfillmentsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsClaimFulfillmentsResponse, error)
+	PostOrdersOrderClaimsClaimFulfillmentsWithResponseFunc                             func(ctx context.Context, id string, claimId string, params *medusa.PostOrdersOrderClaimsClaimFulfillmentsParams, body medusa.PostOrdersOrderClaimsClaimFulfillmentsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsClaimFulfillmentsResponse, error)
+	PostOrdersOrderClaimsClaimShipmentsWithBodyWithResponseFunc                        func(ctx context.Context, id string, claimId string, params *medusa.PostOrdersOrderClaimsClaimShipmentsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsClaimShipmentsResponse, error)
+	PostOrdersOrderClaimsClaimShipmentsWithResponseFunc                                func(ctx context.Context, id string, claimId string, params *medusa.PostOrdersOrderClaimsClaimShipmentsParams, body medusa.PostOrdersOrderClaimsClaimShipmentsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsClaimShipmentsResponse, error)
+	PostOrdersOrderClaimsClaimWithBodyWithResponseFunc                                 func(ctx context.Context, id string, claimId string, params *medusa.PostOrdersOrderClaimsClaimParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsClaimResponse, error)
+	PostOrdersOrderClaimsClaimWithResponseFunc                                         func(ctx context.Context, id string, claimId string, params *medusa.PostOrdersOrderClaimsClaimParams, body medusa.PostOrdersOrderClaimsClaimJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsClaimResponse, error)
+	PostOrdersOrderClaimsWithBodyWithResponseFunc                                      func(ctx context.Context, id string, params *medusa.PostOrdersOrderClaimsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsResponse, error)
+	PostOrdersOrderClaimsWithResponseFunc                                              func(ctx context.Context, id string, params *medusa.PostOrdersOrderClaimsParams, body medusa.PostOrdersOrderClaimsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsResponse, error)
+	PostOrdersOrderCompleteWithResponseFunc                                            func(ctx context.Context, id string, params *medusa.PostOrdersOrderCompleteParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderCompleteResponse, error)
+	PostOrdersOrderFulfillmentsCancelWithResponseFunc                                  func(ctx context.Context, id string, fulfillmentId string, params *medusa.PostOrdersOrderFulfillmentsCancelParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderFulfillmentsCancelResponse, error)
+	PostOrdersOrderFulfillmentsWithBodyWithResponseFunc                                func(ctx context.Context, id string, params *medusa.PostOrdersOrderFulfillmentsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderFulfillmentsResponse, error)
+	PostOrdersOrderFulfillmentsWithResponseFunc                                        func(ctx context.Context, id string, params *medusa.PostOrdersOrderFulfillmentsParams, body medusa.PostOrdersOrderFulfillmentsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderFulfillmentsResponse, error)
+	PostOrdersOrderLineItemReservationsWithBodyWithResponseFunc                        func(ctx context.Context, id string, lineItemId string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderLineItemReservationsResponse, error)
+	PostOrdersOrderLineItemReservationsWithResponseFunc                                func(ctx context.Context, id string, lineItemId string, body medusa.PostOrdersOrderLineItemReservationsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderLineItemReservationsResponse, error)
+	PostOrdersOrderRefundsWithBodyWithResponseFunc                                     func(ctx context.Context, id string, params *medusa.PostOrdersOrderRefundsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderRefundsResponse, error)
+	PostOrdersOrderRefundsWithResponseFunc                                             func(ctx context.Context, id string, params *medusa.PostOrdersOrderRefundsParams, body medusa.PostOrdersOrderRefundsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderRefundsResponse, error)
+	PostOrdersOrderReturnsWithBodyWithResponseFunc                                     func(ctx context.Context, id string, params *medusa.PostOrdersOrderReturnsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderReturnsResponse, error)
+	PostOrdersOrderReturnsWithResponseFunc                                             func(ctx context.Context, id string, params *medusa.PostOrdersOrderReturnsParams, body medusa.PostOrdersOrderReturnsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderReturnsResponse, error)
+	PostOrdersOrderShipmentWithBodyWithResponseFunc                                    func(ctx context.Context, id string, params *medusa.PostOrdersOrderShipmentParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderShipmentResponse, error)
+	PostOrdersOrderShipmentWithResponseFunc                                            func(ctx context.Context, id string, params *medusa.PostOrdersOrderShipmentParams, body medusa.PostOrdersOrderShipmentJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderShipmentResponse, error)
+	PostOrdersOrderShippingMethodsWithBodyWithResponseFunc                             func(ctx context.Context, id string, params *medusa.PostOrdersOrderShippingMethodsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderShippingMethodsResponse, error)
+	PostOrdersOrderShippingMethodsWithResponseFunc                                     func(ctx context.Context, id string, params *medusa.PostOrdersOrderShippingMethodsParams, body medusa.PostOrdersOrderShippingMethodsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderShippingMethodsResponse, error)
+	PostOrdersOrderSwapsSwapFulfillmentsWithBodyWithResponseFunc                       func(ctx context.Context, id string, swapId string, params *medusa.PostOrdersOrderSwapsSwapFulfillmentsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderSwapsSwapFulfillmentsResponse, error)
+	PostOrdersOrderSwapsSwapFulfillmentsWithResponseFunc                               func(ctx context.Context, id string, swapId string, params *medusa.PostOrdersOrderSwapsSwapFulfillmentsParams, body medusa.PostOrdersOrderSwapsSwapFulfillmentsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderSwapsSwapFulfillmentsResponse, error)
+	PostOrdersOrderSwapsSwapProcessPaymentWithResponseFunc                             func(ctx context.Context, id string, swapId string, params *medusa.PostOrdersOrderSwapsSwapProcessPaymentParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderSwapsSwapProcessPaymentResponse, error)
+	PostOrdersOrderSwapsSwapShipmentsWithBodyWithResponseFunc                          func(ctx context.Context, id string, swapId string, params *medusa.PostOrdersOrderSwapsSwapShipmentsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderSwapsSwapShipmentsResponse, error)
+	PostOrdersOrderSwapsSwapShipmentsWithResponseFunc                                  func(ctx context.Context, id string, swapId string, params *medusa.PostOrdersOrderSwapsSwapShipmentsParams, body medusa.PostOrdersOrderSwapsSwapShipmentsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderSwapsSwapShipmentsResponse, error)
+	PostOrdersOrderSwapsWithBodyWithResponseFunc                                       func(ctx context.Context, id string, params *medusa.PostOrdersOrderSwapsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderSwapsResponse, error)
+	PostOrdersOrderSwapsWithResponseFunc                                               func(ctx context.Context, id string, params *medusa.PostOrdersOrderSwapsParams, body medusa.PostOrdersOrderSwapsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderSwapsResponse, error)
+	PostOrdersOrderWithBodyWithResponseFunc                                            func(ctx context.Context, id string, params *medusa.PostOrdersOrderParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderResponse, error)
+	PostOrdersOrderWithResponseFunc                                                    func(ctx context.Context, id string, params *medusa.PostOrdersOrderParams, body medusa.PostOrdersOrderJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderResponse, error)
+	PostOrdersSwapCancelWithResponseFunc                                               func(ctx context.Context, id string, swapId string, params *medusa.PostOrdersSwapCancelParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersSwapCancelResponse, error)
+	PostOrdersSwapFulfillmentsCancelWithResponseFunc                                   func(ctx context.Context, id string, swapId string, fulfillmentId string, params *medusa.PostOrdersSwapFulfillmentsCancelParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersSwapFulfillmentsCancelResponse, error)
+	PostPaymentCollectionsPaymentCollectionAuthorizeWithResponseFunc                   func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPaymentCollectionsPaymentCollectionAuthorizeResponse, error)
+	PostPaymentCollectionsPaymentCollectionWithBodyWithResponseFunc                    func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPaymentCollectionsPaymentCollectionResponse, error)
+	PostPaymentCollectionsPaymentCollectionWithResponseFunc                            func(ctx context.Context, id string, body medusa.PostPaymentCollectionsPaymentCollectionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPaymentCollectionsPaymentCollectionResponse, error)
+	PostPaymentsPaymentCaptureWithResponseFunc                                         func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPaymentsPaymentCaptureResponse, error)
+	PostPaymentsPaymentRefundsWithBodyWithResponseFunc                                 func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPaymentsPaymentRefundsResponse, error)
+	PostPaymentsPaymentRefundsWithResponseFunc                                         func(ctx context.Context, id string, body medusa.PostPaymentsPaymentRefundsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPaymentsPaymentRefundsResponse, error)
+	PostPriceListsPriceListPriceListWithBodyWithResponseFunc                           func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPriceListsPriceListPriceListResponse, error)
+	PostPriceListsPriceListPriceListWithResponseFunc                                   func(ctx context.Context, id string, body medusa.PostPriceListsPriceListPriceListJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPriceListsPriceListPriceListResponse, error)
+	PostPriceListsPriceListPricesBatchWithBodyWithResponseFunc                         func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPriceListsPriceListPricesBatchResponse, error)
+	PostPriceListsPriceListPricesBatchWithResponseFunc                                 func(ctx context.Context, id string, body medusa.PostPriceListsPriceListPricesBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPriceListsPriceListPricesBatchResponse, error)
+	PostPriceListsPriceListWithBodyWithResponseFunc                                    func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPriceListsPriceListResponse, error)
+	PostPriceListsPriceListWithResponseFunc                                            func(ctx context.Context, body medusa.PostPriceListsPriceListJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPriceListsPriceListResponse, error)
+	PostProductCategoriesCategoryProductsBatchWithBodyWithResponseFunc                 func(ctx context.Context, id string, params *medusa.PostProductCategoriesCategoryProductsBatchParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductCategoriesCategoryProductsBatchResponse, error)
+	PostProductCategoriesCategoryProductsBatchWithResponseFunc                         func(ctx context.Context, id string, params *medusa.PostProductCategoriesCategoryProductsBatchParams, body medusa.PostProductCategoriesCategoryProductsBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductCategoriesCategoryProductsBatchResponse, error)
+	PostProductCategoriesCategoryWithBodyWithResponseFunc                              func(ctx context.Context, id string, params *medusa.PostProductCategoriesCategoryParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductCategoriesCategoryResponse, error)
+	PostProductCategoriesCategoryWithResponseFunc                                      func(ctx context.Context, id string, params *medusa.PostProductCategoriesCategoryParams, body medusa.PostProductCategoriesCategoryJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductCategoriesCategoryResponse, error)
+	PostProductCategoriesWithBodyWithResponseFunc                                      func(ctx context.Context, params *medusa.PostProductCategoriesParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductCategoriesResponse, error)
+	PostProductCategoriesWithResponseFunc                                              func(ctx context.Context, params *medusa.PostProductCategoriesParams, body medusa.PostProductCategoriesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductCategoriesResponse, error)
+	PostProductsProductMetadataWithBodyWithResponseFunc                                func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductMetadataResponse, error)
+	PostProductsProductMetadataWithResponseFunc                                        func(ctx context.Context, id string, body medusa.PostProductsProductMetadataJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductMetadataResponse, error)
+	PostProductsProductOptionsOptionWithBodyWithResponseFunc                           func(ctx context.Context, id string, optionId string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductOptionsOptionResponse, error)
+	PostProductsProductOptionsOptionWithResponseFunc                                   func(ctx context.Context, id string, optionId string, body medusa.PostProductsProductOptionsOptionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductOptionsOptionResponse, error)
+	PostProductsProductOptionsWithBodyWithResponseFunc                                 func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductOptionsResponse, error)
+	PostProductsProductOptionsWithResponseFunc                                         func(ctx context.Context, id string, body medusa.PostProductsProductOptionsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductOptionsResponse, error)
+	PostProductsProductVariantsVariantWithBodyWithResponseFunc                         func(ctx context.Context, id string, variantId string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductVariantsVariantResponse, error)
+	PostProductsProductVariantsVariantWithResponseFunc                                 func(ctx context.Context, id string, variantId string, body medusa.PostProductsProductVariantsVariantJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductVariantsVariantResponse, error)
+	PostProductsProductVariantsWithBodyWithResponseFunc                                func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductVariantsResponse, error)
+	PostProductsProductVariantsWithResponseFunc                                        func(ctx context.Context, id string, body medusa.PostProductsProductVariantsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductVariantsResponse, error)
+	PostProductsProductWithBodyWithResponseFunc                                        func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductResponse, error)
+	PostProductsProductWithResponseFunc                                                func(ctx context.Context, id string, body medusa.PostProductsProductJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductResponse, error)
+	PostProductsToCollectionWithBodyWithResponseFunc                                   func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsToCollectionResponse, error)
+	PostProductsToCollectionWithResponseFunc                                           func(ctx context.Context, id string, body medusa.PostProductsToCollectionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsToCollectionResponse, error)
+	PostProductsWithBodyWithResponseFunc                                               func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsResponse, error)
+	PostProductsWithResponseFunc                                                       func(ctx context.Context, body medusa.PostProductsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsResponse, error)
+	PostPublishableApiKeySalesChannelsChannelsBatchWithBodyWithResponseFunc            func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPublishableApiKeySalesChannelsChannelsBatchResponse, error)
+	PostPublishableApiKeySalesChannelsChannelsBatchWithResponseFunc                    func(ctx context.Context, id string, body medusa.PostPublishableApiKeySalesChannelsChannelsBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPublishableApiKeySalesChannelsChannelsBatchResponse, error)
+	PostPublishableApiKeysPublishableApiKeyRevokeWithResponseFunc                      func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPublishableApiKeysPublishableApiKeyRevokeResponse, error)
+	PostPublishableApiKeysWithBodyWithResponseFunc                                     func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPublishableApiKeysResponse, error)
+	PostPublishableApiKeysWithResponseFunc                                             func(ctx context.Context, body medusa.PostPublishableApiKeysJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPublishableApiKeysResponse, error)
+	PostPublishableApiKysPublishableApiKeyWithBodyWithResponseFunc                     func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPublishableApiKysPublishableApiKeyResponse, error)
+	PostPublishableApiKysPublishableApiKeyWithResponseFunc                             func(ctx context.Context, id string, body medusa.PostPublishableApiKysPublishableApiKeyJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPublishableApiKysPublishableApiKeyResponse, error)
+	PostRegionsRegionCountriesCountryWithResponseFunc                                  func(ctx context.Context, id string, countryCode string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionCountriesCountryResponse, error)
+	PostRegionsRegionCountriesWithBodyWithResponseFunc                                 func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionCountriesResponse, error)
+	PostRegionsRegionCountriesWithResponseFunc                                         func(ctx context.Context, id string, body medusa.PostRegionsRegionCountriesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionCountriesResponse, error)
+	PostRegionsRegionFulfillmentProvidersProviderWithResponseFunc                      func(ctx context.Context, id string, providerId string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionFulfillmentProvidersProviderResponse, error)
+	PostRegionsRegionFulfillmentProvidersWithBodyWithResponseFunc                      func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionFulfillmentProvidersResponse, error)
+	PostRegionsRegionFulfillmentProvidersWithResponseFunc                              func(ctx context.Context, id string, body medusa.PostRegionsRegionFulfillmentProvidersJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionFulfillmentProvidersResponse, error)
+	PostRegionsRegionPaymentProvidersProviderWithResponseFunc                          func(ctx context.Context, id string, providerId string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionPaymentProvidersProviderResponse, error)
+	PostRegionsRegionPaymentProvidersWithBodyWithResponseFunc                          func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionPaymentProvidersResponse, error)
+	PostRegionsRegionPaymentProvidersWithResponseFunc                                  func(ctx context.Context, id string, body medusa.PostRegionsRegionPaymentProvidersJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionPaymentProvidersResponse, error)
+	PostRegionsRegionWithBodyWithResponseFunc                                          func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionResponse, error)
+	PostRegionsRegionWithResponseFunc                                                  func(ctx context.Context, id string, body medusa.PostRegionsRegionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionResponse, error)
+	PostRegionsWithBodyWithResponseFunc                                                func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsResponse, error)
+	PostRegionsWithResponseFunc                                                        func(ctx context.Context, body medusa.PostRegionsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsResponse, error)
+	PostReservationsReservationWithBodyWithResponseFunc                                func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReservationsReservationResponse, error)
+	PostReservationsReservationWithResponseFunc                                        func(ctx context.Context, id string, body medusa.PostReservationsReservationJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReservationsReservationResponse, error)
+	PostReservationsWithBodyWithResponseFunc                                           func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReservationsResponse, error)
+	PostReservationsWithResponseFunc                                                   func(ctx context.Context, body medusa.PostReservationsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReservationsResponse, error)
+	PostReturnReasonsReasonWithBodyWithResponseFunc                                    func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReturnReasonsReasonResponse, error)
+	PostReturnReasonsReasonWithResponseFunc                                            func(ctx context.Context, id string, body medusa.PostReturnReasonsReasonJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReturnReasonsReasonResponse, error)
+	PostReturnReasonsWithBodyWithResponseFunc                                          func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReturnReasonsResponse, error)
+	PostReturnReasonsWithResponseFunc                                                  func(ctx context.Context, body medusa.PostReturnReasonsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReturnReasonsResponse, error)
+	PostReturnsReturnCancelWithResponseFunc                                            func(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReturnsReturnCancelResponse, error)
+	PostReturnsReturnReceiveWithBodyWithResponseFunc                                   func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReturnsReturnReceiveResponse, error)
+	PostReturnsReturnReceiveWithResponseFunc                                           func(ctx context.Context, id string, body medusa.PostReturnsReturnReceiveJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReturnsReturnReceiveResponse, error)
+	PostSalesChannelsChannelProductsBatchWithBodyWithResponseFunc                      func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsChannelProductsBatchResponse, error)
+	PostSalesChannelsChannelProductsBatchWithResponseFunc                              func(ctx context.Context, id string, body medusa.PostSalesChannelsChannelProductsBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsChannelProductsBatchResponse, error)
+	PostSalesChannelsSalesChannelStockLocationWithBodyWithResponseFunc                 func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsSalesChannelStockLocationResponse, error)
+	PostSalesChannelsSalesChannelStockLocationWithResponseFunc                         func(ctx context.Context, id string, body medusa.PostSalesChannelsSalesChannelStockLocationJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsSalesChannelStockLocationResponse, error)
+	PostSalesChannelsSalesChannelWithBodyWithResponseFunc                              func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsSalesChannelResponse, error)
+	PostSalesChannelsSalesChannelWithResponseFunc                                      func(ctx context.Context, id string, body medusa.PostSalesChannelsSalesChannelJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsSalesChannelResponse, error)
+	PostSalesChannelsWithBodyWithResponseFunc                                          func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsResponse, error)
+	PostSalesChannelsWithResponseFunc                                                  func(ctx context.Context, body medusa.PostSalesChannelsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsResponse, error)
+	PostShippingOptionsOptionWithBodyWithResponseFunc                                  func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingOptionsOptionResponse, error)
+	PostShippingOptionsOptionWithResponseFunc                                          func(ctx context.Context, id string, body medusa.PostShippingOptionsOptionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingOptionsOptionResponse, error)
+	PostShippingOptionsWithBodyWithResponseFunc                                        func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingOptionsResponse, error)
+	PostShippingOptionsWithResponseFunc                                                func(ctx context.Context, body medusa.PostShippingOptionsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingOptionsResponse, error)
+	PostShippingProfilesProfileWithBodyWithResponseFunc                                func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingProfilesProfileResponse, error)
+	PostShippingProfilesProfileWithResponseFunc                                        func(ctx context.Context, id string, body medusa.PostShippingProfilesProfileJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingProfilesProfileResponse, error)
+	PostShippingProfilesWithBodyWithResponseFunc                                       func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingProfilesResponse, error)
+	PostShippingProfilesWithResponseFunc                                               func(ctx context.Context, body medusa.PostShippingProfilesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingProfilesResponse, error)
+	PostStockLocationsStockLocationWithBodyWithResponseFunc                            func(ctx context.Context, id string, params *medusa.PostStockLocationsStockLocationParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostStockLocationsStockLocationResponse, error)
+	PostStockLocationsStockLocationWithResponseFunc                                    func(ctx context.Context, id string, params *medusa.PostStockLocationsStockLocationParams, body medusa.PostStockLocationsStockLocationJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostStockLocationsStockLocationResponse, error)
+	PostStockLocationsWithBodyWithResponseFunc                                         func(ctx context.Context, params *medusa.PostStockLocationsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostStockLocationsResponse, error)
+	PostStockLocationsWithResponseFunc                                                 func(ctx context.Context, params *medusa.PostStockLocationsParams, body medusa.PostStockLocationsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostStockLocationsResponse, error)
+	PostStoreCurrenciesCodeWithResponseFunc                                            func(ctx context.Context, code string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostStoreCurrenciesCodeResponse, error)
+	PostStoreWithBodyWithResponseFunc                                                  func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostStoreResponse, error)
+	PostStoreWithResponseFunc                                                          func(ctx context.Context, body medusa.PostStoreJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostStoreResponse, error)
+	PostTaxRatesTaxRateProductTypesWithBodyWithResponseFunc                            func(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateProductTypesParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateProductTypesResponse, error)
+	PostTaxRatesTaxRateProductTypesWithResponseFunc                                    func(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateProductTypesParams, body medusa.PostTaxRatesTaxRateProductTypesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateProductTypesResponse, error)
+	PostTaxRatesTaxRateProductsWithBodyWithResponseFunc                                func(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateProductsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateProductsResponse, error)
+	PostTaxRatesTaxRateProductsWithResponseFunc                                        func(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateProductsParams, body medusa.PostTaxRatesTaxRateProductsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateProductsResponse, error)
+	PostTaxRatesTaxRateShippingOptionsWithBodyWithResponseFunc                         func(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateShippingOptionsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateShippingOptionsResponse, error)
+	PostTaxRatesTaxRateShippingOptionsWithResponseFunc                                 func(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateShippingOptionsParams, body medusa.PostTaxRatesTaxRateShippingOptionsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateShippingOptionsResponse, error)
+	PostTaxRatesTaxRateWithBodyWithResponseFunc                                        func(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateResponse, error)
+	PostTaxRatesTaxRateWithResponseFunc                                                func(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateParams, body medusa.PostTaxRatesTaxRateJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateResponse, error)
+	PostTaxRatesWithBodyWithResponseFunc                                               func(ctx context.Context, params *medusa.PostTaxRatesParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesResponse, error)
+	PostTaxRatesWithResponseFunc                                                       func(ctx context.Context, params *medusa.PostTaxRatesParams, body medusa.PostTaxRatesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesResponse, error)
+	PostTokenWithBodyWithResponseFunc                                                  func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTokenResponse, error)
+	PostTokenWithResponseFunc                                                          func(ctx context.Context, body medusa.PostTokenJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTokenResponse, error)
+	PostUploadsDownloadUrlWithBodyWithResponseFunc                                     func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUploadsDownloadUrlResponse, error)
+	PostUploadsDownloadUrlWithResponseFunc                                             func(ctx context.Context, body medusa.PostUploadsDownloadUrlJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUploadsDownloadUrlResponse, error)
+	PostUploadsProtectedWithBodyWithResponseFunc                                       func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUploadsProtectedResponse, error)
+	PostUploadsWithBodyWithResponseFunc                                                func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUploadsResponse, error)
+	PostUsersUserPasswordTokenWithBodyWithResponseFunc                                 func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersUserPasswordTokenResponse, error)
+	PostUsersUserPasswordTokenWithResponseFunc                                         func(ctx context.Context, body medusa.PostUsersUserPasswordTokenJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersUserPasswordTokenResponse, error)
+	PostUsersUserPasswordWithBodyWithResponseFunc                                      func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersUserPasswordResponse, error)
+	PostUsersUserPasswordWithResponseFunc                                              func(ctx context.Context, body medusa.PostUsersUserPasswordJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersUserPasswordResponse, error)
+	PostUsersUserWithBodyWithResponseFunc                                              func(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersUserResponse, error)
+	PostUsersUserWithResponseFunc                                                      func(ctx context.Context, id string, body medusa.PostUsersUserJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersUserResponse, error)
+	PostUsersWithBodyWithResponseFunc                                                  func(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersResponse, error)
+	PostUsersWithResponseFunc                                                          func(ctx context.Context, body medusa.PostUsersJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersResponse, error)
+}
+
+// Calls returns the names of the methods called so far, in order.
+func (f *FakeClient) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]string(nil), f.calls...)
+}
+
+func (f *FakeClient) record(method string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, method)
+}
+
+func (f *FakeClient) BaseURL() string {
+	f.record("BaseURL")
+	if f.BaseURLFunc == nil {
+		panic("clientfake: BaseURL is not stubbed")
+	}
+	return f.BaseURLFunc()
+}
+
+func (f *FakeClient) DeleteAuthWithResponse(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteAuthResponse, error) {
+	f.record("DeleteAuthWithResponse")
+	if f.DeleteAuthWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteAuthWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteAuthWithResponseFunc(ctx, reqEditors...)
+}
+
+func (f *FakeClient) DeleteCollectionsCollectionWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteCollectionsCollectionResponse, error) {
+	f.record("DeleteCollectionsCollectionWithResponse")
+	if f.DeleteCollectionsCollectionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteCollectionsCollectionWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteCollectionsCollectionWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteCustomerGroupsCustomerGroupWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteCustomerGroupsCustomerGroupResponse, error) {
+	f.record("DeleteCustomerGroupsCustomerGroupWithResponse")
+	if f.DeleteCustomerGroupsCustomerGroupWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteCustomerGroupsCustomerGroupWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteCustomerGroupsCustomerGroupWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteCustomerGroupsGroupCustomerBatchWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteCustomerGroupsGroupCustomerBatchResponse, error) {
+	f.record("DeleteCustomerGroupsGroupCustomerBatchWithBodyWithResponse")
+	if f.DeleteCustomerGroupsGroupCustomerBatchWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteCustomerGroupsGroupCustomerBatchWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteCustomerGroupsGroupCustomerBatchWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteCustomerGroupsGroupCustomerBatchWithResponse(ctx context.Context, id string, body medusa.DeleteCustomerGroupsGroupCustomerBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteCustomerGroupsGroupCustomerBatchResponse, error) {
+	f.record("DeleteCustomerGroupsGroupCustomerBatchWithResponse")
+	if f.DeleteCustomerGroupsGroupCustomerBatchWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteCustomerGroupsGroupCustomerBatchWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteCustomerGroupsGroupCustomerBatchWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteDiscountsDiscountConditionsConditionBatchWithBodyWithResponse(ctx context.Context, discountId string, conditionId string, params *medusa.DeleteDiscountsDiscountConditionsConditionBatchParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDiscountsDiscountConditionsConditionBatchResponse, error) {
+	f.record("DeleteDiscountsDiscountConditionsConditionBatchWithBodyWithResponse")
+	if f.DeleteDiscountsDiscountConditionsConditionBatchWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteDiscountsDiscountConditionsConditionBatchWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteDiscountsDiscountConditionsConditionBatchWithBodyWithResponseFunc(ctx, discountId, conditionId, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteDiscountsDiscountConditionsConditionBatchWithResponse(ctx context.Context, discountId string, conditionId string, params *medusa.DeleteDiscountsDiscountConditionsConditionBatchParams, body medusa.DeleteDiscountsDiscountConditionsConditionBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDiscountsDiscountConditionsConditionBatchResponse, error) {
+	f.record("DeleteDiscountsDiscountConditionsConditionBatchWithResponse")
+	if f.DeleteDiscountsDiscountConditionsConditionBatchWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteDiscountsDiscountConditionsConditionBatchWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteDiscountsDiscountConditionsConditionBatchWithResponseFunc(ctx, discountId, conditionId, params, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteDiscountsDiscountConditionsConditionWithResponse(ctx context.Context, discountId string, conditionId string, params *medusa.DeleteDiscountsDiscountConditionsConditionParams, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDiscountsDiscountConditionsConditionResponse, error) {
+	f.record("DeleteDiscountsDiscountConditionsConditionWithResponse")
+	if f.DeleteDiscountsDiscountConditionsConditionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteDiscountsDiscountConditionsConditionWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteDiscountsDiscountConditionsConditionWithResponseFunc(ctx, discountId, conditionId, params, reqEditors...)
+}
+
+func (f *FakeClient) DeleteDiscountsDiscountDynamicCodesCodeWithResponse(ctx context.Context, id string, code string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDiscountsDiscountDynamicCodesCodeResponse, error) {
+	f.record("DeleteDiscountsDiscountDynamicCodesCodeWithResponse")
+	if f.DeleteDiscountsDiscountDynamicCodesCodeWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteDiscountsDiscountDynamicCodesCodeWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteDiscountsDiscountDynamicCodesCodeWithResponseFunc(ctx, id, code, reqEditors...)
+}
+
+func (f *FakeClient) DeleteDiscountsDiscountRegionsRegionWithResponse(ctx context.Context, id string, regionId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDiscountsDiscountRegionsRegionResponse, error) {
+	f.record("DeleteDiscountsDiscountRegionsRegionWithResponse")
+	if f.DeleteDiscountsDiscountRegionsRegionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteDiscountsDiscountRegionsRegionWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteDiscountsDiscountRegionsRegionWithResponseFunc(ctx, id, regionId, reqEditors...)
+}
+
+func (f *FakeClient) DeleteDiscountsDiscountWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDiscountsDiscountResponse, error) {
+	f.record("DeleteDiscountsDiscountWithResponse")
+	if f.DeleteDiscountsDiscountWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteDiscountsDiscountWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteDiscountsDiscountWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteDraftOrdersDraftOrderLineItemsItemWithResponse(ctx context.Context, id string, lineId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDraftOrdersDraftOrderLineItemsItemResponse, error) {
+	f.record("DeleteDraftOrdersDraftOrderLineItemsItemWithResponse")
+	if f.DeleteDraftOrdersDraftOrderLineItemsItemWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteDraftOrdersDraftOrderLineItemsItemWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteDraftOrdersDraftOrderLineItemsItemWithResponseFunc(ctx, id, lineId, reqEditors...)
+}
+
+func (f *FakeClient) DeleteDraftOrdersDraftOrderWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteDraftOrdersDraftOrderResponse, error) {
+	f.record("DeleteDraftOrdersDraftOrderWithResponse")
+	if f.DeleteDraftOrdersDraftOrderWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteDraftOrdersDraftOrderWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteDraftOrdersDraftOrderWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteGiftCardsGiftCardWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteGiftCardsGiftCardResponse, error) {
+	f.record("DeleteGiftCardsGiftCardWithResponse")
+	if f.DeleteGiftCardsGiftCardWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteGiftCardsGiftCardWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteGiftCardsGiftCardWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteInventoryItemsInventoryIteLocationLevelsLocationWithResponse(ctx context.Context, id string, locationId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteInventoryItemsInventoryIteLocationLevelsLocationResponse, error) {
+	f.record("DeleteInventoryItemsInventoryIteLocationLevelsLocationWithResponse")
+	if f.DeleteInventoryItemsInventoryIteLocationLevelsLocationWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteInventoryItemsInventoryIteLocationLevelsLocationWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteInventoryItemsInventoryIteLocationLevelsLocationWithResponseFunc(ctx, id, locationId, reqEditors...)
+}
+
+func (f *FakeClient) DeleteInventoryItemsInventoryItemWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteInventoryItemsInventoryItemResponse, error) {
+	f.record("DeleteInventoryItemsInventoryItemWithResponse")
+	if f.DeleteInventoryItemsInventoryItemWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteInventoryItemsInventoryItemWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteInventoryItemsInventoryItemWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteInvitesInviteWithResponse(ctx context.Context, inviteId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteInvitesInviteResponse, error) {
+	f.record("DeleteInvitesInviteWithResponse")
+	if f.DeleteInvitesInviteWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteInvitesInviteWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteInvitesInviteWithResponseFunc(ctx, inviteId, reqEditors...)
+}
+
+func (f *FakeClient) DeleteNotesNoteWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteNotesNoteResponse, error) {
+	f.record("DeleteNotesNoteWithResponse")
+	if f.DeleteNotesNoteWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteNotesNoteWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteNotesNoteWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteOrderEditsOrderEditItemChangeWithResponse(ctx context.Context, id string, changeId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteOrderEditsOrderEditItemChangeResponse, error) {
+	f.record("DeleteOrderEditsOrderEditItemChangeWithResponse")
+	if f.DeleteOrderEditsOrderEditItemChangeWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteOrderEditsOrderEditItemChangeWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteOrderEditsOrderEditItemChangeWithResponseFunc(ctx, id, changeId, reqEditors...)
+}
+
+func (f *FakeClient) DeleteOrderEditsOrderEditLineItemsLineItemWithResponse(ctx context.Context, id string, itemId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteOrderEditsOrderEditLineItemsLineItemResponse, error) {
+	f.record("DeleteOrderEditsOrderEditLineItemsLineItemWithResponse")
+	if f.DeleteOrderEditsOrderEditLineItemsLineItemWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteOrderEditsOrderEditLineItemsLineItemWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteOrderEditsOrderEditLineItemsLineItemWithResponseFunc(ctx, id, itemId, reqEditors...)
+}
+
+func (f *FakeClient) DeleteOrderEditsOrderEditWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteOrderEditsOrderEditResponse, error) {
+	f.record("DeleteOrderEditsOrderEditWithResponse")
+	if f.DeleteOrderEditsOrderEditWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteOrderEditsOrderEditWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteOrderEditsOrderEditWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeletePaymentCollectionsPaymentCollectionWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePaymentCollectionsPaymentCollectionResponse, error) {
+	f.record("DeletePaymentCollectionsPaymentCollectionWithResponse")
+	if f.DeletePaymentCollectionsPaymentCollectionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeletePaymentCollectionsPaymentCollectionWithResponse", ErrNotStubbed)
+	}
+	return f.DeletePaymentCollectionsPaymentCollectionWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeletePriceListsPriceListPricesBatchWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePriceListsPriceListPricesBatchResponse, error) {
+	f.record("DeletePriceListsPriceListPricesBatchWithBodyWithResponse")
+	if f.DeletePriceListsPriceListPricesBatchWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeletePriceListsPriceListPricesBatchWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.DeletePriceListsPriceListPricesBatchWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) DeletePriceListsPriceListPricesBatchWithResponse(ctx context.Context, id string, body medusa.DeletePriceListsPriceListPricesBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePriceListsPriceListPricesBatchResponse, error) {
+	f.record("DeletePriceListsPriceListPricesBatchWithResponse")
+	if f.DeletePriceListsPriceListPricesBatchWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeletePriceListsPriceListPricesBatchWithResponse", ErrNotStubbed)
+	}
+	return f.DeletePriceListsPriceListPricesBatchWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) DeletePriceListsPriceListProductsPricesBatchWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePriceListsPriceListProductsPricesBatchResponse, error) {
+	f.record("DeletePriceListsPriceListProductsPricesBatchWithResponse")
+	if f.DeletePriceListsPriceListProductsPricesBatchWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeletePriceListsPriceListProductsPricesBatchWithResponse", ErrNotStubbed)
+	}
+	return f.DeletePriceListsPriceListProductsPricesBatchWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeletePriceListsPriceListProductsProductPricesWithResponse(ctx context.Context, id string, productId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePriceListsPriceListProductsProductPricesResponse, error) {
+	f.record("DeletePriceListsPriceListProductsProductPricesWithResponse")
+	if f.DeletePriceListsPriceListProductsProductPricesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeletePriceListsPriceListProductsProductPricesWithResponse", ErrNotStubbed)
+	}
+	return f.DeletePriceListsPriceListProductsProductPricesWithResponseFunc(ctx, id, productId, reqEditors...)
+}
+
+func (f *FakeClient) DeletePriceListsPriceListVariantsVariantPricesWithResponse(ctx context.Context, id string, variantId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePriceListsPriceListVariantsVariantPricesResponse, error) {
+	f.record("DeletePriceListsPriceListVariantsVariantPricesWithResponse")
+	if f.DeletePriceListsPriceListVariantsVariantPricesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeletePriceListsPriceListVariantsVariantPricesWithResponse", ErrNotStubbed)
+	}
+	return f.DeletePriceListsPriceListVariantsVariantPricesWithResponseFunc(ctx, id, variantId, reqEditors...)
+}
+
+func (f *FakeClient) DeletePriceListsPriceListWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePriceListsPriceListResponse, error) {
+	f.record("DeletePriceListsPriceListWithResponse")
+	if f.DeletePriceListsPriceListWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeletePriceListsPriceListWithResponse", ErrNotStubbed)
+	}
+	return f.DeletePriceListsPriceListWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteProductCategoriesCategoryProductsBatchWithBodyWithResponse(ctx context.Context, id string, params *medusa.DeleteProductCategoriesCategoryProductsBatchParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductCategoriesCategoryProductsBatchResponse, error) {
+	f.record("DeleteProductCategoriesCategoryProductsBatchWithBodyWithResponse")
+	if f.DeleteProductCategoriesCategoryProductsBatchWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteProductCategoriesCategoryProductsBatchWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteProductCategoriesCategoryProductsBatchWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteProductCategoriesCategoryProductsBatchWithResponse(ctx context.Context, id string, params *medusa.DeleteProductCategoriesCategoryProductsBatchParams, body medusa.DeleteProductCategoriesCategoryProductsBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductCategoriesCategoryProductsBatchResponse, error) {
+	f.record("DeleteProductCategoriesCategoryProductsBatchWithResponse")
+	if f.DeleteProductCategoriesCategoryProductsBatchWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteProductCategoriesCategoryProductsBatchWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteProductCategoriesCategoryProductsBatchWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteProductCategoriesCategoryWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductCategoriesCategoryResponse, error) {
+	f.record("DeleteProductCategoriesCategoryWithResponse")
+	if f.DeleteProductCategoriesCategoryWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteProductCategoriesCategoryWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteProductCategoriesCategoryWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteProductsFromCollectionWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductsFromCollectionResponse, error) {
+	f.record("DeleteProductsFromCollectionWithBodyWithResponse")
+	if f.DeleteProductsFromCollectionWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteProductsFromCollectionWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteProductsFromCollectionWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteProductsFromCollectionWithResponse(ctx context.Context, id string, body medusa.DeleteProductsFromCollectionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductsFromCollectionResponse, error) {
+	f.record("DeleteProductsFromCollectionWithResponse")
+	if f.DeleteProductsFromCollectionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteProductsFromCollectionWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteProductsFromCollectionWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteProductsProductOptionsOptionWithResponse(ctx context.Context, id string, optionId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductsProductOptionsOptionResponse, error) {
+	f.record("DeleteProductsProductOptionsOptionWithResponse")
+	if f.DeleteProductsProductOptionsOptionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteProductsProductOptionsOptionWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteProductsProductOptionsOptionWithResponseFunc(ctx, id, optionId, reqEditors...)
+}
+
+func (f *FakeClient) DeleteProductsProductVariantsVariantWithResponse(ctx context.Context, id string, variantId string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductsProductVariantsVariantResponse, error) {
+	f.record("DeleteProductsProductVariantsVariantWithResponse")
+	if f.DeleteProductsProductVariantsVariantWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteProductsProductVariantsVariantWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteProductsProductVariantsVariantWithResponseFunc(ctx, id, variantId, reqEditors...)
+}
+
+func (f *FakeClient) DeleteProductsProductWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteProductsProductResponse, error) {
+	f.record("DeleteProductsProductWithResponse")
+	if f.DeleteProductsProductWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteProductsProductWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteProductsProductWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeletePublishableApiKeySalesChannelsChannelsBatchWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePublishableApiKeySalesChannelsChannelsBatchResponse, error) {
+	f.record("DeletePublishableApiKeySalesChannelsChannelsBatchWithBodyWithResponse")
+	if f.DeletePublishableApiKeySalesChannelsChannelsBatchWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeletePublishableApiKeySalesChannelsChannelsBatchWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.DeletePublishableApiKeySalesChannelsChannelsBatchWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) DeletePublishableApiKeySalesChannelsChannelsBatchWithResponse(ctx context.Context, id string, body medusa.DeletePublishableApiKeySalesChannelsChannelsBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePublishableApiKeySalesChannelsChannelsBatchResponse, error) {
+	f.record("DeletePublishableApiKeySalesChannelsChannelsBatchWithResponse")
+	if f.DeletePublishableApiKeySalesChannelsChannelsBatchWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeletePublishableApiKeySalesChannelsChannelsBatchWithResponse", ErrNotStubbed)
+	}
+	return f.DeletePublishableApiKeySalesChannelsChannelsBatchWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) DeletePublishableApiKeysPublishableApiKeyWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeletePublishableApiKeysPublishableApiKeyResponse, error) {
+	f.record("DeletePublishableApiKeysPublishableApiKeyWithResponse")
+	if f.DeletePublishableApiKeysPublishableApiKeyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeletePublishableApiKeysPublishableApiKeyWithResponse", ErrNotStubbed)
+	}
+	return f.DeletePublishableApiKeysPublishableApiKeyWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteRegionsRegionWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteRegionsRegionResponse, error) {
+	f.record("DeleteRegionsRegionWithResponse")
+	if f.DeleteRegionsRegionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteRegionsRegionWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteRegionsRegionWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteReservationsReservationWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteReservationsReservationResponse, error) {
+	f.record("DeleteReservationsReservationWithResponse")
+	if f.DeleteReservationsReservationWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteReservationsReservationWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteReservationsReservationWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteReturnReasonWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteReturnReasonResponse, error) {
+	f.record("DeleteReturnReasonWithResponse")
+	if f.DeleteReturnReasonWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteReturnReasonWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteReturnReasonWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteSalesChannelsChannelProductsBatchWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteSalesChannelsChannelProductsBatchResponse, error) {
+	f.record("DeleteSalesChannelsChannelProductsBatchWithBodyWithResponse")
+	if f.DeleteSalesChannelsChannelProductsBatchWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteSalesChannelsChannelProductsBatchWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteSalesChannelsChannelProductsBatchWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteSalesChannelsChannelProductsBatchWithResponse(ctx context.Context, id string, body medusa.DeleteSalesChannelsChannelProductsBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteSalesChannelsChannelProductsBatchResponse, error) {
+	f.record("DeleteSalesChannelsChannelProductsBatchWithResponse")
+	if f.DeleteSalesChannelsChannelProductsBatchWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteSalesChannelsChannelProductsBatchWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteSalesChannelsChannelProductsBatchWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteSalesChannelsSalesChannelStockLocationWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteSalesChannelsSalesChannelStockLocationResponse, error) {
+	f.record("DeleteSalesChannelsSalesChannelStockLocationWithBodyWithResponse")
+	if f.DeleteSalesChannelsSalesChannelStockLocationWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteSalesChannelsSalesChannelStockLocationWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteSalesChannelsSalesChannelStockLocationWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteSalesChannelsSalesChannelStockLocationWithResponse(ctx context.Context, id string, body medusa.DeleteSalesChannelsSalesChannelStockLocationJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteSalesChannelsSalesChannelStockLocationResponse, error) {
+	f.record("DeleteSalesChannelsSalesChannelStockLocationWithResponse")
+	if f.DeleteSalesChannelsSalesChannelStockLocationWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteSalesChannelsSalesChannelStockLocationWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteSalesChannelsSalesChannelStockLocationWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteSalesChannelsSalesChannelWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteSalesChannelsSalesChannelResponse, error) {
+	f.record("DeleteSalesChannelsSalesChannelWithResponse")
+	if f.DeleteSalesChannelsSalesChannelWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteSalesChannelsSalesChannelWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteSalesChannelsSalesChannelWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteShippingOptionsOptionWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteShippingOptionsOptionResponse, error) {
+	f.record("DeleteShippingOptionsOptionWithResponse")
+	if f.DeleteShippingOptionsOptionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteShippingOptionsOptionWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteShippingOptionsOptionWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteShippingProfilesProfileWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteShippingProfilesProfileResponse, error) {
+	f.record("DeleteShippingProfilesProfileWithResponse")
+	if f.DeleteShippingProfilesProfileWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteShippingProfilesProfileWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteShippingProfilesProfileWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteStockLocationsStockLocationWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteStockLocationsStockLocationResponse, error) {
+	f.record("DeleteStockLocationsStockLocationWithResponse")
+	if f.DeleteStockLocationsStockLocationWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteStockLocationsStockLocationWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteStockLocationsStockLocationWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteStoreCurrenciesCodeWithResponse(ctx context.Context, code string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteStoreCurrenciesCodeResponse, error) {
+	f.record("DeleteStoreCurrenciesCodeWithResponse")
+	if f.DeleteStoreCurrenciesCodeWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteStoreCurrenciesCodeWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteStoreCurrenciesCodeWithResponseFunc(ctx, code, reqEditors...)
+}
+
+func (f *FakeClient) DeleteTaxRatesTaxRateProductTypesWithBodyWithResponse(ctx context.Context, id string, params *medusa.DeleteTaxRatesTaxRateProductTypesParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteTaxRatesTaxRateProductTypesResponse, error) {
+	f.record("DeleteTaxRatesTaxRateProductTypesWithBodyWithResponse")
+	if f.DeleteTaxRatesTaxRateProductTypesWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteTaxRatesTaxRateProductTypesWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteTaxRatesTaxRateProductTypesWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteTaxRatesTaxRateProductTypesWithResponse(ctx context.Context, id string, params *medusa.DeleteTaxRatesTaxRateProductTypesParams, body medusa.DeleteTaxRatesTaxRateProductTypesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteTaxRatesTaxRateProductTypesResponse, error) {
+	f.record("DeleteTaxRatesTaxRateProductTypesWithResponse")
+	if f.DeleteTaxRatesTaxRateProductTypesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteTaxRatesTaxRateProductTypesWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteTaxRatesTaxRateProductTypesWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteTaxRatesTaxRateProductsWithBodyWithResponse(ctx context.Context, id string, params *medusa.DeleteTaxRatesTaxRateProductsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteTaxRatesTaxRateProductsResponse, error) {
+	f.record("DeleteTaxRatesTaxRateProductsWithBodyWithResponse")
+	if f.DeleteTaxRatesTaxRateProductsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteTaxRatesTaxRateProductsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteTaxRatesTaxRateProductsWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteTaxRatesTaxRateProductsWithResponse(ctx context.Context, id string, params *medusa.DeleteTaxRatesTaxRateProductsParams, body medusa.DeleteTaxRatesTaxRateProductsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteTaxRatesTaxRateProductsResponse, error) {
+	f.record("DeleteTaxRatesTaxRateProductsWithResponse")
+	if f.DeleteTaxRatesTaxRateProductsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteTaxRatesTaxRateProductsWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteTaxRatesTaxRateProductsWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteTaxRatesTaxRateShippingOptionsWithBodyWithResponse(ctx context.Context, id string, params *medusa.DeleteTaxRatesTaxRateShippingOptionsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteTaxRatesTaxRateShippingOptionsResponse, error) {
+	f.record("DeleteTaxRatesTaxRateShippingOptionsWithBodyWithResponse")
+	if f.DeleteTaxRatesTaxRateShippingOptionsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteTaxRatesTaxRateShippingOptionsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteTaxRatesTaxRateShippingOptionsWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteTaxRatesTaxRateShippingOptionsWithResponse(ctx context.Context, id string, params *medusa.DeleteTaxRatesTaxRateShippingOptionsParams, body medusa.DeleteTaxRatesTaxRateShippingOptionsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteTaxRatesTaxRateShippingOptionsResponse, error) {
+	f.record("DeleteTaxRatesTaxRateShippingOptionsWithResponse")
+	if f.DeleteTaxRatesTaxRateShippingOptionsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteTaxRatesTaxRateShippingOptionsWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteTaxRatesTaxRateShippingOptionsWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteTaxRatesTaxRateWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteTaxRatesTaxRateResponse, error) {
+	f.record("DeleteTaxRatesTaxRateWithResponse")
+	if f.DeleteTaxRatesTaxRateWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteTaxRatesTaxRateWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteTaxRatesTaxRateWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) DeleteUploadsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteUploadsResponse, error) {
+	f.record("DeleteUploadsWithBodyWithResponse")
+	if f.DeleteUploadsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteUploadsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteUploadsWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteUploadsWithResponse(ctx context.Context, body medusa.DeleteUploadsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteUploadsResponse, error) {
+	f.record("DeleteUploadsWithResponse")
+	if f.DeleteUploadsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteUploadsWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteUploadsWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) DeleteUsersUserWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.DeleteUsersUserResponse, error) {
+	f.record("DeleteUsersUserWithResponse")
+	if f.DeleteUsersUserWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: DeleteUsersUserWithResponse", ErrNotStubbed)
+	}
+	return f.DeleteUsersUserWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) FeatureFlags(ctx context.Context) (map[string]bool, error) {
+	f.record("FeatureFlags")
+	if f.FeatureFlagsFunc == nil {
+		return nil, fmt.Errorf("%w: FeatureFlags", ErrNotStubbed)
+	}
+	return f.FeatureFlagsFunc(ctx)
+}
+
+func (f *FakeClient) GetAppsWithResponse(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetAppsResponse, error) {
+	f.record("GetAppsWithResponse")
+	if f.GetAppsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetAppsWithResponse", ErrNotStubbed)
+	}
+	return f.GetAppsWithResponseFunc(ctx, reqEditors...)
+}
+
+func (f *FakeClient) GetAuthWithResponse(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetAuthResponse, error) {
+	f.record("GetAuthWithResponse")
+	if f.GetAuthWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetAuthWithResponse", ErrNotStubbed)
+	}
+	return f.GetAuthWithResponseFunc(ctx, reqEditors...)
+}
+
+func (f *FakeClient) GetBatchJobsBatchJobWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetBatchJobsBatchJobResponse, error) {
+	f.record("GetBatchJobsBatchJobWithResponse")
+	if f.GetBatchJobsBatchJobWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetBatchJobsBatchJobWithResponse", ErrNotStubbed)
+	}
+	return f.GetBatchJobsBatchJobWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetBatchJobsWithResponse(ctx context.Context, params *medusa.GetBatchJobsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetBatchJobsResponse, error) {
+	f.record("GetBatchJobsWithResponse")
+	if f.GetBatchJobsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetBatchJobsWithResponse", ErrNotStubbed)
+	}
+	return f.GetBatchJobsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetCollectionsCollectionWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCollectionsCollectionResponse, error) {
+	f.record("GetCollectionsCollectionWithResponse")
+	if f.GetCollectionsCollectionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetCollectionsCollectionWithResponse", ErrNotStubbed)
+	}
+	return f.GetCollectionsCollectionWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetCollectionsWithResponse(ctx context.Context, params *medusa.GetCollectionsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCollectionsResponse, error) {
+	f.record("GetCollectionsWithResponse")
+	if f.GetCollectionsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetCollectionsWithResponse", ErrNotStubbed)
+	}
+	return f.GetCollectionsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetCurrenciesWithResponse(ctx context.Context, params *medusa.GetCurrenciesParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCurrenciesResponse, error) {
+	f.record("GetCurrenciesWithResponse")
+	if f.GetCurrenciesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetCurrenciesWithResponse", ErrNotStubbed)
+	}
+	return f.GetCurrenciesWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetCustomerGroupsGroupCustomersWithResponse(ctx context.Context, id string, params *medusa.GetCustomerGroupsGroupCustomersParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCustomerGroupsGroupCustomersResponse, error) {
+	f.record("GetCustomerGroupsGroupCustomersWithResponse")
+	if f.GetCustomerGroupsGroupCustomersWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetCustomerGroupsGroupCustomersWithResponse", ErrNotStubbed)
+	}
+	return f.GetCustomerGroupsGroupCustomersWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetCustomerGroupsGroupWithResponse(ctx context.Context, id string, params *medusa.GetCustomerGroupsGroupParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCustomerGroupsGroupResponse, error) {
+	f.record("GetCustomerGroupsGroupWithResponse")
+	if f.GetCustomerGroupsGroupWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetCustomerGroupsGroupWithResponse", ErrNotStubbed)
+	}
+	return f.GetCustomerGroupsGroupWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetCustomerGroupsWithResponse(ctx context.Context, params *medusa.GetCustomerGroupsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCustomerGroupsResponse, error) {
+	f.record("GetCustomerGroupsWithResponse")
+	if f.GetCustomerGroupsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetCustomerGroupsWithResponse", ErrNotStubbed)
+	}
+	return f.GetCustomerGroupsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetCustomersCustomerWithResponse(ctx context.Context, id string, params *medusa.GetCustomersCustomerParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCustomersCustomerResponse, error) {
+	f.record("GetCustomersCustomerWithResponse")
+	if f.GetCustomersCustomerWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetCustomersCustomerWithResponse", ErrNotStubbed)
+	}
+	return f.GetCustomersCustomerWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetCustomersWithResponse(ctx context.Context, params *medusa.GetCustomersParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetCustomersResponse, error) {
+	f.record("GetCustomersWithResponse")
+	if f.GetCustomersWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetCustomersWithResponse", ErrNotStubbed)
+	}
+	return f.GetCustomersWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetDiscountsDiscountCodeWithResponse(ctx context.Context, code string, params *medusa.GetDiscountsDiscountCodeParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetDiscountsDiscountCodeResponse, error) {
+	f.record("GetDiscountsDiscountCodeWithResponse")
+	if f.GetDiscountsDiscountCodeWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetDiscountsDiscountCodeWithResponse", ErrNotStubbed)
+	}
+	return f.GetDiscountsDiscountCodeWithResponseFunc(ctx, code, params, reqEditors...)
+}
+
+func (f *FakeClient) GetDiscountsDiscountConditionsConditionWithResponse(ctx context.Context, discountId string, conditionId string, params *medusa.GetDiscountsDiscountConditionsConditionParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetDiscountsDiscountConditionsConditionResponse, error) {
+	f.record("GetDiscountsDiscountConditionsConditionWithResponse")
+	if f.GetDiscountsDiscountConditionsConditionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetDiscountsDiscountConditionsConditionWithResponse", ErrNotStubbed)
+	}
+	return f.GetDiscountsDiscountConditionsConditionWithResponseFunc(ctx, discountId, conditionId, params, reqEditors...)
+}
+
+func (f *FakeClient) GetDiscountsDiscountWithResponse(ctx context.Context, id string, params *medusa.GetDiscountsDiscountParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetDiscountsDiscountResponse, error) {
+	f.record("GetDiscountsDiscountWithResponse")
+	if f.GetDiscountsDiscountWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetDiscountsDiscountWithResponse", ErrNotStubbed)
+	}
+	return f.GetDiscountsDiscountWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetDiscountsWithResponse(ctx context.Context, params *medusa.GetDiscountsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetDiscountsResponse, error) {
+	f.record("GetDiscountsWithResponse")
+	if f.GetDiscountsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetDiscountsWithResponse", ErrNotStubbed)
+	}
+	return f.GetDiscountsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetDraftOrdersDraftOrderWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetDraftOrdersDraftOrderResponse, error) {
+	f.record("GetDraftOrdersDraftOrderWithResponse")
+	if f.GetDraftOrdersDraftOrderWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetDraftOrdersDraftOrderWithResponse", ErrNotStubbed)
+	}
+	return f.GetDraftOrdersDraftOrderWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetDraftOrdersWithResponse(ctx context.Context, params *medusa.GetDraftOrdersParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetDraftOrdersResponse, error) {
+	f.record("GetDraftOrdersWithResponse")
+	if f.GetDraftOrdersWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetDraftOrdersWithResponse", ErrNotStubbed)
+	}
+	return f.GetDraftOrdersWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetGiftCardsGiftCardWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetGiftCardsGiftCardResponse, error) {
+	f.record("GetGiftCardsGiftCardWithResponse")
+	if f.GetGiftCardsGiftCardWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetGiftCardsGiftCardWithResponse", ErrNotStubbed)
+	}
+	return f.GetGiftCardsGiftCardWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetGiftCardsWithResponse(ctx context.Context, params *medusa.GetGiftCardsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetGiftCardsResponse, error) {
+	f.record("GetGiftCardsWithResponse")
+	if f.GetGiftCardsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetGiftCardsWithResponse", ErrNotStubbed)
+	}
+	return f.GetGiftCardsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetInventoryItemsInventoryItemLocationLevelsWithResponse(ctx context.Context, id string, params *medusa.GetInventoryItemsInventoryItemLocationLevelsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetInventoryItemsInventoryItemLocationLevelsResponse, error) {
+	f.record("GetInventoryItemsInventoryItemLocationLevelsWithResponse")
+	if f.GetInventoryItemsInventoryItemLocationLevelsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetInventoryItemsInventoryItemLocationLevelsWithResponse", ErrNotStubbed)
+	}
+	return f.GetInventoryItemsInventoryItemLocationLevelsWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetInventoryItemsInventoryItemWithResponse(ctx context.Context, id string, params *medusa.GetInventoryItemsInventoryItemParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetInventoryItemsInventoryItemResponse, error) {
+	f.record("GetInventoryItemsInventoryItemWithResponse")
+	if f.GetInventoryItemsInventoryItemWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetInventoryItemsInventoryItemWithResponse", ErrNotStubbed)
+	}
+	return f.GetInventoryItemsInventoryItemWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetInventoryItemsWithResponse(ctx context.Context, params *medusa.GetInventoryItemsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetInventoryItemsResponse, error) {
+	f.record("GetInventoryItemsWithResponse")
+	if f.GetInventoryItemsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetInventoryItemsWithResponse", ErrNotStubbed)
+	}
+	return f.GetInventoryItemsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetInvitesWithResponse(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetInvitesResponse, error) {
+	f.record("GetInvitesWithResponse")
+	if f.GetInvitesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetInvitesWithResponse", ErrNotStubbed)
+	}
+	return f.GetInvitesWithResponseFunc(ctx, reqEditors...)
+}
+
+func (f *FakeClient) GetNotesNoteWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetNotesNoteResponse, error) {
+	f.record("GetNotesNoteWithResponse")
+	if f.GetNotesNoteWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetNotesNoteWithResponse", ErrNotStubbed)
+	}
+	return f.GetNotesNoteWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetNotesWithResponse(ctx context.Context, params *medusa.GetNotesParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetNotesResponse, error) {
+	f.record("GetNotesWithResponse")
+	if f.GetNotesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetNotesWithResponse", ErrNotStubbed)
+	}
+	return f.GetNotesWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetNotificationsWithResponse(ctx context.Context, params *medusa.GetNotificationsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetNotificationsResponse, error) {
+	f.record("GetNotificationsWithResponse")
+	if f.GetNotificationsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetNotificationsWithResponse", ErrNotStubbed)
+	}
+	return f.GetNotificationsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetOrderEditsOrderEditWithResponse(ctx context.Context, id string, params *medusa.GetOrderEditsOrderEditParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetOrderEditsOrderEditResponse, error) {
+	f.record("GetOrderEditsOrderEditWithResponse")
+	if f.GetOrderEditsOrderEditWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetOrderEditsOrderEditWithResponse", ErrNotStubbed)
+	}
+	return f.GetOrderEditsOrderEditWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetOrderEditsWithResponse(ctx context.Context, params *medusa.GetOrderEditsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetOrderEditsResponse, error) {
+	f.record("GetOrderEditsWithResponse")
+	if f.GetOrderEditsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetOrderEditsWithResponse", ErrNotStubbed)
+	}
+	return f.GetOrderEditsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetOrdersOrderReservationsWithResponse(ctx context.Context, id string, params *medusa.GetOrdersOrderReservationsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetOrdersOrderReservationsResponse, error) {
+	f.record("GetOrdersOrderReservationsWithResponse")
+	if f.GetOrdersOrderReservationsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetOrdersOrderReservationsWithResponse", ErrNotStubbed)
+	}
+	return f.GetOrdersOrderReservationsWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetOrdersOrderWithResponse(ctx context.Context, id string, params *medusa.GetOrdersOrderParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetOrdersOrderResponse, error) {
+	f.record("GetOrdersOrderWithResponse")
+	if f.GetOrdersOrderWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetOrdersOrderWithResponse", ErrNotStubbed)
+	}
+	return f.GetOrdersOrderWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetOrdersWithResponse(ctx context.Context, params *medusa.GetOrdersParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetOrdersResponse, error) {
+	f.record("GetOrdersWithResponse")
+	if f.GetOrdersWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetOrdersWithResponse", ErrNotStubbed)
+	}
+	return f.GetOrdersWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetPaymentCollectionsPaymentCollectionWithResponse(ctx context.Context, id string, params *medusa.GetPaymentCollectionsPaymentCollectionParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPaymentCollectionsPaymentCollectionResponse, error) {
+	f.record("GetPaymentCollectionsPaymentCollectionWithResponse")
+	if f.GetPaymentCollectionsPaymentCollectionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetPaymentCollectionsPaymentCollectionWithResponse", ErrNotStubbed)
+	}
+	return f.GetPaymentCollectionsPaymentCollectionWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetPaymentsPaymentWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPaymentsPaymentResponse, error) {
+	f.record("GetPaymentsPaymentWithResponse")
+	if f.GetPaymentsPaymentWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetPaymentsPaymentWithResponse", ErrNotStubbed)
+	}
+	return f.GetPaymentsPaymentWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetPriceListsPriceListProductsWithResponse(ctx context.Context, id string, params *medusa.GetPriceListsPriceListProductsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPriceListsPriceListProductsResponse, error) {
+	f.record("GetPriceListsPriceListProductsWithResponse")
+	if f.GetPriceListsPriceListProductsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetPriceListsPriceListProductsWithResponse", ErrNotStubbed)
+	}
+	return f.GetPriceListsPriceListProductsWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetPriceListsPriceListWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPriceListsPriceListResponse, error) {
+	f.record("GetPriceListsPriceListWithResponse")
+	if f.GetPriceListsPriceListWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetPriceListsPriceListWithResponse", ErrNotStubbed)
+	}
+	return f.GetPriceListsPriceListWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetPriceListsWithResponse(ctx context.Context, params *medusa.GetPriceListsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPriceListsResponse, error) {
+	f.record("GetPriceListsWithResponse")
+	if f.GetPriceListsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetPriceListsWithResponse", ErrNotStubbed)
+	}
+	return f.GetPriceListsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetProductCategoriesCategoryWithResponse(ctx context.Context, id string, params *medusa.GetProductCategoriesCategoryParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductCategoriesCategoryResponse, error) {
+	f.record("GetProductCategoriesCategoryWithResponse")
+	if f.GetProductCategoriesCategoryWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetProductCategoriesCategoryWithResponse", ErrNotStubbed)
+	}
+	return f.GetProductCategoriesCategoryWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetProductCategoriesWithResponse(ctx context.Context, params *medusa.GetProductCategoriesParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductCategoriesResponse, error) {
+	f.record("GetProductCategoriesWithResponse")
+	if f.GetProductCategoriesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetProductCategoriesWithResponse", ErrNotStubbed)
+	}
+	return f.GetProductCategoriesWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetProductTagsWithResponse(ctx context.Context, params *medusa.GetProductTagsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductTagsResponse, error) {
+	f.record("GetProductTagsWithResponse")
+	if f.GetProductTagsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetProductTagsWithResponse", ErrNotStubbed)
+	}
+	return f.GetProductTagsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetProductTypesWithResponse(ctx context.Context, params *medusa.GetProductTypesParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductTypesResponse, error) {
+	f.record("GetProductTypesWithResponse")
+	if f.GetProductTypesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetProductTypesWithResponse", ErrNotStubbed)
+	}
+	return f.GetProductTypesWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetProductsProductVariantsWithResponse(ctx context.Context, id string, params *medusa.GetProductsProductVariantsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductsProductVariantsResponse, error) {
+	f.record("GetProductsProductVariantsWithResponse")
+	if f.GetProductsProductVariantsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetProductsProductVariantsWithResponse", ErrNotStubbed)
+	}
+	return f.GetProductsProductVariantsWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetProductsProductWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductsProductResponse, error) {
+	f.record("GetProductsProductWithResponse")
+	if f.GetProductsProductWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetProductsProductWithResponse", ErrNotStubbed)
+	}
+	return f.GetProductsProductWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetProductsTagUsageWithResponse(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductsTagUsageResponse, error) {
+	f.record("GetProductsTagUsageWithResponse")
+	if f.GetProductsTagUsageWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetProductsTagUsageWithResponse", ErrNotStubbed)
+	}
+	return f.GetProductsTagUsageWithResponseFunc(ctx, reqEditors...)
+}
+
+func (f *FakeClient) GetProductsTypesWithResponse(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductsTypesResponse, error) {
+	f.record("GetProductsTypesWithResponse")
+	if f.GetProductsTypesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetProductsTypesWithResponse", ErrNotStubbed)
+	}
+	return f.GetProductsTypesWithResponseFunc(ctx, reqEditors...)
+}
+
+func (f *FakeClient) GetProductsWithResponse(ctx context.Context, params *medusa.GetProductsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetProductsResponse, error) {
+	f.record("GetProductsWithResponse")
+	if f.GetProductsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetProductsWithResponse", ErrNotStubbed)
+	}
+	return f.GetProductsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetPublishableApiKeySalesChannelsWithResponse(ctx context.Context, id string, params *medusa.GetPublishableApiKeySalesChannelsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPublishableApiKeySalesChannelsResponse, error) {
+	f.record("GetPublishableApiKeySalesChannelsWithResponse")
+	if f.GetPublishableApiKeySalesChannelsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetPublishableApiKeySalesChannelsWithResponse", ErrNotStubbed)
+	}
+	return f.GetPublishableApiKeySalesChannelsWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetPublishableApiKeysPublishableApiKeyWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPublishableApiKeysPublishableApiKeyResponse, error) {
+	f.record("GetPublishableApiKeysPublishableApiKeyWithResponse")
+	if f.GetPublishableApiKeysPublishableApiKeyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetPublishableApiKeysPublishableApiKeyWithResponse", ErrNotStubbed)
+	}
+	return f.GetPublishableApiKeysPublishableApiKeyWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetPublishableApiKeysWithResponse(ctx context.Context, params *medusa.GetPublishableApiKeysParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetPublishableApiKeysResponse, error) {
+	f.record("GetPublishableApiKeysWithResponse")
+	if f.GetPublishableApiKeysWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetPublishableApiKeysWithResponse", ErrNotStubbed)
+	}
+	return f.GetPublishableApiKeysWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetRegionsRegionFulfillmentOptionsWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetRegionsRegionFulfillmentOptionsResponse, error) {
+	f.record("GetRegionsRegionFulfillmentOptionsWithResponse")
+	if f.GetRegionsRegionFulfillmentOptionsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetRegionsRegionFulfillmentOptionsWithResponse", ErrNotStubbed)
+	}
+	return f.GetRegionsRegionFulfillmentOptionsWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetRegionsRegionWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetRegionsRegionResponse, error) {
+	f.record("GetRegionsRegionWithResponse")
+	if f.GetRegionsRegionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetRegionsRegionWithResponse", ErrNotStubbed)
+	}
+	return f.GetRegionsRegionWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetRegionsWithResponse(ctx context.Context, params *medusa.GetRegionsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetRegionsResponse, error) {
+	f.record("GetRegionsWithResponse")
+	if f.GetRegionsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetRegionsWithResponse", ErrNotStubbed)
+	}
+	return f.GetRegionsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetReservationsReservationWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetReservationsReservationResponse, error) {
+	f.record("GetReservationsReservationWithResponse")
+	if f.GetReservationsReservationWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetReservationsReservationWithResponse", ErrNotStubbed)
+	}
+	return f.GetReservationsReservationWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetReservationsWithResponse(ctx context.Context, params *medusa.GetReservationsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetReservationsResponse, error) {
+	f.record("GetReservationsWithResponse")
+	if f.GetReservationsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetReservationsWithResponse", ErrNotStubbed)
+	}
+	return f.GetReservationsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetReturnReasonsReasonWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetReturnReasonsReasonResponse, error) {
+	f.record("GetReturnReasonsReasonWithResponse")
+	if f.GetReturnReasonsReasonWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetReturnReasonsReasonWithResponse", ErrNotStubbed)
+	}
+	return f.GetReturnReasonsReasonWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetReturnReasonsWithResponse(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetReturnReasonsResponse, error) {
+	f.record("GetReturnReasonsWithResponse")
+	if f.GetReturnReasonsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetReturnReasonsWithResponse", ErrNotStubbed)
+	}
+	return f.GetReturnReasonsWithResponseFunc(ctx, reqEditors...)
+}
+
+func (f *FakeClient) GetReturnsWithResponse(ctx context.Context, params *medusa.GetReturnsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetReturnsResponse, error) {
+	f.record("GetReturnsWithResponse")
+	if f.GetReturnsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetReturnsWithResponse", ErrNotStubbed)
+	}
+	return f.GetReturnsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetSalesChannelsSalesChannelWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetSalesChannelsSalesChannelResponse, error) {
+	f.record("GetSalesChannelsSalesChannelWithResponse")
+	if f.GetSalesChannelsSalesChannelWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetSalesChannelsSalesChannelWithResponse", ErrNotStubbed)
+	}
+	return f.GetSalesChannelsSalesChannelWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetSalesChannelsWithResponse(ctx context.Context, params *medusa.GetSalesChannelsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetSalesChannelsResponse, error) {
+	f.record("GetSalesChannelsWithResponse")
+	if f.GetSalesChannelsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetSalesChannelsWithResponse", ErrNotStubbed)
+	}
+	return f.GetSalesChannelsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetShippingOptionsOptionWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetShippingOptionsOptionResponse, error) {
+	f.record("GetShippingOptionsOptionWithResponse")
+	if f.GetShippingOptionsOptionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetShippingOptionsOptionWithResponse", ErrNotStubbed)
+	}
+	return f.GetShippingOptionsOptionWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetShippingOptionsWithResponse(ctx context.Context, params *medusa.GetShippingOptionsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetShippingOptionsResponse, error) {
+	f.record("GetShippingOptionsWithResponse")
+	if f.GetShippingOptionsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetShippingOptionsWithResponse", ErrNotStubbed)
+	}
+	return f.GetShippingOptionsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetShippingProfilesProfileWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetShippingProfilesProfileResponse, error) {
+	f.record("GetShippingProfilesProfileWithResponse")
+	if f.GetShippingProfilesProfileWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetShippingProfilesProfileWithResponse", ErrNotStubbed)
+	}
+	return f.GetShippingProfilesProfileWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetShippingProfilesWithResponse(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetShippingProfilesResponse, error) {
+	f.record("GetShippingProfilesWithResponse")
+	if f.GetShippingProfilesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetShippingProfilesWithResponse", ErrNotStubbed)
+	}
+	return f.GetShippingProfilesWithResponseFunc(ctx, reqEditors...)
+}
+
+func (f *FakeClient) GetStockLocationsStockLocationWithResponse(ctx context.Context, id string, params *medusa.GetStockLocationsStockLocationParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetStockLocationsStockLocationResponse, error) {
+	f.record("GetStockLocationsStockLocationWithResponse")
+	if f.GetStockLocationsStockLocationWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetStockLocationsStockLocationWithResponse", ErrNotStubbed)
+	}
+	return f.GetStockLocationsStockLocationWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetStockLocationsWithResponse(ctx context.Context, params *medusa.GetStockLocationsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetStockLocationsResponse, error) {
+	f.record("GetStockLocationsWithResponse")
+	if f.GetStockLocationsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetStockLocationsWithResponse", ErrNotStubbed)
+	}
+	return f.GetStockLocationsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetStorePaymentProvidersWithResponse(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetStorePaymentProvidersResponse, error) {
+	f.record("GetStorePaymentProvidersWithResponse")
+	if f.GetStorePaymentProvidersWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetStorePaymentProvidersWithResponse", ErrNotStubbed)
+	}
+	return f.GetStorePaymentProvidersWithResponseFunc(ctx, reqEditors...)
+}
+
+func (f *FakeClient) GetStoreTaxProvidersWithResponse(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetStoreTaxProvidersResponse, error) {
+	f.record("GetStoreTaxProvidersWithResponse")
+	if f.GetStoreTaxProvidersWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetStoreTaxProvidersWithResponse", ErrNotStubbed)
+	}
+	return f.GetStoreTaxProvidersWithResponseFunc(ctx, reqEditors...)
+}
+
+func (f *FakeClient) GetStoreWithResponse(ctx context.Context, reqEditors ...medusa.RequestEditorFn) (*medusa.GetStoreResponse, error) {
+	f.record("GetStoreWithResponse")
+	if f.GetStoreWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetStoreWithResponse", ErrNotStubbed)
+	}
+	return f.GetStoreWithResponseFunc(ctx, reqEditors...)
+}
+
+func (f *FakeClient) GetSwapsSwapWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetSwapsSwapResponse, error) {
+	f.record("GetSwapsSwapWithResponse")
+	if f.GetSwapsSwapWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetSwapsSwapWithResponse", ErrNotStubbed)
+	}
+	return f.GetSwapsSwapWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetSwapsWithResponse(ctx context.Context, params *medusa.GetSwapsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetSwapsResponse, error) {
+	f.record("GetSwapsWithResponse")
+	if f.GetSwapsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetSwapsWithResponse", ErrNotStubbed)
+	}
+	return f.GetSwapsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetTaxRatesTaxRateWithResponse(ctx context.Context, id string, params *medusa.GetTaxRatesTaxRateParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetTaxRatesTaxRateResponse, error) {
+	f.record("GetTaxRatesTaxRateWithResponse")
+	if f.GetTaxRatesTaxRateWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetTaxRatesTaxRateWithResponse", ErrNotStubbed)
+	}
+	return f.GetTaxRatesTaxRateWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetTaxRatesWithResponse(ctx context.Context, params *medusa.GetTaxRatesParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetTaxRatesResponse, error) {
+	f.record("GetTaxRatesWithResponse")
+	if f.GetTaxRatesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetTaxRatesWithResponse", ErrNotStubbed)
+	}
+	return f.GetTaxRatesWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetUsersUserWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetUsersUserResponse, error) {
+	f.record("GetUsersUserWithResponse")
+	if f.GetUsersUserWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetUsersUserWithResponse", ErrNotStubbed)
+	}
+	return f.GetUsersUserWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetUsersWithResponse(ctx context.Context, params *medusa.GetUsersParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetUsersResponse, error) {
+	f.record("GetUsersWithResponse")
+	if f.GetUsersWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetUsersWithResponse", ErrNotStubbed)
+	}
+	return f.GetUsersWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) GetVariantsVariantInventoryWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.GetVariantsVariantInventoryResponse, error) {
+	f.record("GetVariantsVariantInventoryWithResponse")
+	if f.GetVariantsVariantInventoryWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetVariantsVariantInventoryWithResponse", ErrNotStubbed)
+	}
+	return f.GetVariantsVariantInventoryWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) GetVariantsVariantWithResponse(ctx context.Context, id string, params *medusa.GetVariantsVariantParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetVariantsVariantResponse, error) {
+	f.record("GetVariantsVariantWithResponse")
+	if f.GetVariantsVariantWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetVariantsVariantWithResponse", ErrNotStubbed)
+	}
+	return f.GetVariantsVariantWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) GetVariantsWithResponse(ctx context.Context, params *medusa.GetVariantsParams, reqEditors ...medusa.RequestEditorFn) (*medusa.GetVariantsResponse, error) {
+	f.record("GetVariantsWithResponse")
+	if f.GetVariantsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: GetVariantsWithResponse", ErrNotStubbed)
+	}
+	return f.GetVariantsWithResponseFunc(ctx, params, reqEditors...)
+}
+
+func (f *FakeClient) PostAppsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostAppsResponse, error) {
+	f.record("PostAppsWithBodyWithResponse")
+	if f.PostAppsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostAppsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostAppsWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostAppsWithResponse(ctx context.Context, body medusa.PostAppsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostAppsResponse, error) {
+	f.record("PostAppsWithResponse")
+	if f.PostAppsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostAppsWithResponse", ErrNotStubbed)
+	}
+	return f.PostAppsWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostAuthWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostAuthResponse, error) {
+	f.record("PostAuthWithBodyWithResponse")
+	if f.PostAuthWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostAuthWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostAuthWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostAuthWithResponse(ctx context.Context, body medusa.PostAuthJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostAuthResponse, error) {
+	f.record("PostAuthWithResponse")
+	if f.PostAuthWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostAuthWithResponse", ErrNotStubbed)
+	}
+	return f.PostAuthWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostBatchJobsBatchJobCancelWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostBatchJobsBatchJobCancelResponse, error) {
+	f.record("PostBatchJobsBatchJobCancelWithResponse")
+	if f.PostBatchJobsBatchJobCancelWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostBatchJobsBatchJobCancelWithResponse", ErrNotStubbed)
+	}
+	return f.PostBatchJobsBatchJobCancelWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) PostBatchJobsBatchJobConfirmProcessingWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostBatchJobsBatchJobConfirmProcessingResponse, error) {
+	f.record("PostBatchJobsBatchJobConfirmProcessingWithResponse")
+	if f.PostBatchJobsBatchJobConfirmProcessingWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostBatchJobsBatchJobConfirmProcessingWithResponse", ErrNotStubbed)
+	}
+	return f.PostBatchJobsBatchJobConfirmProcessingWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) PostBatchJobsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostBatchJobsResponse, error) {
+	f.record("PostBatchJobsWithBodyWithResponse")
+	if f.PostBatchJobsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostBatchJobsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostBatchJobsWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostBatchJobsWithResponse(ctx context.Context, body medusa.PostBatchJobsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostBatchJobsResponse, error) {
+	f.record("PostBatchJobsWithResponse")
+	if f.PostBatchJobsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostBatchJobsWithResponse", ErrNotStubbed)
+	}
+	return f.PostBatchJobsWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCollectionsCollectionWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCollectionsCollectionResponse, error) {
+	f.record("PostCollectionsCollectionWithBodyWithResponse")
+	if f.PostCollectionsCollectionWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCollectionsCollectionWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostCollectionsCollectionWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCollectionsCollectionWithResponse(ctx context.Context, id string, body medusa.PostCollectionsCollectionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCollectionsCollectionResponse, error) {
+	f.record("PostCollectionsCollectionWithResponse")
+	if f.PostCollectionsCollectionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCollectionsCollectionWithResponse", ErrNotStubbed)
+	}
+	return f.PostCollectionsCollectionWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCollectionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCollectionsResponse, error) {
+	f.record("PostCollectionsWithBodyWithResponse")
+	if f.PostCollectionsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCollectionsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostCollectionsWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCollectionsWithResponse(ctx context.Context, body medusa.PostCollectionsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCollectionsResponse, error) {
+	f.record("PostCollectionsWithResponse")
+	if f.PostCollectionsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCollectionsWithResponse", ErrNotStubbed)
+	}
+	return f.PostCollectionsWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCurrenciesCurrencyWithBodyWithResponse(ctx context.Context, code string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCurrenciesCurrencyResponse, error) {
+	f.record("PostCurrenciesCurrencyWithBodyWithResponse")
+	if f.PostCurrenciesCurrencyWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCurrenciesCurrencyWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostCurrenciesCurrencyWithBodyWithResponseFunc(ctx, code, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCurrenciesCurrencyWithResponse(ctx context.Context, code string, body medusa.PostCurrenciesCurrencyJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCurrenciesCurrencyResponse, error) {
+	f.record("PostCurrenciesCurrencyWithResponse")
+	if f.PostCurrenciesCurrencyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCurrenciesCurrencyWithResponse", ErrNotStubbed)
+	}
+	return f.PostCurrenciesCurrencyWithResponseFunc(ctx, code, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCustomerGroupsGroupCustomersBatchWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomerGroupsGroupCustomersBatchResponse, error) {
+	f.record("PostCustomerGroupsGroupCustomersBatchWithBodyWithResponse")
+	if f.PostCustomerGroupsGroupCustomersBatchWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCustomerGroupsGroupCustomersBatchWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostCustomerGroupsGroupCustomersBatchWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCustomerGroupsGroupCustomersBatchWithResponse(ctx context.Context, id string, body medusa.PostCustomerGroupsGroupCustomersBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomerGroupsGroupCustomersBatchResponse, error) {
+	f.record("PostCustomerGroupsGroupCustomersBatchWithResponse")
+	if f.PostCustomerGroupsGroupCustomersBatchWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCustomerGroupsGroupCustomersBatchWithResponse", ErrNotStubbed)
+	}
+	return f.PostCustomerGroupsGroupCustomersBatchWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCustomerGroupsGroupWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomerGroupsGroupResponse, error) {
+	f.record("PostCustomerGroupsGroupWithBodyWithResponse")
+	if f.PostCustomerGroupsGroupWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCustomerGroupsGroupWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostCustomerGroupsGroupWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCustomerGroupsGroupWithResponse(ctx context.Context, id string, body medusa.PostCustomerGroupsGroupJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomerGroupsGroupResponse, error) {
+	f.record("PostCustomerGroupsGroupWithResponse")
+	if f.PostCustomerGroupsGroupWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCustomerGroupsGroupWithResponse", ErrNotStubbed)
+	}
+	return f.PostCustomerGroupsGroupWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCustomerGroupsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomerGroupsResponse, error) {
+	f.record("PostCustomerGroupsWithBodyWithResponse")
+	if f.PostCustomerGroupsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCustomerGroupsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostCustomerGroupsWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCustomerGroupsWithResponse(ctx context.Context, body medusa.PostCustomerGroupsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomerGroupsResponse, error) {
+	f.record("PostCustomerGroupsWithResponse")
+	if f.PostCustomerGroupsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCustomerGroupsWithResponse", ErrNotStubbed)
+	}
+	return f.PostCustomerGroupsWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCustomersCustomerWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostCustomersCustomerParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomersCustomerResponse, error) {
+	f.record("PostCustomersCustomerWithBodyWithResponse")
+	if f.PostCustomersCustomerWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCustomersCustomerWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostCustomersCustomerWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCustomersCustomerWithResponse(ctx context.Context, id string, params *medusa.PostCustomersCustomerParams, body medusa.PostCustomersCustomerJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomersCustomerResponse, error) {
+	f.record("PostCustomersCustomerWithResponse")
+	if f.PostCustomersCustomerWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCustomersCustomerWithResponse", ErrNotStubbed)
+	}
+	return f.PostCustomersCustomerWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCustomersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomersResponse, error) {
+	f.record("PostCustomersWithBodyWithResponse")
+	if f.PostCustomersWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCustomersWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostCustomersWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostCustomersWithResponse(ctx context.Context, body medusa.PostCustomersJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostCustomersResponse, error) {
+	f.record("PostCustomersWithResponse")
+	if f.PostCustomersWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostCustomersWithResponse", ErrNotStubbed)
+	}
+	return f.PostCustomersWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDiscountsDiscountConditionsConditionBatchWithBodyWithResponse(ctx context.Context, discountId string, conditionId string, params *medusa.PostDiscountsDiscountConditionsConditionBatchParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountConditionsConditionBatchResponse, error) {
+	f.record("PostDiscountsDiscountConditionsConditionBatchWithBodyWithResponse")
+	if f.PostDiscountsDiscountConditionsConditionBatchWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDiscountsDiscountConditionsConditionBatchWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostDiscountsDiscountConditionsConditionBatchWithBodyWithResponseFunc(ctx, discountId, conditionId, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDiscountsDiscountConditionsConditionBatchWithResponse(ctx context.Context, discountId string, conditionId string, params *medusa.PostDiscountsDiscountConditionsConditionBatchParams, body medusa.PostDiscountsDiscountConditionsConditionBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountConditionsConditionBatchResponse, error) {
+	f.record("PostDiscountsDiscountConditionsConditionBatchWithResponse")
+	if f.PostDiscountsDiscountConditionsConditionBatchWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDiscountsDiscountConditionsConditionBatchWithResponse", ErrNotStubbed)
+	}
+	return f.PostDiscountsDiscountConditionsConditionBatchWithResponseFunc(ctx, discountId, conditionId, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDiscountsDiscountConditionsConditionWithBodyWithResponse(ctx context.Context, discountId string, conditionId string, params *medusa.PostDiscountsDiscountConditionsConditionParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountConditionsConditionResponse, error) {
+	f.record("PostDiscountsDiscountConditionsConditionWithBodyWithResponse")
+	if f.PostDiscountsDiscountConditionsConditionWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDiscountsDiscountConditionsConditionWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostDiscountsDiscountConditionsConditionWithBodyWithResponseFunc(ctx, discountId, conditionId, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDiscountsDiscountConditionsConditionWithResponse(ctx context.Context, discountId string, conditionId string, params *medusa.PostDiscountsDiscountConditionsConditionParams, body medusa.PostDiscountsDiscountConditionsConditionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountConditionsConditionResponse, error) {
+	f.record("PostDiscountsDiscountConditionsConditionWithResponse")
+	if f.PostDiscountsDiscountConditionsConditionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDiscountsDiscountConditionsConditionWithResponse", ErrNotStubbed)
+	}
+	return f.PostDiscountsDiscountConditionsConditionWithResponseFunc(ctx, discountId, conditionId, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDiscountsDiscountConditionsWithBodyWithResponse(ctx context.Context, discountId string, params *medusa.PostDiscountsDiscountConditionsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountConditionsResponse, error) {
+	f.record("PostDiscountsDiscountConditionsWithBodyWithResponse")
+	if f.PostDiscountsDiscountConditionsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDiscountsDiscountConditionsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostDiscountsDiscountConditionsWithBodyWithResponseFunc(ctx, discountId, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDiscountsDiscountConditionsWithResponse(ctx context.Context, discountId string, params *medusa.PostDiscountsDiscountConditionsParams, body medusa.PostDiscountsDiscountConditionsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountConditionsResponse, error) {
+	f.record("PostDiscountsDiscountConditionsWithResponse")
+	if f.PostDiscountsDiscountConditionsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDiscountsDiscountConditionsWithResponse", ErrNotStubbed)
+	}
+	return f.PostDiscountsDiscountConditionsWithResponseFunc(ctx, discountId, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDiscountsDiscountDynamicCodesWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountDynamicCodesResponse, error) {
+	f.record("PostDiscountsDiscountDynamicCodesWithBodyWithResponse")
+	if f.PostDiscountsDiscountDynamicCodesWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDiscountsDiscountDynamicCodesWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostDiscountsDiscountDynamicCodesWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDiscountsDiscountDynamicCodesWithResponse(ctx context.Context, id string, body medusa.PostDiscountsDiscountDynamicCodesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountDynamicCodesResponse, error) {
+	f.record("PostDiscountsDiscountDynamicCodesWithResponse")
+	if f.PostDiscountsDiscountDynamicCodesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDiscountsDiscountDynamicCodesWithResponse", ErrNotStubbed)
+	}
+	return f.PostDiscountsDiscountDynamicCodesWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDiscountsDiscountRegionsRegionWithResponse(ctx context.Context, id string, regionId string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountRegionsRegionResponse, error) {
+	f.record("PostDiscountsDiscountRegionsRegionWithResponse")
+	if f.PostDiscountsDiscountRegionsRegionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDiscountsDiscountRegionsRegionWithResponse", ErrNotStubbed)
+	}
+	return f.PostDiscountsDiscountRegionsRegionWithResponseFunc(ctx, id, regionId, reqEditors...)
+}
+
+func (f *FakeClient) PostDiscountsDiscountWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostDiscountsDiscountParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountResponse, error) {
+	f.record("PostDiscountsDiscountWithBodyWithResponse")
+	if f.PostDiscountsDiscountWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDiscountsDiscountWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostDiscountsDiscountWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDiscountsDiscountWithResponse(ctx context.Context, id string, params *medusa.PostDiscountsDiscountParams, body medusa.PostDiscountsDiscountJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsDiscountResponse, error) {
+	f.record("PostDiscountsDiscountWithResponse")
+	if f.PostDiscountsDiscountWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDiscountsDiscountWithResponse", ErrNotStubbed)
+	}
+	return f.PostDiscountsDiscountWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDiscountsWithBodyWithResponse(ctx context.Context, params *medusa.PostDiscountsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsResponse, error) {
+	f.record("PostDiscountsWithBodyWithResponse")
+	if f.PostDiscountsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDiscountsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostDiscountsWithBodyWithResponseFunc(ctx, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDiscountsWithResponse(ctx context.Context, params *medusa.PostDiscountsParams, body medusa.PostDiscountsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDiscountsResponse, error) {
+	f.record("PostDiscountsWithResponse")
+	if f.PostDiscountsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDiscountsWithResponse", ErrNotStubbed)
+	}
+	return f.PostDiscountsWithResponseFunc(ctx, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDraftOrdersDraftOrderLineItemsItemWithBodyWithResponse(ctx context.Context, id string, lineId string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersDraftOrderLineItemsItemResponse, error) {
+	f.record("PostDraftOrdersDraftOrderLineItemsItemWithBodyWithResponse")
+	if f.PostDraftOrdersDraftOrderLineItemsItemWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDraftOrdersDraftOrderLineItemsItemWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostDraftOrdersDraftOrderLineItemsItemWithBodyWithResponseFunc(ctx, id, lineId, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDraftOrdersDraftOrderLineItemsItemWithResponse(ctx context.Context, id string, lineId string, body medusa.PostDraftOrdersDraftOrderLineItemsItemJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersDraftOrderLineItemsItemResponse, error) {
+	f.record("PostDraftOrdersDraftOrderLineItemsItemWithResponse")
+	if f.PostDraftOrdersDraftOrderLineItemsItemWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDraftOrdersDraftOrderLineItemsItemWithResponse", ErrNotStubbed)
+	}
+	return f.PostDraftOrdersDraftOrderLineItemsItemWithResponseFunc(ctx, id, lineId, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDraftOrdersDraftOrderLineItemsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersDraftOrderLineItemsResponse, error) {
+	f.record("PostDraftOrdersDraftOrderLineItemsWithBodyWithResponse")
+	if f.PostDraftOrdersDraftOrderLineItemsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDraftOrdersDraftOrderLineItemsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostDraftOrdersDraftOrderLineItemsWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDraftOrdersDraftOrderLineItemsWithResponse(ctx context.Context, id string, body medusa.PostDraftOrdersDraftOrderLineItemsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersDraftOrderLineItemsResponse, error) {
+	f.record("PostDraftOrdersDraftOrderLineItemsWithResponse")
+	if f.PostDraftOrdersDraftOrderLineItemsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDraftOrdersDraftOrderLineItemsWithResponse", ErrNotStubbed)
+	}
+	return f.PostDraftOrdersDraftOrderLineItemsWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDraftOrdersDraftOrderRegisterPaymentWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersDraftOrderRegisterPaymentResponse, error) {
+	f.record("PostDraftOrdersDraftOrderRegisterPaymentWithResponse")
+	if f.PostDraftOrdersDraftOrderRegisterPaymentWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDraftOrdersDraftOrderRegisterPaymentWithResponse", ErrNotStubbed)
+	}
+	return f.PostDraftOrdersDraftOrderRegisterPaymentWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) PostDraftOrdersDraftOrderWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersDraftOrderResponse, error) {
+	f.record("PostDraftOrdersDraftOrderWithBodyWithResponse")
+	if f.PostDraftOrdersDraftOrderWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDraftOrdersDraftOrderWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostDraftOrdersDraftOrderWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDraftOrdersDraftOrderWithResponse(ctx context.Context, id string, body medusa.PostDraftOrdersDraftOrderJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersDraftOrderResponse, error) {
+	f.record("PostDraftOrdersDraftOrderWithResponse")
+	if f.PostDraftOrdersDraftOrderWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDraftOrdersDraftOrderWithResponse", ErrNotStubbed)
+	}
+	return f.PostDraftOrdersDraftOrderWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDraftOrdersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersResponse, error) {
+	f.record("PostDraftOrdersWithBodyWithResponse")
+	if f.PostDraftOrdersWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDraftOrdersWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostDraftOrdersWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostDraftOrdersWithResponse(ctx context.Context, body medusa.PostDraftOrdersJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostDraftOrdersResponse, error) {
+	f.record("PostDraftOrdersWithResponse")
+	if f.PostDraftOrdersWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostDraftOrdersWithResponse", ErrNotStubbed)
+	}
+	return f.PostDraftOrdersWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostGiftCardsGiftCardWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostGiftCardsGiftCardResponse, error) {
+	f.record("PostGiftCardsGiftCardWithBodyWithResponse")
+	if f.PostGiftCardsGiftCardWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostGiftCardsGiftCardWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostGiftCardsGiftCardWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostGiftCardsGiftCardWithResponse(ctx context.Context, id string, body medusa.PostGiftCardsGiftCardJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostGiftCardsGiftCardResponse, error) {
+	f.record("PostGiftCardsGiftCardWithResponse")
+	if f.PostGiftCardsGiftCardWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostGiftCardsGiftCardWithResponse", ErrNotStubbed)
+	}
+	return f.PostGiftCardsGiftCardWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostGiftCardsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostGiftCardsResponse, error) {
+	f.record("PostGiftCardsWithBodyWithResponse")
+	if f.PostGiftCardsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostGiftCardsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostGiftCardsWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostGiftCardsWithResponse(ctx context.Context, body medusa.PostGiftCardsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostGiftCardsResponse, error) {
+	f.record("PostGiftCardsWithResponse")
+	if f.PostGiftCardsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostGiftCardsWithResponse", ErrNotStubbed)
+	}
+	return f.PostGiftCardsWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostInventoryItemsInventoryItemLocationLevelsLocationLevelWithBodyWithResponse(ctx context.Context, id string, locationId string, params *medusa.PostInventoryItemsInventoryItemLocationLevelsLocationLevelParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsInventoryItemLocationLevelsLocationLevelResponse, error) {
+	f.record("PostInventoryItemsInventoryItemLocationLevelsLocationLevelWithBodyWithResponse")
+	if f.PostInventoryItemsInventoryItemLocationLevelsLocationLevelWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostInventoryItemsInventoryItemLocationLevelsLocationLevelWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostInventoryItemsInventoryItemLocationLevelsLocationLevelWithBodyWithResponseFunc(ctx, id, locationId, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostInventoryItemsInventoryItemLocationLevelsLocationLevelWithResponse(ctx context.Context, id string, locationId string, params *medusa.PostInventoryItemsInventoryItemLocationLevelsLocationLevelParams, body medusa.PostInventoryItemsInventoryItemLocationLevelsLocationLevelJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsInventoryItemLocationLevelsLocationLevelResponse, error) {
+	f.record("PostInventoryItemsInventoryItemLocationLevelsLocationLevelWithResponse")
+	if f.PostInventoryItemsInventoryItemLocationLevelsLocationLevelWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostInventoryItemsInventoryItemLocationLevelsLocationLevelWithResponse", ErrNotStubbed)
+	}
+	return f.PostInventoryItemsInventoryItemLocationLevelsLocationLevelWithResponseFunc(ctx, id, locationId, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostInventoryItemsInventoryItemLocationLevelsWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostInventoryItemsInventoryItemLocationLevelsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsInventoryItemLocationLevelsResponse, error) {
+	f.record("PostInventoryItemsInventoryItemLocationLevelsWithBodyWithResponse")
+	if f.PostInventoryItemsInventoryItemLocationLevelsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostInventoryItemsInventoryItemLocationLevelsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostInventoryItemsInventoryItemLocationLevelsWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostInventoryItemsInventoryItemLocationLevelsWithResponse(ctx context.Context, id string, params *medusa.PostInventoryItemsInventoryItemLocationLevelsParams, body medusa.PostInventoryItemsInventoryItemLocationLevelsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsInventoryItemLocationLevelsResponse, error) {
+	f.record("PostInventoryItemsInventoryItemLocationLevelsWithResponse")
+	if f.PostInventoryItemsInventoryItemLocationLevelsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostInventoryItemsInventoryItemLocationLevelsWithResponse", ErrNotStubbed)
+	}
+	return f.PostInventoryItemsInventoryItemLocationLevelsWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostInventoryItemsInventoryItemWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostInventoryItemsInventoryItemParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsInventoryItemResponse, error) {
+	f.record("PostInventoryItemsInventoryItemWithBodyWithResponse")
+	if f.PostInventoryItemsInventoryItemWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostInventoryItemsInventoryItemWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostInventoryItemsInventoryItemWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostInventoryItemsInventoryItemWithResponse(ctx context.Context, id string, params *medusa.PostInventoryItemsInventoryItemParams, body medusa.PostInventoryItemsInventoryItemJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsInventoryItemResponse, error) {
+	f.record("PostInventoryItemsInventoryItemWithResponse")
+	if f.PostInventoryItemsInventoryItemWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostInventoryItemsInventoryItemWithResponse", ErrNotStubbed)
+	}
+	return f.PostInventoryItemsInventoryItemWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostInventoryItemsWithBodyWithResponse(ctx context.Context, params *medusa.PostInventoryItemsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsResponse, error) {
+	f.record("PostInventoryItemsWithBodyWithResponse")
+	if f.PostInventoryItemsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostInventoryItemsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostInventoryItemsWithBodyWithResponseFunc(ctx, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostInventoryItemsWithResponse(ctx context.Context, params *medusa.PostInventoryItemsParams, body medusa.PostInventoryItemsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInventoryItemsResponse, error) {
+	f.record("PostInventoryItemsWithResponse")
+	if f.PostInventoryItemsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostInventoryItemsWithResponse", ErrNotStubbed)
+	}
+	return f.PostInventoryItemsWithResponseFunc(ctx, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostInvitesInviteAcceptWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInvitesInviteAcceptResponse, error) {
+	f.record("PostInvitesInviteAcceptWithBodyWithResponse")
+	if f.PostInvitesInviteAcceptWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostInvitesInviteAcceptWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostInvitesInviteAcceptWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostInvitesInviteAcceptWithResponse(ctx context.Context, body medusa.PostInvitesInviteAcceptJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInvitesInviteAcceptResponse, error) {
+	f.record("PostInvitesInviteAcceptWithResponse")
+	if f.PostInvitesInviteAcceptWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostInvitesInviteAcceptWithResponse", ErrNotStubbed)
+	}
+	return f.PostInvitesInviteAcceptWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostInvitesInviteResendWithResponse(ctx context.Context, inviteId string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInvitesInviteResendResponse, error) {
+	f.record("PostInvitesInviteResendWithResponse")
+	if f.PostInvitesInviteResendWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostInvitesInviteResendWithResponse", ErrNotStubbed)
+	}
+	return f.PostInvitesInviteResendWithResponseFunc(ctx, inviteId, reqEditors...)
+}
+
+func (f *FakeClient) PostInvitesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInvitesResponse, error) {
+	f.record("PostInvitesWithBodyWithResponse")
+	if f.PostInvitesWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostInvitesWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostInvitesWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostInvitesWithResponse(ctx context.Context, body medusa.PostInvitesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostInvitesResponse, error) {
+	f.record("PostInvitesWithResponse")
+	if f.PostInvitesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostInvitesWithResponse", ErrNotStubbed)
+	}
+	return f.PostInvitesWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostNotesNoteWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostNotesNoteResponse, error) {
+	f.record("PostNotesNoteWithBodyWithResponse")
+	if f.PostNotesNoteWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostNotesNoteWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostNotesNoteWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostNotesNoteWithResponse(ctx context.Context, id string, body medusa.PostNotesNoteJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostNotesNoteResponse, error) {
+	f.record("PostNotesNoteWithResponse")
+	if f.PostNotesNoteWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostNotesNoteWithResponse", ErrNotStubbed)
+	}
+	return f.PostNotesNoteWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostNotesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostNotesResponse, error) {
+	f.record("PostNotesWithBodyWithResponse")
+	if f.PostNotesWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostNotesWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostNotesWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostNotesWithResponse(ctx context.Context, body medusa.PostNotesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostNotesResponse, error) {
+	f.record("PostNotesWithResponse")
+	if f.PostNotesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostNotesWithResponse", ErrNotStubbed)
+	}
+	return f.PostNotesWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostNotificationsNotificationResendWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostNotificationsNotificationResendResponse, error) {
+	f.record("PostNotificationsNotificationResendWithBodyWithResponse")
+	if f.PostNotificationsNotificationResendWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostNotificationsNotificationResendWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostNotificationsNotificationResendWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostNotificationsNotificationResendWithResponse(ctx context.Context, id string, body medusa.PostNotificationsNotificationResendJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostNotificationsNotificationResendResponse, error) {
+	f.record("PostNotificationsNotificationResendWithResponse")
+	if f.PostNotificationsNotificationResendWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostNotificationsNotificationResendWithResponse", ErrNotStubbed)
+	}
+	return f.PostNotificationsNotificationResendWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrderEditsEditLineItemsLineItemWithBodyWithResponse(ctx context.Context, id string, itemId string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsEditLineItemsLineItemResponse, error) {
+	f.record("PostOrderEditsEditLineItemsLineItemWithBodyWithResponse")
+	if f.PostOrderEditsEditLineItemsLineItemWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrderEditsEditLineItemsLineItemWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrderEditsEditLineItemsLineItemWithBodyWithResponseFunc(ctx, id, itemId, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrderEditsEditLineItemsLineItemWithResponse(ctx context.Context, id string, itemId string, body medusa.PostOrderEditsEditLineItemsLineItemJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsEditLineItemsLineItemResponse, error) {
+	f.record("PostOrderEditsEditLineItemsLineItemWithResponse")
+	if f.PostOrderEditsEditLineItemsLineItemWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrderEditsEditLineItemsLineItemWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrderEditsEditLineItemsLineItemWithResponseFunc(ctx, id, itemId, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrderEditsEditLineItemsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsEditLineItemsResponse, error) {
+	f.record("PostOrderEditsEditLineItemsWithBodyWithResponse")
+	if f.PostOrderEditsEditLineItemsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrderEditsEditLineItemsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrderEditsEditLineItemsWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrderEditsEditLineItemsWithResponse(ctx context.Context, id string, body medusa.PostOrderEditsEditLineItemsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsEditLineItemsResponse, error) {
+	f.record("PostOrderEditsEditLineItemsWithResponse")
+	if f.PostOrderEditsEditLineItemsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrderEditsEditLineItemsWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrderEditsEditLineItemsWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrderEditsOrderEditCancelWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsOrderEditCancelResponse, error) {
+	f.record("PostOrderEditsOrderEditCancelWithResponse")
+	if f.PostOrderEditsOrderEditCancelWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrderEditsOrderEditCancelWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrderEditsOrderEditCancelWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) PostOrderEditsOrderEditConfirmWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsOrderEditConfirmResponse, error) {
+	f.record("PostOrderEditsOrderEditConfirmWithResponse")
+	if f.PostOrderEditsOrderEditConfirmWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrderEditsOrderEditConfirmWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrderEditsOrderEditConfirmWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) PostOrderEditsOrderEditRequestWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsOrderEditRequestResponse, error) {
+	f.record("PostOrderEditsOrderEditRequestWithResponse")
+	if f.PostOrderEditsOrderEditRequestWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrderEditsOrderEditRequestWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrderEditsOrderEditRequestWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) PostOrderEditsOrderEditWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsOrderEditResponse, error) {
+	f.record("PostOrderEditsOrderEditWithBodyWithResponse")
+	if f.PostOrderEditsOrderEditWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrderEditsOrderEditWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrderEditsOrderEditWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrderEditsOrderEditWithResponse(ctx context.Context, id string, body medusa.PostOrderEditsOrderEditJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsOrderEditResponse, error) {
+	f.record("PostOrderEditsOrderEditWithResponse")
+	if f.PostOrderEditsOrderEditWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrderEditsOrderEditWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrderEditsOrderEditWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrderEditsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsResponse, error) {
+	f.record("PostOrderEditsWithBodyWithResponse")
+	if f.PostOrderEditsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrderEditsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrderEditsWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrderEditsWithResponse(ctx context.Context, body medusa.PostOrderEditsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrderEditsResponse, error) {
+	f.record("PostOrderEditsWithResponse")
+	if f.PostOrderEditsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrderEditsWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrderEditsWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersClaimCancelWithResponse(ctx context.Context, id string, claimId string, params *medusa.PostOrdersClaimCancelParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersClaimCancelResponse, error) {
+	f.record("PostOrdersClaimCancelWithResponse")
+	if f.PostOrdersClaimCancelWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersClaimCancelWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersClaimCancelWithResponseFunc(ctx, id, claimId, params, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersClaimFulfillmentsCancelWithResponse(ctx context.Context, id string, claimId string, fulfillmentId string, params *medusa.PostOrdersClaimFulfillmentsCancelParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersClaimFulfillmentsCancelResponse, error) {
+	f.record("PostOrdersClaimFulfillmentsCancelWithResponse")
+	if f.PostOrdersClaimFulfillmentsCancelWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersClaimFulfillmentsCancelWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersClaimFulfillmentsCancelWithResponseFunc(ctx, id, claimId, fulfillmentId, params, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderArchiveWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderArchiveParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderArchiveResponse, error) {
+	f.record("PostOrdersOrderArchiveWithResponse")
+	if f.PostOrdersOrderArchiveWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderArchiveWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderArchiveWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderCancelWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderCancelParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderCancelResponse, error) {
+	f.record("PostOrdersOrderCancelWithResponse")
+	if f.PostOrdersOrderCancelWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderCancelWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderCancelWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderCaptureWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderCaptureParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderCaptureResponse, error) {
+	f.record("PostOrdersOrderCaptureWithResponse")
+	if f.PostOrdersOrderCaptureWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderCaptureWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderCaptureWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderClaimsClaimFulfillmentsWithBodyWithResponse(ctx context.Context, id string, claimId string, params *medusa.PostOrdersOrderClaimsClaimFulfillmentsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsClaimFulfillmentsResponse, error) {
+	f.record("PostOrdersOrderClaimsClaimFulfillmentsWithBodyWithResponse")
+	if f.PostOrdersOrderClaimsClaimFulfillmentsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderClaimsClaimFulfillmentsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderClaimsClaimFulfillmentsWithBodyWithResponseFunc(ctx, id, claimId, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderClaimsClaimFulfillmentsWithResponse(ctx context.Context, id string, claimId string, params *medusa.PostOrdersOrderClaimsClaimFulfillmentsParams, body medusa.PostOrdersOrderClaimsClaimFulfillmentsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsClaimFulfillmentsResponse, error) {
+	f.record("PostOrdersOrderClaimsClaimFulfillmentsWithResponse")
+	if f.PostOrdersOrderClaimsClaimFulfillmentsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderClaimsClaimFulfillmentsWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderClaimsClaimFulfillmentsWithResponseFunc(ctx, id, claimId, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderClaimsClaimShipmentsWithBodyWithResponse(ctx context.Context, id string, claimId string, params *medusa.PostOrdersOrderClaimsClaimShipmentsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsClaimShipmentsResponse, error) {
+	f.record("PostOrdersOrderClaimsClaimShipmentsWithBodyWithResponse")
+	if f.PostOrdersOrderClaimsClaimShipmentsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderClaimsClaimShipmentsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderClaimsClaimShipmentsWithBodyWithResponseFunc(ctx, id, claimId, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderClaimsClaimShipmentsWithResponse(ctx context.Context, id string, claimId string, params *medusa.PostOrdersOrderClaimsClaimShipmentsParams, body medusa.PostOrdersOrderClaimsClaimShipmentsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsClaimShipmentsResponse, error) {
+	f.record("PostOrdersOrderClaimsClaimShipmentsWithResponse")
+	if f.PostOrdersOrderClaimsClaimShipmentsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderClaimsClaimShipmentsWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderClaimsClaimShipmentsWithResponseFunc(ctx, id, claimId, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderClaimsClaimWithBodyWithResponse(ctx context.Context, id string, claimId string, params *medusa.PostOrdersOrderClaimsClaimParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsClaimResponse, error) {
+	f.record("PostOrdersOrderClaimsClaimWithBodyWithResponse")
+	if f.PostOrdersOrderClaimsClaimWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderClaimsClaimWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderClaimsClaimWithBodyWithResponseFunc(ctx, id, claimId, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderClaimsClaimWithResponse(ctx context.Context, id string, claimId string, params *medusa.PostOrdersOrderClaimsClaimParams, body medusa.PostOrdersOrderClaimsClaimJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsClaimResponse, error) {
+	f.record("PostOrdersOrderClaimsClaimWithResponse")
+	if f.PostOrdersOrderClaimsClaimWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderClaimsClaimWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderClaimsClaimWithResponseFunc(ctx, id, claimId, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderClaimsWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderClaimsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsResponse, error) {
+	f.record("PostOrdersOrderClaimsWithBodyWithResponse")
+	if f.PostOrdersOrderClaimsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderClaimsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderClaimsWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderClaimsWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderClaimsParams, body medusa.PostOrdersOrderClaimsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderClaimsResponse, error) {
+	f.record("PostOrdersOrderClaimsWithResponse")
+	if f.PostOrdersOrderClaimsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderClaimsWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderClaimsWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderCompleteWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderCompleteParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderCompleteResponse, error) {
+	f.record("PostOrdersOrderCompleteWithResponse")
+	if f.PostOrdersOrderCompleteWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderCompleteWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderCompleteWithResponseFunc(ctx, id, params, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderFulfillmentsCancelWithResponse(ctx context.Context, id string, fulfillmentId string, params *medusa.PostOrdersOrderFulfillmentsCancelParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderFulfillmentsCancelResponse, error) {
+	f.record("PostOrdersOrderFulfillmentsCancelWithResponse")
+	if f.PostOrdersOrderFulfillmentsCancelWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderFulfillmentsCancelWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderFulfillmentsCancelWithResponseFunc(ctx, id, fulfillmentId, params, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderFulfillmentsWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderFulfillmentsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderFulfillmentsResponse, error) {
+	f.record("PostOrdersOrderFulfillmentsWithBodyWithResponse")
+	if f.PostOrdersOrderFulfillmentsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderFulfillmentsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderFulfillmentsWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderFulfillmentsWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderFulfillmentsParams, body medusa.PostOrdersOrderFulfillmentsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderFulfillmentsResponse, error) {
+	f.record("PostOrdersOrderFulfillmentsWithResponse")
+	if f.PostOrdersOrderFulfillmentsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderFulfillmentsWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderFulfillmentsWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderLineItemReservationsWithBodyWithResponse(ctx context.Context, id string, lineItemId string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderLineItemReservationsResponse, error) {
+	f.record("PostOrdersOrderLineItemReservationsWithBodyWithResponse")
+	if f.PostOrdersOrderLineItemReservationsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderLineItemReservationsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderLineItemReservationsWithBodyWithResponseFunc(ctx, id, lineItemId, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderLineItemReservationsWithResponse(ctx context.Context, id string, lineItemId string, body medusa.PostOrdersOrderLineItemReservationsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderLineItemReservationsResponse, error) {
+	f.record("PostOrdersOrderLineItemReservationsWithResponse")
+	if f.PostOrdersOrderLineItemReservationsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderLineItemReservationsWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderLineItemReservationsWithResponseFunc(ctx, id, lineItemId, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderRefundsWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderRefundsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderRefundsResponse, error) {
+	f.record("PostOrdersOrderRefundsWithBodyWithResponse")
+	if f.PostOrdersOrderRefundsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderRefundsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderRefundsWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderRefundsWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderRefundsParams, body medusa.PostOrdersOrderRefundsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderRefundsResponse, error) {
+	f.record("PostOrdersOrderRefundsWithResponse")
+	if f.PostOrdersOrderRefundsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderRefundsWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderRefundsWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderReturnsWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderReturnsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderReturnsResponse, error) {
+	f.record("PostOrdersOrderReturnsWithBodyWithResponse")
+	if f.PostOrdersOrderReturnsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderReturnsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderReturnsWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderReturnsWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderReturnsParams, body medusa.PostOrdersOrderReturnsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderReturnsResponse, error) {
+	f.record("PostOrdersOrderReturnsWithResponse")
+	if f.PostOrdersOrderReturnsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderReturnsWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderReturnsWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderShipmentWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderShipmentParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderShipmentResponse, error) {
+	f.record("PostOrdersOrderShipmentWithBodyWithResponse")
+	if f.PostOrdersOrderShipmentWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderShipmentWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderShipmentWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderShipmentWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderShipmentParams, body medusa.PostOrdersOrderShipmentJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderShipmentResponse, error) {
+	f.record("PostOrdersOrderShipmentWithResponse")
+	if f.PostOrdersOrderShipmentWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderShipmentWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderShipmentWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderShippingMethodsWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderShippingMethodsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderShippingMethodsResponse, error) {
+	f.record("PostOrdersOrderShippingMethodsWithBodyWithResponse")
+	if f.PostOrdersOrderShippingMethodsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderShippingMethodsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderShippingMethodsWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderShippingMethodsWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderShippingMethodsParams, body medusa.PostOrdersOrderShippingMethodsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderShippingMethodsResponse, error) {
+	f.record("PostOrdersOrderShippingMethodsWithResponse")
+	if f.PostOrdersOrderShippingMethodsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderShippingMethodsWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderShippingMethodsWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderSwapsSwapFulfillmentsWithBodyWithResponse(ctx context.Context, id string, swapId string, params *medusa.PostOrdersOrderSwapsSwapFulfillmentsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderSwapsSwapFulfillmentsResponse, error) {
+	f.record("PostOrdersOrderSwapsSwapFulfillmentsWithBodyWithResponse")
+	if f.PostOrdersOrderSwapsSwapFulfillmentsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderSwapsSwapFulfillmentsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderSwapsSwapFulfillmentsWithBodyWithResponseFunc(ctx, id, swapId, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderSwapsSwapFulfillmentsWithResponse(ctx context.Context, id string, swapId string, params *medusa.PostOrdersOrderSwapsSwapFulfillmentsParams, body medusa.PostOrdersOrderSwapsSwapFulfillmentsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderSwapsSwapFulfillmentsResponse, error) {
+	f.record("PostOrdersOrderSwapsSwapFulfillmentsWithResponse")
+	if f.PostOrdersOrderSwapsSwapFulfillmentsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderSwapsSwapFulfillmentsWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderSwapsSwapFulfillmentsWithResponseFunc(ctx, id, swapId, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderSwapsSwapProcessPaymentWithResponse(ctx context.Context, id string, swapId string, params *medusa.PostOrdersOrderSwapsSwapProcessPaymentParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderSwapsSwapProcessPaymentResponse, error) {
+	f.record("PostOrdersOrderSwapsSwapProcessPaymentWithResponse")
+	if f.PostOrdersOrderSwapsSwapProcessPaymentWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderSwapsSwapProcessPaymentWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderSwapsSwapProcessPaymentWithResponseFunc(ctx, id, swapId, params, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderSwapsSwapShipmentsWithBodyWithResponse(ctx context.Context, id string, swapId string, params *medusa.PostOrdersOrderSwapsSwapShipmentsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderSwapsSwapShipmentsResponse, error) {
+	f.record("PostOrdersOrderSwapsSwapShipmentsWithBodyWithResponse")
+	if f.PostOrdersOrderSwapsSwapShipmentsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderSwapsSwapShipmentsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderSwapsSwapShipmentsWithBodyWithResponseFunc(ctx, id, swapId, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderSwapsSwapShipmentsWithResponse(ctx context.Context, id string, swapId string, params *medusa.PostOrdersOrderSwapsSwapShipmentsParams, body medusa.PostOrdersOrderSwapsSwapShipmentsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderSwapsSwapShipmentsResponse, error) {
+	f.record("PostOrdersOrderSwapsSwapShipmentsWithResponse")
+	if f.PostOrdersOrderSwapsSwapShipmentsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderSwapsSwapShipmentsWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderSwapsSwapShipmentsWithResponseFunc(ctx, id, swapId, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderSwapsWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderSwapsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderSwapsResponse, error) {
+	f.record("PostOrdersOrderSwapsWithBodyWithResponse")
+	if f.PostOrdersOrderSwapsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderSwapsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderSwapsWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderSwapsWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderSwapsParams, body medusa.PostOrdersOrderSwapsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderSwapsResponse, error) {
+	f.record("PostOrdersOrderSwapsWithResponse")
+	if f.PostOrdersOrderSwapsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderSwapsWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderSwapsWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderResponse, error) {
+	f.record("PostOrdersOrderWithBodyWithResponse")
+	if f.PostOrdersOrderWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersOrderWithResponse(ctx context.Context, id string, params *medusa.PostOrdersOrderParams, body medusa.PostOrdersOrderJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersOrderResponse, error) {
+	f.record("PostOrdersOrderWithResponse")
+	if f.PostOrdersOrderWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersOrderWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersOrderWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersSwapCancelWithResponse(ctx context.Context, id string, swapId string, params *medusa.PostOrdersSwapCancelParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersSwapCancelResponse, error) {
+	f.record("PostOrdersSwapCancelWithResponse")
+	if f.PostOrdersSwapCancelWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersSwapCancelWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersSwapCancelWithResponseFunc(ctx, id, swapId, params, reqEditors...)
+}
+
+func (f *FakeClient) PostOrdersSwapFulfillmentsCancelWithResponse(ctx context.Context, id string, swapId string, fulfillmentId string, params *medusa.PostOrdersSwapFulfillmentsCancelParams, reqEditors ...medusa.RequestEditorFn) (*medusa.PostOrdersSwapFulfillmentsCancelResponse, error) {
+	f.record("PostOrdersSwapFulfillmentsCancelWithResponse")
+	if f.PostOrdersSwapFulfillmentsCancelWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostOrdersSwapFulfillmentsCancelWithResponse", ErrNotStubbed)
+	}
+	return f.PostOrdersSwapFulfillmentsCancelWithResponseFunc(ctx, id, swapId, fulfillmentId, params, reqEditors...)
+}
+
+func (f *FakeClient) PostPaymentCollectionsPaymentCollectionAuthorizeWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPaymentCollectionsPaymentCollectionAuthorizeResponse, error) {
+	f.record("PostPaymentCollectionsPaymentCollectionAuthorizeWithResponse")
+	if f.PostPaymentCollectionsPaymentCollectionAuthorizeWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPaymentCollectionsPaymentCollectionAuthorizeWithResponse", ErrNotStubbed)
+	}
+	return f.PostPaymentCollectionsPaymentCollectionAuthorizeWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) PostPaymentCollectionsPaymentCollectionWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPaymentCollectionsPaymentCollectionResponse, error) {
+	f.record("PostPaymentCollectionsPaymentCollectionWithBodyWithResponse")
+	if f.PostPaymentCollectionsPaymentCollectionWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPaymentCollectionsPaymentCollectionWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostPaymentCollectionsPaymentCollectionWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostPaymentCollectionsPaymentCollectionWithResponse(ctx context.Context, id string, body medusa.PostPaymentCollectionsPaymentCollectionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPaymentCollectionsPaymentCollectionResponse, error) {
+	f.record("PostPaymentCollectionsPaymentCollectionWithResponse")
+	if f.PostPaymentCollectionsPaymentCollectionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPaymentCollectionsPaymentCollectionWithResponse", ErrNotStubbed)
+	}
+	return f.PostPaymentCollectionsPaymentCollectionWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostPaymentsPaymentCaptureWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPaymentsPaymentCaptureResponse, error) {
+	f.record("PostPaymentsPaymentCaptureWithResponse")
+	if f.PostPaymentsPaymentCaptureWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPaymentsPaymentCaptureWithResponse", ErrNotStubbed)
+	}
+	return f.PostPaymentsPaymentCaptureWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) PostPaymentsPaymentRefundsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPaymentsPaymentRefundsResponse, error) {
+	f.record("PostPaymentsPaymentRefundsWithBodyWithResponse")
+	if f.PostPaymentsPaymentRefundsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPaymentsPaymentRefundsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostPaymentsPaymentRefundsWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostPaymentsPaymentRefundsWithResponse(ctx context.Context, id string, body medusa.PostPaymentsPaymentRefundsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPaymentsPaymentRefundsResponse, error) {
+	f.record("PostPaymentsPaymentRefundsWithResponse")
+	if f.PostPaymentsPaymentRefundsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPaymentsPaymentRefundsWithResponse", ErrNotStubbed)
+	}
+	return f.PostPaymentsPaymentRefundsWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostPriceListsPriceListPriceListWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPriceListsPriceListPriceListResponse, error) {
+	f.record("PostPriceListsPriceListPriceListWithBodyWithResponse")
+	if f.PostPriceListsPriceListPriceListWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPriceListsPriceListPriceListWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostPriceListsPriceListPriceListWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostPriceListsPriceListPriceListWithResponse(ctx context.Context, id string, body medusa.PostPriceListsPriceListPriceListJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPriceListsPriceListPriceListResponse, error) {
+	f.record("PostPriceListsPriceListPriceListWithResponse")
+	if f.PostPriceListsPriceListPriceListWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPriceListsPriceListPriceListWithResponse", ErrNotStubbed)
+	}
+	return f.PostPriceListsPriceListPriceListWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostPriceListsPriceListPricesBatchWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPriceListsPriceListPricesBatchResponse, error) {
+	f.record("PostPriceListsPriceListPricesBatchWithBodyWithResponse")
+	if f.PostPriceListsPriceListPricesBatchWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPriceListsPriceListPricesBatchWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostPriceListsPriceListPricesBatchWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostPriceListsPriceListPricesBatchWithResponse(ctx context.Context, id string, body medusa.PostPriceListsPriceListPricesBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPriceListsPriceListPricesBatchResponse, error) {
+	f.record("PostPriceListsPriceListPricesBatchWithResponse")
+	if f.PostPriceListsPriceListPricesBatchWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPriceListsPriceListPricesBatchWithResponse", ErrNotStubbed)
+	}
+	return f.PostPriceListsPriceListPricesBatchWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostPriceListsPriceListWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPriceListsPriceListResponse, error) {
+	f.record("PostPriceListsPriceListWithBodyWithResponse")
+	if f.PostPriceListsPriceListWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPriceListsPriceListWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostPriceListsPriceListWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostPriceListsPriceListWithResponse(ctx context.Context, body medusa.PostPriceListsPriceListJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPriceListsPriceListResponse, error) {
+	f.record("PostPriceListsPriceListWithResponse")
+	if f.PostPriceListsPriceListWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPriceListsPriceListWithResponse", ErrNotStubbed)
+	}
+	return f.PostPriceListsPriceListWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductCategoriesCategoryProductsBatchWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostProductCategoriesCategoryProductsBatchParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductCategoriesCategoryProductsBatchResponse, error) {
+	f.record("PostProductCategoriesCategoryProductsBatchWithBodyWithResponse")
+	if f.PostProductCategoriesCategoryProductsBatchWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductCategoriesCategoryProductsBatchWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductCategoriesCategoryProductsBatchWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductCategoriesCategoryProductsBatchWithResponse(ctx context.Context, id string, params *medusa.PostProductCategoriesCategoryProductsBatchParams, body medusa.PostProductCategoriesCategoryProductsBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductCategoriesCategoryProductsBatchResponse, error) {
+	f.record("PostProductCategoriesCategoryProductsBatchWithResponse")
+	if f.PostProductCategoriesCategoryProductsBatchWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductCategoriesCategoryProductsBatchWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductCategoriesCategoryProductsBatchWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductCategoriesCategoryWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostProductCategoriesCategoryParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductCategoriesCategoryResponse, error) {
+	f.record("PostProductCategoriesCategoryWithBodyWithResponse")
+	if f.PostProductCategoriesCategoryWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductCategoriesCategoryWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductCategoriesCategoryWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductCategoriesCategoryWithResponse(ctx context.Context, id string, params *medusa.PostProductCategoriesCategoryParams, body medusa.PostProductCategoriesCategoryJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductCategoriesCategoryResponse, error) {
+	f.record("PostProductCategoriesCategoryWithResponse")
+	if f.PostProductCategoriesCategoryWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductCategoriesCategoryWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductCategoriesCategoryWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductCategoriesWithBodyWithResponse(ctx context.Context, params *medusa.PostProductCategoriesParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductCategoriesResponse, error) {
+	f.record("PostProductCategoriesWithBodyWithResponse")
+	if f.PostProductCategoriesWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductCategoriesWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductCategoriesWithBodyWithResponseFunc(ctx, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductCategoriesWithResponse(ctx context.Context, params *medusa.PostProductCategoriesParams, body medusa.PostProductCategoriesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductCategoriesResponse, error) {
+	f.record("PostProductCategoriesWithResponse")
+	if f.PostProductCategoriesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductCategoriesWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductCategoriesWithResponseFunc(ctx, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsProductMetadataWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductMetadataResponse, error) {
+	f.record("PostProductsProductMetadataWithBodyWithResponse")
+	if f.PostProductsProductMetadataWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsProductMetadataWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsProductMetadataWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsProductMetadataWithResponse(ctx context.Context, id string, body medusa.PostProductsProductMetadataJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductMetadataResponse, error) {
+	f.record("PostProductsProductMetadataWithResponse")
+	if f.PostProductsProductMetadataWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsProductMetadataWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsProductMetadataWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsProductOptionsOptionWithBodyWithResponse(ctx context.Context, id string, optionId string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductOptionsOptionResponse, error) {
+	f.record("PostProductsProductOptionsOptionWithBodyWithResponse")
+	if f.PostProductsProductOptionsOptionWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsProductOptionsOptionWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsProductOptionsOptionWithBodyWithResponseFunc(ctx, id, optionId, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsProductOptionsOptionWithResponse(ctx context.Context, id string, optionId string, body medusa.PostProductsProductOptionsOptionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductOptionsOptionResponse, error) {
+	f.record("PostProductsProductOptionsOptionWithResponse")
+	if f.PostProductsProductOptionsOptionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsProductOptionsOptionWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsProductOptionsOptionWithResponseFunc(ctx, id, optionId, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsProductOptionsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductOptionsResponse, error) {
+	f.record("PostProductsProductOptionsWithBodyWithResponse")
+	if f.PostProductsProductOptionsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsProductOptionsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsProductOptionsWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsProductOptionsWithResponse(ctx context.Context, id string, body medusa.PostProductsProductOptionsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductOptionsResponse, error) {
+	f.record("PostProductsProductOptionsWithResponse")
+	if f.PostProductsProductOptionsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsProductOptionsWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsProductOptionsWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsProductVariantsVariantWithBodyWithResponse(ctx context.Context, id string, variantId string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductVariantsVariantResponse, error) {
+	f.record("PostProductsProductVariantsVariantWithBodyWithResponse")
+	if f.PostProductsProductVariantsVariantWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsProductVariantsVariantWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsProductVariantsVariantWithBodyWithResponseFunc(ctx, id, variantId, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsProductVariantsVariantWithResponse(ctx context.Context, id string, variantId string, body medusa.PostProductsProductVariantsVariantJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductVariantsVariantResponse, error) {
+	f.record("PostProductsProductVariantsVariantWithResponse")
+	if f.PostProductsProductVariantsVariantWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsProductVariantsVariantWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsProductVariantsVariantWithResponseFunc(ctx, id, variantId, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsProductVariantsWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductVariantsResponse, error) {
+	f.record("PostProductsProductVariantsWithBodyWithResponse")
+	if f.PostProductsProductVariantsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsProductVariantsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsProductVariantsWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsProductVariantsWithResponse(ctx context.Context, id string, body medusa.PostProductsProductVariantsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductVariantsResponse, error) {
+	f.record("PostProductsProductVariantsWithResponse")
+	if f.PostProductsProductVariantsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsProductVariantsWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsProductVariantsWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsProductWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductResponse, error) {
+	f.record("PostProductsProductWithBodyWithResponse")
+	if f.PostProductsProductWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsProductWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsProductWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsProductWithResponse(ctx context.Context, id string, body medusa.PostProductsProductJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsProductResponse, error) {
+	f.record("PostProductsProductWithResponse")
+	if f.PostProductsProductWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsProductWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsProductWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsToCollectionWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsToCollectionResponse, error) {
+	f.record("PostProductsToCollectionWithBodyWithResponse")
+	if f.PostProductsToCollectionWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsToCollectionWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsToCollectionWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsToCollectionWithResponse(ctx context.Context, id string, body medusa.PostProductsToCollectionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsToCollectionResponse, error) {
+	f.record("PostProductsToCollectionWithResponse")
+	if f.PostProductsToCollectionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsToCollectionWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsToCollectionWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsResponse, error) {
+	f.record("PostProductsWithBodyWithResponse")
+	if f.PostProductsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostProductsWithResponse(ctx context.Context, body medusa.PostProductsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostProductsResponse, error) {
+	f.record("PostProductsWithResponse")
+	if f.PostProductsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostProductsWithResponse", ErrNotStubbed)
+	}
+	return f.PostProductsWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostPublishableApiKeySalesChannelsChannelsBatchWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPublishableApiKeySalesChannelsChannelsBatchResponse, error) {
+	f.record("PostPublishableApiKeySalesChannelsChannelsBatchWithBodyWithResponse")
+	if f.PostPublishableApiKeySalesChannelsChannelsBatchWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPublishableApiKeySalesChannelsChannelsBatchWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostPublishableApiKeySalesChannelsChannelsBatchWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostPublishableApiKeySalesChannelsChannelsBatchWithResponse(ctx context.Context, id string, body medusa.PostPublishableApiKeySalesChannelsChannelsBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPublishableApiKeySalesChannelsChannelsBatchResponse, error) {
+	f.record("PostPublishableApiKeySalesChannelsChannelsBatchWithResponse")
+	if f.PostPublishableApiKeySalesChannelsChannelsBatchWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPublishableApiKeySalesChannelsChannelsBatchWithResponse", ErrNotStubbed)
+	}
+	return f.PostPublishableApiKeySalesChannelsChannelsBatchWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostPublishableApiKeysPublishableApiKeyRevokeWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPublishableApiKeysPublishableApiKeyRevokeResponse, error) {
+	f.record("PostPublishableApiKeysPublishableApiKeyRevokeWithResponse")
+	if f.PostPublishableApiKeysPublishableApiKeyRevokeWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPublishableApiKeysPublishableApiKeyRevokeWithResponse", ErrNotStubbed)
+	}
+	return f.PostPublishableApiKeysPublishableApiKeyRevokeWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) PostPublishableApiKeysWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPublishableApiKeysResponse, error) {
+	f.record("PostPublishableApiKeysWithBodyWithResponse")
+	if f.PostPublishableApiKeysWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPublishableApiKeysWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostPublishableApiKeysWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostPublishableApiKeysWithResponse(ctx context.Context, body medusa.PostPublishableApiKeysJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPublishableApiKeysResponse, error) {
+	f.record("PostPublishableApiKeysWithResponse")
+	if f.PostPublishableApiKeysWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPublishableApiKeysWithResponse", ErrNotStubbed)
+	}
+	return f.PostPublishableApiKeysWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostPublishableApiKysPublishableApiKeyWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPublishableApiKysPublishableApiKeyResponse, error) {
+	f.record("PostPublishableApiKysPublishableApiKeyWithBodyWithResponse")
+	if f.PostPublishableApiKysPublishableApiKeyWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPublishableApiKysPublishableApiKeyWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostPublishableApiKysPublishableApiKeyWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostPublishableApiKysPublishableApiKeyWithResponse(ctx context.Context, id string, body medusa.PostPublishableApiKysPublishableApiKeyJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostPublishableApiKysPublishableApiKeyResponse, error) {
+	f.record("PostPublishableApiKysPublishableApiKeyWithResponse")
+	if f.PostPublishableApiKysPublishableApiKeyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostPublishableApiKysPublishableApiKeyWithResponse", ErrNotStubbed)
+	}
+	return f.PostPublishableApiKysPublishableApiKeyWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostRegionsRegionCountriesCountryWithResponse(ctx context.Context, id string, countryCode string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionCountriesCountryResponse, error) {
+	f.record("PostRegionsRegionCountriesCountryWithResponse")
+	if f.PostRegionsRegionCountriesCountryWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostRegionsRegionCountriesCountryWithResponse", ErrNotStubbed)
+	}
+	return f.PostRegionsRegionCountriesCountryWithResponseFunc(ctx, id, countryCode, reqEditors...)
+}
+
+func (f *FakeClient) PostRegionsRegionCountriesWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionCountriesResponse, error) {
+	f.record("PostRegionsRegionCountriesWithBodyWithResponse")
+	if f.PostRegionsRegionCountriesWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostRegionsRegionCountriesWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostRegionsRegionCountriesWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostRegionsRegionCountriesWithResponse(ctx context.Context, id string, body medusa.PostRegionsRegionCountriesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionCountriesResponse, error) {
+	f.record("PostRegionsRegionCountriesWithResponse")
+	if f.PostRegionsRegionCountriesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostRegionsRegionCountriesWithResponse", ErrNotStubbed)
+	}
+	return f.PostRegionsRegionCountriesWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostRegionsRegionFulfillmentProvidersProviderWithResponse(ctx context.Context, id string, providerId string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionFulfillmentProvidersProviderResponse, error) {
+	f.record("PostRegionsRegionFulfillmentProvidersProviderWithResponse")
+	if f.PostRegionsRegionFulfillmentProvidersProviderWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostRegionsRegionFulfillmentProvidersProviderWithResponse", ErrNotStubbed)
+	}
+	return f.PostRegionsRegionFulfillmentProvidersProviderWithResponseFunc(ctx, id, providerId, reqEditors...)
+}
+
+func (f *FakeClient) PostRegionsRegionFulfillmentProvidersWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionFulfillmentProvidersResponse, error) {
+	f.record("PostRegionsRegionFulfillmentProvidersWithBodyWithResponse")
+	if f.PostRegionsRegionFulfillmentProvidersWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostRegionsRegionFulfillmentProvidersWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostRegionsRegionFulfillmentProvidersWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostRegionsRegionFulfillmentProvidersWithResponse(ctx context.Context, id string, body medusa.PostRegionsRegionFulfillmentProvidersJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionFulfillmentProvidersResponse, error) {
+	f.record("PostRegionsRegionFulfillmentProvidersWithResponse")
+	if f.PostRegionsRegionFulfillmentProvidersWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostRegionsRegionFulfillmentProvidersWithResponse", ErrNotStubbed)
+	}
+	return f.PostRegionsRegionFulfillmentProvidersWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostRegionsRegionPaymentProvidersProviderWithResponse(ctx context.Context, id string, providerId string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionPaymentProvidersProviderResponse, error) {
+	f.record("PostRegionsRegionPaymentProvidersProviderWithResponse")
+	if f.PostRegionsRegionPaymentProvidersProviderWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostRegionsRegionPaymentProvidersProviderWithResponse", ErrNotStubbed)
+	}
+	return f.PostRegionsRegionPaymentProvidersProviderWithResponseFunc(ctx, id, providerId, reqEditors...)
+}
+
+func (f *FakeClient) PostRegionsRegionPaymentProvidersWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionPaymentProvidersResponse, error) {
+	f.record("PostRegionsRegionPaymentProvidersWithBodyWithResponse")
+	if f.PostRegionsRegionPaymentProvidersWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostRegionsRegionPaymentProvidersWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostRegionsRegionPaymentProvidersWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostRegionsRegionPaymentProvidersWithResponse(ctx context.Context, id string, body medusa.PostRegionsRegionPaymentProvidersJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionPaymentProvidersResponse, error) {
+	f.record("PostRegionsRegionPaymentProvidersWithResponse")
+	if f.PostRegionsRegionPaymentProvidersWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostRegionsRegionPaymentProvidersWithResponse", ErrNotStubbed)
+	}
+	return f.PostRegionsRegionPaymentProvidersWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostRegionsRegionWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionResponse, error) {
+	f.record("PostRegionsRegionWithBodyWithResponse")
+	if f.PostRegionsRegionWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostRegionsRegionWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostRegionsRegionWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostRegionsRegionWithResponse(ctx context.Context, id string, body medusa.PostRegionsRegionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionResponse, error) {
+	f.record("PostRegionsRegionWithResponse")
+	if f.PostRegionsRegionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostRegionsRegionWithResponse", ErrNotStubbed)
+	}
+	return f.PostRegionsRegionWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostRegionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsResponse, error) {
+	f.record("PostRegionsWithBodyWithResponse")
+	if f.PostRegionsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostRegionsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostRegionsWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostRegionsWithResponse(ctx context.Context, body medusa.PostRegionsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsResponse, error) {
+	f.record("PostRegionsWithResponse")
+	if f.PostRegionsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostRegionsWithResponse", ErrNotStubbed)
+	}
+	return f.PostRegionsWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostReservationsReservationWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReservationsReservationResponse, error) {
+	f.record("PostReservationsReservationWithBodyWithResponse")
+	if f.PostReservationsReservationWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostReservationsReservationWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostReservationsReservationWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostReservationsReservationWithResponse(ctx context.Context, id string, body medusa.PostReservationsReservationJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReservationsReservationResponse, error) {
+	f.record("PostReservationsReservationWithResponse")
+	if f.PostReservationsReservationWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostReservationsReservationWithResponse", ErrNotStubbed)
+	}
+	return f.PostReservationsReservationWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostReservationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReservationsResponse, error) {
+	f.record("PostReservationsWithBodyWithResponse")
+	if f.PostReservationsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostReservationsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostReservationsWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostReservationsWithResponse(ctx context.Context, body medusa.PostReservationsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReservationsResponse, error) {
+	f.record("PostReservationsWithResponse")
+	if f.PostReservationsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostReservationsWithResponse", ErrNotStubbed)
+	}
+	return f.PostReservationsWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostReturnReasonsReasonWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReturnReasonsReasonResponse, error) {
+	f.record("PostReturnReasonsReasonWithBodyWithResponse")
+	if f.PostReturnReasonsReasonWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostReturnReasonsReasonWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostReturnReasonsReasonWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostReturnReasonsReasonWithResponse(ctx context.Context, id string, body medusa.PostReturnReasonsReasonJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReturnReasonsReasonResponse, error) {
+	f.record("PostReturnReasonsReasonWithResponse")
+	if f.PostReturnReasonsReasonWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostReturnReasonsReasonWithResponse", ErrNotStubbed)
+	}
+	return f.PostReturnReasonsReasonWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostReturnReasonsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReturnReasonsResponse, error) {
+	f.record("PostReturnReasonsWithBodyWithResponse")
+	if f.PostReturnReasonsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostReturnReasonsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostReturnReasonsWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostReturnReasonsWithResponse(ctx context.Context, body medusa.PostReturnReasonsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReturnReasonsResponse, error) {
+	f.record("PostReturnReasonsWithResponse")
+	if f.PostReturnReasonsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostReturnReasonsWithResponse", ErrNotStubbed)
+	}
+	return f.PostReturnReasonsWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostReturnsReturnCancelWithResponse(ctx context.Context, id string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReturnsReturnCancelResponse, error) {
+	f.record("PostReturnsReturnCancelWithResponse")
+	if f.PostReturnsReturnCancelWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostReturnsReturnCancelWithResponse", ErrNotStubbed)
+	}
+	return f.PostReturnsReturnCancelWithResponseFunc(ctx, id, reqEditors...)
+}
+
+func (f *FakeClient) PostReturnsReturnReceiveWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReturnsReturnReceiveResponse, error) {
+	f.record("PostReturnsReturnReceiveWithBodyWithResponse")
+	if f.PostReturnsReturnReceiveWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostReturnsReturnReceiveWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostReturnsReturnReceiveWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostReturnsReturnReceiveWithResponse(ctx context.Context, id string, body medusa.PostReturnsReturnReceiveJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostReturnsReturnReceiveResponse, error) {
+	f.record("PostReturnsReturnReceiveWithResponse")
+	if f.PostReturnsReturnReceiveWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostReturnsReturnReceiveWithResponse", ErrNotStubbed)
+	}
+	return f.PostReturnsReturnReceiveWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostSalesChannelsChannelProductsBatchWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsChannelProductsBatchResponse, error) {
+	f.record("PostSalesChannelsChannelProductsBatchWithBodyWithResponse")
+	if f.PostSalesChannelsChannelProductsBatchWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostSalesChannelsChannelProductsBatchWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostSalesChannelsChannelProductsBatchWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostSalesChannelsChannelProductsBatchWithResponse(ctx context.Context, id string, body medusa.PostSalesChannelsChannelProductsBatchJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsChannelProductsBatchResponse, error) {
+	f.record("PostSalesChannelsChannelProductsBatchWithResponse")
+	if f.PostSalesChannelsChannelProductsBatchWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostSalesChannelsChannelProductsBatchWithResponse", ErrNotStubbed)
+	}
+	return f.PostSalesChannelsChannelProductsBatchWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostSalesChannelsSalesChannelStockLocationWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsSalesChannelStockLocationResponse, error) {
+	f.record("PostSalesChannelsSalesChannelStockLocationWithBodyWithResponse")
+	if f.PostSalesChannelsSalesChannelStockLocationWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostSalesChannelsSalesChannelStockLocationWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostSalesChannelsSalesChannelStockLocationWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostSalesChannelsSalesChannelStockLocationWithResponse(ctx context.Context, id string, body medusa.PostSalesChannelsSalesChannelStockLocationJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsSalesChannelStockLocationResponse, error) {
+	f.record("PostSalesChannelsSalesChannelStockLocationWithResponse")
+	if f.PostSalesChannelsSalesChannelStockLocationWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostSalesChannelsSalesChannelStockLocationWithResponse", ErrNotStubbed)
+	}
+	return f.PostSalesChannelsSalesChannelStockLocationWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostSalesChannelsSalesChannelWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsSalesChannelResponse, error) {
+	f.record("PostSalesChannelsSalesChannelWithBodyWithResponse")
+	if f.PostSalesChannelsSalesChannelWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostSalesChannelsSalesChannelWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostSalesChannelsSalesChannelWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostSalesChannelsSalesChannelWithResponse(ctx context.Context, id string, body medusa.PostSalesChannelsSalesChannelJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsSalesChannelResponse, error) {
+	f.record("PostSalesChannelsSalesChannelWithResponse")
+	if f.PostSalesChannelsSalesChannelWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostSalesChannelsSalesChannelWithResponse", ErrNotStubbed)
+	}
+	return f.PostSalesChannelsSalesChannelWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostSalesChannelsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsResponse, error) {
+	f.record("PostSalesChannelsWithBodyWithResponse")
+	if f.PostSalesChannelsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostSalesChannelsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostSalesChannelsWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostSalesChannelsWithResponse(ctx context.Context, body medusa.PostSalesChannelsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostSalesChannelsResponse, error) {
+	f.record("PostSalesChannelsWithResponse")
+	if f.PostSalesChannelsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostSalesChannelsWithResponse", ErrNotStubbed)
+	}
+	return f.PostSalesChannelsWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostShippingOptionsOptionWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingOptionsOptionResponse, error) {
+	f.record("PostShippingOptionsOptionWithBodyWithResponse")
+	if f.PostShippingOptionsOptionWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostShippingOptionsOptionWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostShippingOptionsOptionWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostShippingOptionsOptionWithResponse(ctx context.Context, id string, body medusa.PostShippingOptionsOptionJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingOptionsOptionResponse, error) {
+	f.record("PostShippingOptionsOptionWithResponse")
+	if f.PostShippingOptionsOptionWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostShippingOptionsOptionWithResponse", ErrNotStubbed)
+	}
+	return f.PostShippingOptionsOptionWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostShippingOptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingOptionsResponse, error) {
+	f.record("PostShippingOptionsWithBodyWithResponse")
+	if f.PostShippingOptionsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostShippingOptionsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostShippingOptionsWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostShippingOptionsWithResponse(ctx context.Context, body medusa.PostShippingOptionsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingOptionsResponse, error) {
+	f.record("PostShippingOptionsWithResponse")
+	if f.PostShippingOptionsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostShippingOptionsWithResponse", ErrNotStubbed)
+	}
+	return f.PostShippingOptionsWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostShippingProfilesProfileWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingProfilesProfileResponse, error) {
+	f.record("PostShippingProfilesProfileWithBodyWithResponse")
+	if f.PostShippingProfilesProfileWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostShippingProfilesProfileWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostShippingProfilesProfileWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostShippingProfilesProfileWithResponse(ctx context.Context, id string, body medusa.PostShippingProfilesProfileJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingProfilesProfileResponse, error) {
+	f.record("PostShippingProfilesProfileWithResponse")
+	if f.PostShippingProfilesProfileWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostShippingProfilesProfileWithResponse", ErrNotStubbed)
+	}
+	return f.PostShippingProfilesProfileWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostShippingProfilesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingProfilesResponse, error) {
+	f.record("PostShippingProfilesWithBodyWithResponse")
+	if f.PostShippingProfilesWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostShippingProfilesWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostShippingProfilesWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostShippingProfilesWithResponse(ctx context.Context, body medusa.PostShippingProfilesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostShippingProfilesResponse, error) {
+	f.record("PostShippingProfilesWithResponse")
+	if f.PostShippingProfilesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostShippingProfilesWithResponse", ErrNotStubbed)
+	}
+	return f.PostShippingProfilesWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostStockLocationsStockLocationWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostStockLocationsStockLocationParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostStockLocationsStockLocationResponse, error) {
+	f.record("PostStockLocationsStockLocationWithBodyWithResponse")
+	if f.PostStockLocationsStockLocationWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostStockLocationsStockLocationWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostStockLocationsStockLocationWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostStockLocationsStockLocationWithResponse(ctx context.Context, id string, params *medusa.PostStockLocationsStockLocationParams, body medusa.PostStockLocationsStockLocationJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostStockLocationsStockLocationResponse, error) {
+	f.record("PostStockLocationsStockLocationWithResponse")
+	if f.PostStockLocationsStockLocationWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostStockLocationsStockLocationWithResponse", ErrNotStubbed)
+	}
+	return f.PostStockLocationsStockLocationWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostStockLocationsWithBodyWithResponse(ctx context.Context, params *medusa.PostStockLocationsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostStockLocationsResponse, error) {
+	f.record("PostStockLocationsWithBodyWithResponse")
+	if f.PostStockLocationsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostStockLocationsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostStockLocationsWithBodyWithResponseFunc(ctx, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostStockLocationsWithResponse(ctx context.Context, params *medusa.PostStockLocationsParams, body medusa.PostStockLocationsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostStockLocationsResponse, error) {
+	f.record("PostStockLocationsWithResponse")
+	if f.PostStockLocationsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostStockLocationsWithResponse", ErrNotStubbed)
+	}
+	return f.PostStockLocationsWithResponseFunc(ctx, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostStoreCurrenciesCodeWithResponse(ctx context.Context, code string, reqEditors ...medusa.RequestEditorFn) (*medusa.PostStoreCurrenciesCodeResponse, error) {
+	f.record("PostStoreCurrenciesCodeWithResponse")
+	if f.PostStoreCurrenciesCodeWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostStoreCurrenciesCodeWithResponse", ErrNotStubbed)
+	}
+	return f.PostStoreCurrenciesCodeWithResponseFunc(ctx, code, reqEditors...)
+}
+
+func (f *FakeClient) PostStoreWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostStoreResponse, error) {
+	f.record("PostStoreWithBodyWithResponse")
+	if f.PostStoreWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostStoreWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostStoreWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostStoreWithResponse(ctx context.Context, body medusa.PostStoreJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostStoreResponse, error) {
+	f.record("PostStoreWithResponse")
+	if f.PostStoreWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostStoreWithResponse", ErrNotStubbed)
+	}
+	return f.PostStoreWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostTaxRatesTaxRateProductTypesWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateProductTypesParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateProductTypesResponse, error) {
+	f.record("PostTaxRatesTaxRateProductTypesWithBodyWithResponse")
+	if f.PostTaxRatesTaxRateProductTypesWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostTaxRatesTaxRateProductTypesWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostTaxRatesTaxRateProductTypesWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostTaxRatesTaxRateProductTypesWithResponse(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateProductTypesParams, body medusa.PostTaxRatesTaxRateProductTypesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateProductTypesResponse, error) {
+	f.record("PostTaxRatesTaxRateProductTypesWithResponse")
+	if f.PostTaxRatesTaxRateProductTypesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostTaxRatesTaxRateProductTypesWithResponse", ErrNotStubbed)
+	}
+	return f.PostTaxRatesTaxRateProductTypesWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostTaxRatesTaxRateProductsWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateProductsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateProductsResponse, error) {
+	f.record("PostTaxRatesTaxRateProductsWithBodyWithResponse")
+	if f.PostTaxRatesTaxRateProductsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostTaxRatesTaxRateProductsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostTaxRatesTaxRateProductsWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostTaxRatesTaxRateProductsWithResponse(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateProductsParams, body medusa.PostTaxRatesTaxRateProductsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateProductsResponse, error) {
+	f.record("PostTaxRatesTaxRateProductsWithResponse")
+	if f.PostTaxRatesTaxRateProductsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostTaxRatesTaxRateProductsWithResponse", ErrNotStubbed)
+	}
+	return f.PostTaxRatesTaxRateProductsWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostTaxRatesTaxRateShippingOptionsWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateShippingOptionsParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateShippingOptionsResponse, error) {
+	f.record("PostTaxRatesTaxRateShippingOptionsWithBodyWithResponse")
+	if f.PostTaxRatesTaxRateShippingOptionsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostTaxRatesTaxRateShippingOptionsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostTaxRatesTaxRateShippingOptionsWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostTaxRatesTaxRateShippingOptionsWithResponse(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateShippingOptionsParams, body medusa.PostTaxRatesTaxRateShippingOptionsJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateShippingOptionsResponse, error) {
+	f.record("PostTaxRatesTaxRateShippingOptionsWithResponse")
+	if f.PostTaxRatesTaxRateShippingOptionsWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostTaxRatesTaxRateShippingOptionsWithResponse", ErrNotStubbed)
+	}
+	return f.PostTaxRatesTaxRateShippingOptionsWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostTaxRatesTaxRateWithBodyWithResponse(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateResponse, error) {
+	f.record("PostTaxRatesTaxRateWithBodyWithResponse")
+	if f.PostTaxRatesTaxRateWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostTaxRatesTaxRateWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostTaxRatesTaxRateWithBodyWithResponseFunc(ctx, id, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostTaxRatesTaxRateWithResponse(ctx context.Context, id string, params *medusa.PostTaxRatesTaxRateParams, body medusa.PostTaxRatesTaxRateJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesTaxRateResponse, error) {
+	f.record("PostTaxRatesTaxRateWithResponse")
+	if f.PostTaxRatesTaxRateWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostTaxRatesTaxRateWithResponse", ErrNotStubbed)
+	}
+	return f.PostTaxRatesTaxRateWithResponseFunc(ctx, id, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostTaxRatesWithBodyWithResponse(ctx context.Context, params *medusa.PostTaxRatesParams, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesResponse, error) {
+	f.record("PostTaxRatesWithBodyWithResponse")
+	if f.PostTaxRatesWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostTaxRatesWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostTaxRatesWithBodyWithResponseFunc(ctx, params, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostTaxRatesWithResponse(ctx context.Context, params *medusa.PostTaxRatesParams, body medusa.PostTaxRatesJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTaxRatesResponse, error) {
+	f.record("PostTaxRatesWithResponse")
+	if f.PostTaxRatesWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostTaxRatesWithResponse", ErrNotStubbed)
+	}
+	return f.PostTaxRatesWithResponseFunc(ctx, params, body, reqEditors...)
+}
+
+func (f *FakeClient) PostTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTokenResponse, error) {
+	f.record("PostTokenWithBodyWithResponse")
+	if f.PostTokenWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostTokenWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostTokenWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostTokenWithResponse(ctx context.Context, body medusa.PostTokenJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostTokenResponse, error) {
+	f.record("PostTokenWithResponse")
+	if f.PostTokenWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostTokenWithResponse", ErrNotStubbed)
+	}
+	return f.PostTokenWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostUploadsDownloadUrlWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUploadsDownloadUrlResponse, error) {
+	f.record("PostUploadsDownloadUrlWithBodyWithResponse")
+	if f.PostUploadsDownloadUrlWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostUploadsDownloadUrlWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostUploadsDownloadUrlWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostUploadsDownloadUrlWithResponse(ctx context.Context, body medusa.PostUploadsDownloadUrlJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUploadsDownloadUrlResponse, error) {
+	f.record("PostUploadsDownloadUrlWithResponse")
+	if f.PostUploadsDownloadUrlWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostUploadsDownloadUrlWithResponse", ErrNotStubbed)
+	}
+	return f.PostUploadsDownloadUrlWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostUploadsProtectedWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUploadsProtectedResponse, error) {
+	f.record("PostUploadsProtectedWithBodyWithResponse")
+	if f.PostUploadsProtectedWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostUploadsProtectedWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostUploadsProtectedWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostUploadsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUploadsResponse, error) {
+	f.record("PostUploadsWithBodyWithResponse")
+	if f.PostUploadsWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostUploadsWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostUploadsWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostUsersUserPasswordTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersUserPasswordTokenResponse, error) {
+	f.record("PostUsersUserPasswordTokenWithBodyWithResponse")
+	if f.PostUsersUserPasswordTokenWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostUsersUserPasswordTokenWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostUsersUserPasswordTokenWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostUsersUserPasswordTokenWithResponse(ctx context.Context, body medusa.PostUsersUserPasswordTokenJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersUserPasswordTokenResponse, error) {
+	f.record("PostUsersUserPasswordTokenWithResponse")
+	if f.PostUsersUserPasswordTokenWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostUsersUserPasswordTokenWithResponse", ErrNotStubbed)
+	}
+	return f.PostUsersUserPasswordTokenWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostUsersUserPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersUserPasswordResponse, error) {
+	f.record("PostUsersUserPasswordWithBodyWithResponse")
+	if f.PostUsersUserPasswordWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostUsersUserPasswordWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostUsersUserPasswordWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostUsersUserPasswordWithResponse(ctx context.Context, body medusa.PostUsersUserPasswordJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersUserPasswordResponse, error) {
+	f.record("PostUsersUserPasswordWithResponse")
+	if f.PostUsersUserPasswordWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostUsersUserPasswordWithResponse", ErrNotStubbed)
+	}
+	return f.PostUsersUserPasswordWithResponseFunc(ctx, body, reqEditors...)
+}
+
+func (f *FakeClient) PostUsersUserWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersUserResponse, error) {
+	f.record("PostUsersUserWithBodyWithResponse")
+	if f.PostUsersUserWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostUsersUserWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostUsersUserWithBodyWithResponseFunc(ctx, id, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostUsersUserWithResponse(ctx context.Context, id string, body medusa.PostUsersUserJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersUserResponse, error) {
+	f.record("PostUsersUserWithResponse")
+	if f.PostUsersUserWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostUsersUserWithResponse", ErrNotStubbed)
+	}
+	return f.PostUsersUserWithResponseFunc(ctx, id, body, reqEditors...)
+}
+
+func (f *FakeClient) PostUsersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersResponse, error) {
+	f.record("PostUsersWithBodyWithResponse")
+	if f.PostUsersWithBodyWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostUsersWithBodyWithResponse", ErrNotStubbed)
+	}
+	return f.PostUsersWithBodyWithResponseFunc(ctx, contentType, body, reqEditors...)
+}
+
+func (f *FakeClient) PostUsersWithResponse(ctx context.Context, body medusa.PostUsersJSONRequestBody, reqEditors ...medusa.RequestEditorFn) (*medusa.PostUsersResponse, error) {
+	f.record("PostUsersWithResponse")
+	if f.PostUsersWithResponseFunc == nil {
+		return nil, fmt.Errorf("%w: PostUsersWithResponse", ErrNotStubbed)
+	}
+	return f.PostUsersWithResponseFunc(ctx, body, reqEditors...)
+}
@@ -0,0 +1,176 @@
+//go:build ignore
+
+// This program generates fake.gen.go from the utils.Client interface.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	clientPackage = "github.com/ikhvost/terraform-provider-medusa/internal/utils"
+	clientName    = "Client"
+	output        = "fake.gen.go"
+)
+
+func main() {
+	pkg, err := importer.ForCompiler(token.NewFileSet(), "source", nil).Import(clientPackage)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	iface, ok := pkg.Scope().Lookup(clientName).Type().Underlying().(*types.Interface)
+	if !ok {
+		log.Fatalf("%s.%s is not an interface", clientPackage, clientName)
+	}
+
+	imports := map[string]string{"errors": "errors", "fmt": "fmt", "sync": "sync", clientPackage: pkg.Name()}
+	qualifier := func(p *types.Package) string {
+		imports[p.Path()] = p.Name()
+		return p.Name()
+	}
+
+	var methods []*types.Func
+	for i := 0; i < iface.NumMethods(); i++ {
+		methods = append(methods, iface.Method(i))
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].Name() < methods[j].Name() })
+
+	var fields, bodies bytes.Buffer
+	for _, method := range methods {
+		writeMethod(&fields, &bodies, method, qualifier)
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by generate.go; DO NOT EDIT.\n\npackage clientfake\n\nimport (\n")
+	var std, other []string
+	for path := range imports {
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			other = append(other, path)
+		} else {
+			std = append(std, path)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	for _, path := range std {
+		fmt.Fprintf(&out, "\t%q\n", path)
+	}
+	fmt.Fprintf(&out, "\n")
+	for _, path := range other {
+		fmt.Fprintf(&out, "\t%q\n", path)
+	}
+	fmt.Fprintf(&out, ")\n\n")
+	fmt.Fprintf(&out, "var _ %s.%s = &FakeClient{}\n\n", pkg.Name(), clientName)
+	fmt.Fprintf(&out, "// ErrNotStubbed is returned by methods called without a stub.\n")
+	fmt.Fprintf(&out, "var ErrNotStubbed = errors.New(\"clientfake: method is not stubbed\")\n\n")
+	fmt.Fprintf(&out, "// FakeClient implements %s.%s with a stub function per method.\n", pkg.Name(), clientName)
+	fmt.Fprintf(&out, "type FakeClient struct {\n\tmu sync.Mutex\n\tcalls []string\n\n%s}\n\n", fields.String())
+	fmt.Fprintf(&out, `// Calls returns the names of the methods called so far, in order.
+func (f *FakeClient) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]string(nil), f.calls...)
+}
+
+func (f *FakeClient) record(method string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, method)
+}
+
+`)
+	out.Write(bodies.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatalf("unable to format the generated code: %s", err)
+	}
+	if err := os.WriteFile(output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeMethod(fields, bodies *bytes.Buffer, method *types.Func, qualifier types.Qualifier) {
+	sig := method.Type().(*types.Signature)
+	name := method.Name()
+
+	var params, args []string
+	for i := 0; i < sig.Params().Len(); i++ {
+		param := sig.Params().At(i)
+		paramName := param.Name()
+		if paramName == "" || paramName == "_" {
+			paramName = fmt.Sprintf("p%d", i)
+		}
+
+		typ := types.TypeString(param.Type(), qualifier)
+		arg := paramName
+		if sig.Variadic() && i == sig.Params().Len()-1 {
+			typ = "..." + types.TypeString(param.Type().(*types.Slice).Elem(), qualifier)
+			arg += "..."
+		}
+		params = append(params, paramName+" "+typ)
+		args = append(args, arg)
+	}
+
+	var results []string
+	for i := 0; i < sig.Results().Len(); i++ {
+		results = append(results, types.TypeString(sig.Results().At(i).Type(), qualifier))
+	}
+	resultList := strings.Join(results, ", ")
+	if len(results) > 1 {
+		resultList = "(" + resultList + ")"
+	}
+
+	funcType := fmt.Sprintf("func(%s) %s", strings.Join(params, ", "), resultList)
+	fmt.Fprintf(fields, "\t%sFunc %s\n", name, funcType)
+
+	fmt.Fprintf(bodies, "func (f *FakeClient) %s(%s) %s {\n", name, strings.Join(params, ", "), resultList)
+	fmt.Fprintf(bodies, "\tf.record(%q)\n", name)
+	fmt.Fprintf(bodies, "\tif f.%sFunc == nil {\n", name)
+	if len(results) > 0 && results[len(results)-1] == "error" {
+		var zeros []string
+		for i := 0; i < len(results)-1; i++ {
+			zeros = append(zeros, zeroValue(sig.Results().At(i).Type(), qualifier))
+		}
+		zeros = append(zeros, fmt.Sprintf("fmt.Errorf(\"%%w: %s\", ErrNotStubbed)", name))
+		fmt.Fprintf(bodies, "\t\treturn %s\n", strings.Join(zeros, ", "))
+	} else {
+		fmt.Fprintf(bodies, "\t\tpanic(\"clientfake: %s is not stubbed\")\n", name)
+	}
+	fmt.Fprintf(bodies, "\t}\n")
+	if len(results) > 0 {
+		fmt.Fprintf(bodies, "\treturn f.%sFunc(%s)\n}\n\n", name, strings.Join(args, ", "))
+	} else {
+		fmt.Fprintf(bodies, "\tf.%sFunc(%s)\n}\n\n", name, strings.Join(args, ", "))
+	}
+}
+
+func zeroValue(t types.Type, qualifier types.Qualifier) string {
+	switch u := t.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Map, *types.Interface, *types.Signature, *types.Chan:
+		return "nil"
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsString != 0:
+			return `""`
+		case u.Info()&types.IsBoolean != 0:
+			return "false"
+		default:
+			return "0"
+		}
+	default:
+		return types.TypeString(t, qualifier) + "{}"
+	}
+}
@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+)
+
+// updatedAtKey is the private state key that holds the updated_at time of
+// the object when it was last read or written by the provider.
+const updatedAtKey = "updated_at"
+
+// Values of the on_concurrent_change provider attribute.
+const (
+	concurrentChangeWarn = "warn"
+	concurrentChangeFail = "fail"
+)
+
+// privateState is the private state of the resource requests and responses,
+// whose type is internal to the framework.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// providerClient is the client the provider hands to its resources, together
+// with the settings that change how they behave.
+type providerClient struct {
+	utils.Client
+
+	onConcurrentChange string
+	regionPlans        *regionPlans
+}
+
+// recordUpdatedAt keeps the updated_at time of the object in private state,
+// to find out on the next update whether it changed in between.
+func recordUpdatedAt(ctx context.Context, private privateState, updatedAt time.Time) diag.Diagnostics {
+	b, err := json.Marshal(updatedAt)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error recording updated_at", "Could not encode updated_at, unexpected error: "+err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, updatedAtKey, b)
+}
+
+// checkConcurrentChange compares the updated_at time of the object, returned
+// by current, to the one recorded in private state. When they differ, someone
+// changed the object after Terraform last read it, and it adds a warning, or
+// an error when the provider sets on_concurrent_change to fail. Objects
+// without a recorded time, such as those in states written by older versions
+// of the provider, are not checked.
+func checkConcurrentChange(ctx context.Context, client utils.Client, private privateState, name, id string, current func() (time.Time, *diag.ErrorDiagnostic), diags *diag.Diagnostics) {
+	b, d := private.GetKey(ctx, updatedAtKey)
+	diags.Append(d...)
+	if diags.HasError() || len(b) == 0 {
+		return
+	}
+
+	var recorded time.Time
+	if err := json.Unmarshal(b, &recorded); err != nil {
+		diags.AddError(
+			fmt.Sprintf("Error updating %s", name),
+			"Could not read the recorded updated_at, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	updatedAt, d2 := current()
+	if d2 != nil {
+		diags.Append(d2)
+		return
+	}
+	if updatedAt.Equal(recorded) {
+		return
+	}
+
+	summary := fmt.Sprintf("The %s changed outside of Terraform", name)
+	detail := fmt.Sprintf("The %s %s was updated at %s, after Terraform last read it at %s. ",
+		name, id, updatedAt.Format(time.RFC3339Nano), recorded.Format(time.RFC3339Nano))
+
+	if c, ok := client.(*providerClient); ok && c.onConcurrentChange == concurrentChangeFail {
+		diags.AddError(summary, detail+"Nothing was changed. Refresh and review the plan again before applying it.")
+		return
+	}
+	diags.AddWarning(summary, detail+"Only the attributes changed in the configuration were sent, "+
+		"so the other changes were kept.")
+}
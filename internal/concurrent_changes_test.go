@@ -0,0 +1,158 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+)
+
+// testPrivateState is private state kept in a map.
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testPrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	p[key] = value
+	return nil
+}
+
+func TestCheckConcurrentChange(t *testing.T) {
+	read := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	later := read.Add(time.Minute)
+
+	tests := []struct {
+		name        string
+		recorded    *time.Time
+		current     time.Time
+		currentErr  bool
+		mode        string
+		wantCurrent bool
+		wantWarning bool
+		wantError   bool
+	}{
+		{name: "not recorded"},
+		{name: "unchanged", recorded: &read, current: read, wantCurrent: true},
+		{name: "changed", recorded: &read, current: later, wantCurrent: true, wantWarning: true},
+		{name: "changed with warn", recorded: &read, current: later, mode: concurrentChangeWarn, wantCurrent: true, wantWarning: true},
+		{name: "changed with fail", recorded: &read, current: later, mode: concurrentChangeFail, wantCurrent: true, wantError: true},
+		{name: "unchanged with fail", recorded: &read, current: read, mode: concurrentChangeFail, wantCurrent: true},
+		{name: "read error", recorded: &read, currentErr: true, wantCurrent: true, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			private := testPrivateState{}
+			if tt.recorded != nil {
+				if diags := recordUpdatedAt(ctx, private, *tt.recorded); diags.HasError() {
+					t.Fatal(diags)
+				}
+			}
+
+			var client utils.Client = utils.NewClient(nil, "", nil)
+			if tt.mode != "" {
+				client = &providerClient{Client: client, onConcurrentChange: tt.mode}
+			}
+
+			called := false
+			current := func() (time.Time, *diag.ErrorDiagnostic) {
+				called = true
+				if tt.currentErr {
+					d := diag.NewErrorDiagnostic("Error retrieving customer group", "unavailable")
+					return time.Time{}, &d
+				}
+				return tt.current, nil
+			}
+
+			var diags diag.Diagnostics
+			checkConcurrentChange(ctx, client, private, "customer group", "cgrp_1", current, &diags)
+
+			if called != tt.wantCurrent {
+				t.Errorf("expected the current time to be read %v, got %v", tt.wantCurrent, called)
+			}
+			if got := diags.WarningsCount() > 0; got != tt.wantWarning {
+				t.Errorf("expected warning %v, got %v", tt.wantWarning, diags)
+			}
+			if got := diags.HasError(); got != tt.wantError {
+				t.Errorf("expected error %v, got %v", tt.wantError, diags)
+			}
+		})
+	}
+}
+
+func TestCustomerGroupResourceConcurrentChange(t *testing.T) {
+	for _, mode := range []string{concurrentChangeWarn, concurrentChangeFail} {
+		t.Run(mode, func(t *testing.T) {
+			ctx := context.Background()
+			sdk := newFakeTestAccHarness(t).client(t)
+			r := &customerGroupResource{client: &providerClient{
+				Client:             utils.NewClient(sdk, "", nil),
+				onConcurrentChange: mode,
+			}}
+			s := testResourceSchema(t, r)
+
+			plan := customerGroupResourceModel{ID: types.StringUnknown(), Name: types.StringValue("VIP")}
+			created := fwresource.CreateResponse{State: testState(t, s, nil)}
+			created.Private = testPrivate(created.Private)
+			r.Create(ctx, fwresource.CreateRequest{Plan: testPlan(t, s, plan)}, &created)
+			if created.Diagnostics.HasError() {
+				t.Fatal(created.Diagnostics)
+			}
+
+			var state customerGroupResourceModel
+			if diags := created.State.Get(ctx, &state); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			// Without a change in between, the update goes through quietly.
+			plan = state
+			plan.Name = types.StringValue("Gold")
+			req := fwresource.UpdateRequest{Plan: testPlan(t, s, plan), State: created.State, Private: created.Private}
+			updated := fwresource.UpdateResponse{State: req.State, Private: req.Private}
+			r.Update(ctx, req, &updated)
+			if len(updated.Diagnostics) > 0 {
+				t.Fatalf("expected no diagnostics, got %v", updated.Diagnostics)
+			}
+
+			// Terraform last read the customer group before it was changed.
+			if diags := recordUpdatedAt(ctx, updated.Private, time.Unix(0, 0)); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			plan.Name = types.StringValue("Platinum")
+			req = fwresource.UpdateRequest{Plan: testPlan(t, s, plan), State: updated.State, Private: updated.Private}
+			resp := fwresource.UpdateResponse{State: req.State, Private: req.Private}
+			r.Update(ctx, req, &resp)
+
+			content, err := sdk.GetCustomerGroupsGroupWithResponse(ctx, state.ID.ValueString(), nil)
+			if err != nil || content.JSON200 == nil {
+				t.Fatalf("unable to read customer group: %v", err)
+			}
+			name := content.JSON200.CustomerGroup.Name
+
+			switch mode {
+			case concurrentChangeWarn:
+				if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+					t.Errorf("expected a single warning, got %v", resp.Diagnostics)
+				}
+				if name != "Platinum" {
+					t.Errorf("expected the update to be applied, got name %q", name)
+				}
+			case concurrentChangeFail:
+				if !resp.Diagnostics.HasError() {
+					t.Errorf("expected an error, got %v", resp.Diagnostics)
+				}
+				if name != "Gold" {
+					t.Errorf("expected the update to be stopped, got name %q", name)
+				}
+			}
+		})
+	}
+}
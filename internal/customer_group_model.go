@@ -5,23 +5,27 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
 )
 
 // customerGroupResourceModel maps the resource schema data.
 type customerGroupResourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	ID       types.String            `tfsdk:"id"`
+	Name     types.String            `tfsdk:"name"`
+	Metadata map[string]types.String `tfsdk:"metadata"`
 }
 
 func (m *customerGroupResourceModel) toCreateInput() medusa.AdminPostCustomerGroupsReq {
 	return medusa.AdminPostCustomerGroupsReq{
-		Name: m.Name.ValueString(),
+		Name:     m.Name.ValueString(),
+		Metadata: utils.ConvertToMetadata(m.Metadata),
 	}
 }
 
-func (m *customerGroupResourceModel) toUpdateInput() medusa.AdminPostCustomerGroupsGroupReq {
+func (m *customerGroupResourceModel) toUpdateInput(prior *customerGroupResourceModel) medusa.AdminPostCustomerGroupsGroupReq {
 	return medusa.AdminPostCustomerGroupsGroupReq{
-		Name: m.Name.ValueStringPointer(),
+		Name:     utils.ChangedString(m.Name, prior.Name),
+		Metadata: utils.ConvertToMetadataUpdate(m.Metadata, prior.Metadata),
 	}
 }
 
@@ -32,6 +36,7 @@ func (m *customerGroupResourceModel) fromRemote(c *medusa.AdminCustomerGroupsRes
 
 	m.ID = types.StringValue(c.CustomerGroup.Id)
 	m.Name = types.StringValue(c.CustomerGroup.Name)
+	m.Metadata = utils.ConvertToTerraformMetadata(m.Metadata, c.CustomerGroup.Metadata)
 
 	return nil
 }
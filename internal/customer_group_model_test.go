@@ -0,0 +1,95 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCustomerGroupModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	tests := []struct {
+		name   string
+		create customerGroupResourceModel
+		update customerGroupResourceModel
+	}{
+		{
+			name:   "rename",
+			create: customerGroupResourceModel{Name: types.StringValue("VIP")},
+			update: customerGroupResourceModel{Name: types.StringValue("Very Important")},
+		},
+		{
+			name:   "metadata",
+			create: customerGroupResourceModel{Name: types.StringValue("Wholesale"), Metadata: testMetadata("erp_id", "42", "channel", "web")},
+			update: customerGroupResourceModel{Name: types.StringValue("Wholesale EU"), Metadata: testMetadata("erp_id", "43")},
+		},
+		{
+			name:   "unicode",
+			create: customerGroupResourceModel{Name: types.StringValue("Stammkunden")},
+			update: customerGroupResourceModel{Name: types.StringValue("Stammkunden ★")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created, err := client.PostCustomerGroupsWithResponse(ctx, tt.create.toCreateInput())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			state := customerGroupResourceModel{Metadata: tt.create.Metadata}
+			if err := state.fromRemote(created.JSON200); err != nil {
+				t.Fatalf("%s: %s", err, created.Body)
+			}
+			tt.create.ID = state.ID
+			checkModel(t, tt.create, state)
+
+			prior := state
+			updated, err := client.PostCustomerGroupsGroupWithResponse(ctx, state.ID.ValueString(), tt.update.toUpdateInput(&prior))
+			if err != nil {
+				t.Fatal(err)
+			}
+			state = customerGroupResourceModel{Metadata: tt.update.Metadata}
+			if err := state.fromRemote(updated.JSON200); err != nil {
+				t.Fatalf("%s: %s", err, updated.Body)
+			}
+			tt.update.ID = state.ID
+			checkModel(t, tt.update, state)
+		})
+	}
+}
+
+func TestCustomerGroupModelProperties(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	created, err := client.PostCustomerGroupsWithResponse(ctx, (&customerGroupResourceModel{Name: types.StringValue("Group")}).toCreateInput())
+	if err != nil || created.JSON200 == nil {
+		t.Fatalf("unable to create customer group: %v", err)
+	}
+	id := created.JSON200.CustomerGroup.Id
+
+	checkModelProperty(t, func(name string) bool {
+		plan := customerGroupResourceModel{Name: quickString(name)}
+
+		updated, err := client.PostCustomerGroupsGroupWithResponse(ctx, id, plan.toUpdateInput(&customerGroupResourceModel{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var state customerGroupResourceModel
+		if err := state.fromRemote(updated.JSON200); err != nil {
+			t.Fatalf("%s: %s", err, updated.Body)
+		}
+		plan.ID = state.ID
+
+		diff := modelDiff(plan, state)
+		for _, d := range diff {
+			t.Log(d)
+		}
+		return len(diff) == 0
+	})
+}
@@ -2,8 +2,10 @@ package internal
 
 import (
 	"context"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -11,8 +13,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
-
-	"github.com/ikhvost/medusajs-go-sdk/medusa"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -29,7 +29,7 @@ func NewCustomerGroupResource() resource.Resource {
 
 // customerGroupResource is the resource implementation.
 type customerGroupResource struct {
-	client medusa.ClientWithResponsesInterface
+	client utils.Client
 }
 
 // Metadata returns the data source type name.
@@ -53,17 +53,24 @@ func (r *customerGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 				Description: "The name of the customer group.",
 				Required:    true,
 			},
+			"metadata": metadataAttribute("customer group"),
 		},
 	}
 }
 
 // Configure adds the provider configured client to the data source.
-func (r *customerGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (r *customerGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	r.client = utils.GetClient(req.ProviderData)
+	client, d := utils.GetClient(req.ProviderData)
+	if d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	r.client = client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -103,6 +110,8 @@ func (r *customerGroupResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.CustomerGroup.UpdatedAt)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -139,6 +148,8 @@ func (r *customerGroupResource) Read(ctx context.Context, req resource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.CustomerGroup.UpdatedAt)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -151,8 +162,28 @@ func (r *customerGroupResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	// Retrieve values from state, to know the metadata keys to remove
+	var state customerGroupResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check that the customer group did not change since Terraform last read it
+	checkConcurrentChange(ctx, r.client, req.Private, "customer group", plan.ID.ValueString(), func() (time.Time, *diag.ErrorDiagnostic) {
+		content, err := r.client.GetCustomerGroupsGroupWithResponse(ctx, plan.ID.ValueString(), nil)
+		if d := utils.CheckGetError("customer_group", plan.ID.ValueString(), content, err); d != nil {
+			return time.Time{}, d
+		}
+		return content.JSON200.CustomerGroup.UpdatedAt, nil
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Generate API request body from plan
-	input := plan.toUpdateInput()
+	input := plan.toUpdateInput(&state)
 
 	content, err := r.client.PostCustomerGroupsGroupWithResponse(ctx, plan.ID.ValueString(), input)
 	if d := utils.CheckUpdateError("customer_group", content, err); d != nil {
@@ -177,6 +208,8 @@ func (r *customerGroupResource) Update(ctx context.Context, req resource.UpdateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.CustomerGroup.UpdatedAt)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -0,0 +1,83 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+func init() {
+	resource.AddTestSweepers("medusa_customer_group", &resource.Sweeper{
+		Name: "medusa_customer_group",
+		F: sweep("medusa_customer_group", listCustomerGroups, func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error) {
+			res, err := client.DeleteCustomerGroupsCustomerGroupWithResponse(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return res.StatusCode(), nil
+		}),
+	})
+}
+
+func listCustomerGroups(ctx context.Context, client medusa.ClientWithResponsesInterface, offset int) ([]sweepable, int, error) {
+	res, err := client.GetCustomerGroupsWithResponse(ctx, &medusa.GetCustomerGroupsParams{
+		Q:      ptr(testAccNamePrefix),
+		Offset: &offset,
+		Limit:  ptr(importPageSize),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if res.JSON200 == nil {
+		return nil, 0, listError(res.StatusCode(), res.Body)
+	}
+
+	var objects []sweepable
+	for _, group := range res.JSON200.CustomerGroups {
+		objects = append(objects, sweepable{ID: group.Id, Name: group.Name})
+	}
+	return objects, res.JSON200.Count, nil
+}
+
+func TestAccCustomerGroupResource(t *testing.T) {
+	h := newTestAccHarness(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		CheckDestroy: h.checkDestroy(t, "medusa_customer_group", func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error) {
+			content, err := client.GetCustomerGroupsGroupWithResponse(ctx, id, nil)
+			if err != nil {
+				return 0, err
+			}
+			return content.StatusCode(), nil
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: h.providerConfig() + `
+resource "medusa_customer_group" "test" {
+  name = "tf-acc-customer-group"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("medusa_customer_group.test", "id"),
+					resource.TestCheckResourceAttr("medusa_customer_group.test", "name", "tf-acc-customer-group"),
+				),
+			},
+			{
+				ResourceName:      "medusa_customer_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: h.providerConfig() + `
+resource "medusa_customer_group" "test" {
+  name = "tf-acc-customer-group-updated"
+}
+`,
+				Check: resource.TestCheckResourceAttr("medusa_customer_group.test", "name", "tf-acc-customer-group-updated"),
+			},
+		},
+	})
+}
@@ -1,72 +1,218 @@
 package internal
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
-	"log"
+	"io"
 	"net/http"
-	"net/http/httputil"
-	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func NewDebugTransport(innerTransport http.RoundTripper) http.RoundTripper {
+const (
+	// debugSubsystem is the tflog subsystem HTTP traffic is logged to. Its
+	// level can be set separately with TF_LOG_PROVIDER_MEDUSA_HTTP.
+	debugSubsystem = "http"
+
+	// defaultDebugBodySize is the number of body bytes logged when the
+	// provider configuration does not set debug_http_max_body_size.
+	defaultDebugBodySize = 4096
+
+	redacted = "[REDACTED]"
+)
+
+// redactedHeaders are replaced before a request or response is logged.
+var redactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// redactedFields are JSON object keys whose values are replaced before a
+// body is logged.
+var redactedFields = []string{
+	"password",
+	"api_token",
+	"access_token",
+}
+
+func NewDebugTransport(innerTransport http.RoundTripper, maxBodySize int) http.RoundTripper {
 	return &LogTransport{
-		transport: innerTransport,
+		transport:   innerTransport,
+		maxBodySize: maxBodySize,
 	}
 }
 
+// LogTransport logs requests and responses at TRACE level with secrets
+// redacted.
 type LogTransport struct {
-	transport http.RoundTripper
-}
-
-var DebugTransport = &LogTransport{
-	transport: http.DefaultTransport,
+	transport   http.RoundTripper
+	maxBodySize int
 }
 
 func (c *LogTransport) RoundTrip(request *http.Request) (*http.Response, error) {
-	if os.Getenv("SB_DEBUG") != "" {
-		logRequest(request.Context(), request)
-	}
+	ctx := tflog.NewSubsystem(request.Context(), debugSubsystem,
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER_MEDUSA_HTTP"))
+
+	c.logRequest(ctx, request)
+	start := time.Now()
+
 	response, err := c.transport.RoundTrip(request)
-	if os.Getenv("SB_DEBUG") != "" {
-		logResponse(request.Context(), response, err)
-	}
+	c.logResponse(ctx, response, err, time.Since(start))
+
 	return response, err
 }
 
-const logRequestTemplate = `DEBUG:
----[ REQUEST ]--------------------------------------------------------
-%s
-----------------------------------------------------------------------
-`
-
-const logResponseTemplate = `DEBUG:
----[ RESPONSE ]-------------------------------------------------------
-%s
-----------------------------------------------------------------------
-`
-
-func logRequest(ctx context.Context, r *http.Request) {
-	body, err := httputil.DumpRequestOut(r, true)
+func (c *LogTransport) logRequest(ctx context.Context, r *http.Request) {
+	body, err := readRequestBody(r)
 	if err != nil {
+		tflog.SubsystemTrace(ctx, debugSubsystem, "Unable to read Medusa API request body", map[string]any{
+			"error": err.Error(),
+		})
 		return
 	}
-	tflog.Info(ctx, fmt.Sprintf(logRequestTemplate, body))
-	log.Printf(logRequestTemplate, body)
+
+	tflog.SubsystemTrace(ctx, debugSubsystem, "Sending Medusa API request", map[string]any{
+		"http_method":          r.Method,
+		"http_url":             r.URL.String(),
+		"http_request_headers": redactHeaders(r.Header),
+		"http_request_body":    c.formatBody(body),
+	})
 }
 
-func logResponse(ctx context.Context, r *http.Response, err error) {
+func (c *LogTransport) logResponse(ctx context.Context, r *http.Response, err error, duration time.Duration) {
 	if err != nil {
-		tflog.Info(ctx, fmt.Sprintf(logResponseTemplate, err))
-		log.Printf(logResponseTemplate, err)
+		tflog.SubsystemTrace(ctx, debugSubsystem, "Medusa API request failed", map[string]any{
+			"error":       err.Error(),
+			"duration_ms": duration.Milliseconds(),
+		})
 		return
 	}
-	body, err := httputil.DumpResponse(r, true)
+
+	body, err := readResponseBody(r)
 	if err != nil {
+		tflog.SubsystemTrace(ctx, debugSubsystem, "Unable to read Medusa API response body", map[string]any{
+			"error": err.Error(),
+		})
 		return
 	}
-	tflog.Info(ctx, fmt.Sprintf(logResponseTemplate, body))
-	log.Printf(logResponseTemplate, body)
+
+	tflog.SubsystemTrace(ctx, debugSubsystem, "Received Medusa API response", map[string]any{
+		"http_status":           r.StatusCode,
+		"http_response_headers": redactHeaders(r.Header),
+		"http_response_body":    c.formatBody(body),
+		"duration_ms":           duration.Milliseconds(),
+	})
+}
+
+// formatBody redacts secrets from a JSON body and truncates it to the
+// configured size.
+func (c *LogTransport) formatBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err == nil {
+		if b, err := json.Marshal(redactJSON(value, redactedFields)); err == nil {
+			body = b
+		}
+	}
+
+	if c.maxBodySize > 0 && len(body) > c.maxBodySize {
+		// Cut at the start of a character, so that it is not split.
+		n := c.maxBodySize
+		for n > 0 && !utf8.RuneStart(body[n]) {
+			n--
+		}
+		return fmt.Sprintf("%s... (%d bytes truncated)", body[:n], len(body)-n)
+	}
+	return string(body)
+}
+
+// redactJSON replaces the values of the given object keys, at any depth of
+// a decoded JSON value.
+func redactJSON(value any, fields []string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if isRedactedField(key, fields) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactJSON(item, fields)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactJSON(item, fields)
+		}
+	}
+	return value
+}
+
+func isRedactedField(key string, fields []string) bool {
+	for _, field := range fields {
+		if strings.EqualFold(key, field) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactHeaders(headers http.Header) map[string]string {
+	result := make(map[string]string, len(headers))
+	for key, values := range headers {
+		result[key] = strings.Join(values, ", ")
+	}
+	for _, key := range redactedHeaders {
+		if _, ok := result[key]; ok {
+			result[key] = redacted
+		}
+	}
+	return result
+}
+
+// readRequestBody returns the request body and leaves the request readable.
+func readRequestBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(body)
+	}
+
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+// readResponseBody returns the response body and leaves the response readable.
+func readResponseBody(r *http.Response) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
 }
@@ -0,0 +1,126 @@
+package internal
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLogTransportFormatBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxBodySize int
+		body        string
+		want        string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "redacted fields",
+			body: `{"email":"admin@example.com","password":"secret"}`,
+			want: `{"email":"admin@example.com","password":"[REDACTED]"}`,
+		},
+		{
+			name: "nested and case insensitive",
+			body: `{"user":{"API_Token":"abc","name":"Admin"},"tokens":[{"access_token":"def"}]}`,
+			want: `{"tokens":[{"access_token":"[REDACTED]"}],"user":{"API_Token":"[REDACTED]","name":"Admin"}}`,
+		},
+		{
+			name: "not json",
+			body: `password=secret`,
+			want: `password=secret`,
+		},
+		{
+			name:        "truncated",
+			maxBodySize: 10,
+			body:        `{"name":"Europe","currency_code":"eur"}`,
+			want:        `{"currency... (29 bytes truncated)`,
+		},
+		{
+			name:        "redacted before truncated",
+			maxBodySize: 20,
+			body:        `{"password":"a very long secret"}`,
+			want:        `{"password":"[REDACT... (5 bytes truncated)`,
+		},
+		{
+			name:        "truncated at a character",
+			maxBodySize: 12,
+			body:        `{"name":"Größe"}`,
+			want:        `{"name":"Gr... (7 bytes truncated)`,
+		},
+		{
+			name: "not truncated",
+			body: `{"name":"Europe"}`,
+			want: `{"name":"Europe"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &LogTransport{maxBodySize: tt.maxBodySize}
+			if got := c.formatBody([]byte(tt.body)); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	headers := http.Header{
+		"Authorization": {"Bearer token"},
+		"Set-Cookie":    {"a=1", "b=2"},
+		"Accept":        {"application/json", "text/plain"},
+	}
+
+	got := redactHeaders(headers)
+	want := map[string]string{
+		"Authorization": redacted,
+		"Set-Cookie":    redacted,
+		"Accept":        "application/json, text/plain",
+	}
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("header %s: expected %q, got %q", key, value, got[key])
+		}
+	}
+	if headers.Get("Authorization") != "Bearer token" {
+		t.Error("expected the request headers to be left as they are")
+	}
+}
+
+// Logging reads the bodies, which must still reach the server and the
+// client in full.
+func TestLogTransportRoundTrip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(append([]byte("echo "), body...))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewDebugTransport(http.DefaultTransport, 4)}
+	for _, request := range []func() (*http.Response, error){
+		func() (*http.Response, error) {
+			return client.Post(server.URL, "application/json", strings.NewReader(`{"password":"secret"}`))
+		},
+		func() (*http.Response, error) {
+			// A body that can only be read once.
+			return client.Post(server.URL, "application/json", io.MultiReader(strings.NewReader(`{"password":"secret"}`)))
+		},
+	} {
+		resp, err := request()
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := string(body); got != `echo {"password":"secret"}` {
+			t.Errorf("expected the body to pass unchanged, got %q", got)
+		}
+	}
+}
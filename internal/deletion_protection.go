@@ -0,0 +1,94 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionAttribute returns the schema of the deletion_protection
+// attribute. It only lives in the state, so it does not depend on Medusa.
+func deletionProtectionAttribute(entity string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("If set to true, Terraform refuses to destroy the %s, including to replace it. "+
+			"Set it to false and apply before destroying the %s. Defaults to false.", entity, entity),
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+}
+
+// checkDeletionProtection adds an error and returns true when the object in
+// state has deletion_protection set, so Delete leaves it alone.
+func checkDeletionProtection(ctx context.Context, state tfsdk.State, diags *diag.Diagnostics, name string) bool {
+	var protected types.Bool
+	diags.Append(state.GetAttribute(ctx, path.Root("deletion_protection"), &protected)...)
+	if diags.HasError() {
+		return true
+	}
+	if !protected.ValueBool() {
+		return false
+	}
+
+	var id types.String
+	diags.Append(state.GetAttribute(ctx, path.Root("id"), &id)...)
+	diags.AddError(
+		fmt.Sprintf("Error deleting %s", name),
+		fmt.Sprintf("The %s %s has deletion_protection set. Set deletion_protection to false and apply, then destroy it.",
+			name, id.ValueString()),
+	)
+	return true
+}
+
+// warnDeletionProtection adds a warning to a plan that destroys a protected
+// object, or replaces it because one of the replace attributes changes.
+// Replacements requested with -replace or replace_triggered_by are not
+// visible to the provider, and are only stopped by Delete.
+func warnDeletionProtection(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, name string, replace ...path.Path) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var protected types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("deletion_protection"), &protected)...)
+	if resp.Diagnostics.HasError() || !protected.ValueBool() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("The %s is protected from deletion", name),
+			fmt.Sprintf("The %s has deletion_protection set, so destroying it will fail. "+
+				"Set deletion_protection to false and apply first.", name),
+		)
+		return
+	}
+
+	for _, p := range replace {
+		var prior, planned attr.Value
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, p, &prior)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, p, &planned)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if prior.Equal(planned) {
+			continue
+		}
+
+		resp.Diagnostics.AddAttributeWarning(
+			p,
+			fmt.Sprintf("The %s is protected from deletion", name),
+			fmt.Sprintf("Changing %s replaces the %s, which has deletion_protection set, so the apply will fail. "+
+				"Set deletion_protection to false and apply first, or keep the current value.", p, name),
+		)
+		return
+	}
+}
@@ -0,0 +1,255 @@
+package internal
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ikhvost/terraform-provider-medusa/internal/clientfake"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+)
+
+func TestWarnDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	s := testResourceSchema(t, &salesChannelResource{})
+
+	channel := func(protected, adopt bool, name string) *salesChannelResourceModel {
+		return &salesChannelResourceModel{
+			ID:                 types.StringValue("sc_1"),
+			Name:               types.StringValue(name),
+			Description:        types.StringValue("Online store"),
+			IsDisabled:         types.BoolValue(false),
+			AdoptExisting:      types.BoolValue(adopt),
+			DeletionProtection: types.BoolValue(protected),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		state       *salesChannelResourceModel
+		plan        *salesChannelResourceModel
+		wantWarning string
+	}{
+		{
+			name: "created",
+			plan: channel(true, false, "Web"),
+		},
+		{
+			name:        "protected and destroyed",
+			state:       channel(true, false, "Web"),
+			wantWarning: "destroying it will fail",
+		},
+		{
+			name:  "unprotected and destroyed",
+			state: channel(false, false, "Web"),
+		},
+		{
+			name:        "protected and replaced",
+			state:       channel(true, false, "Web"),
+			plan:        channel(true, true, "Web"),
+			wantWarning: "Changing adopt_existing replaces the sales channel",
+		},
+		{
+			name:  "protected and updated",
+			state: channel(true, false, "Web"),
+			plan:  channel(true, false, "Online"),
+		},
+		{
+			name:  "protection lifted and replaced",
+			state: channel(false, false, "Web"),
+			plan:  channel(false, true, "Web"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := fwresource.ModifyPlanRequest{
+				State: testState(t, s, nil),
+				Plan:  tfsdk.Plan{Schema: s, Raw: testState(t, s, nil).Raw},
+			}
+			if tt.state != nil {
+				req.State = testState(t, s, tt.state)
+			}
+			if tt.plan != nil {
+				req.Plan = testPlan(t, s, tt.plan)
+			}
+
+			resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+			warnDeletionProtection(ctx, req, &resp, "sales channel", path.Root("adopt_existing"))
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			var details []string
+			for _, d := range resp.Diagnostics.Warnings() {
+				details = append(details, d.Detail())
+			}
+			warning := strings.Join(details, "\n")
+			if (tt.wantWarning == "") != (warning == "") || !strings.Contains(warning, tt.wantWarning) {
+				t.Errorf("expected warning %q, got %q", tt.wantWarning, warning)
+			}
+		})
+	}
+}
+
+func TestCheckDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	s := testResourceSchema(t, &regionResource{})
+
+	for _, protected := range []bool{false, true} {
+		state := testState(t, s, &regionResourceModel{
+			ID:                 types.StringValue("reg_1"),
+			DeletionProtection: types.BoolValue(protected),
+		})
+
+		var diags diag.Diagnostics
+		if got := checkDeletionProtection(ctx, state, &diags, "region"); got != protected {
+			t.Errorf("protected %t: expected %t, got %t", protected, protected, got)
+		}
+		if diags.HasError() != protected {
+			t.Errorf("protected %t: unexpected diagnostics %v", protected, diags)
+		}
+	}
+}
+
+// Changing only the attributes kept in the Terraform state sends nothing to
+// Medusa, which would otherwise be asked for an empty update.
+func TestUpdateStateOnlyChanges(t *testing.T) {
+	ctx := context.Background()
+	region := &regionResourceModel{
+		ID:                   types.StringValue("reg_1"),
+		Name:                 types.StringValue("Europe"),
+		CurrencyCode:         types.StringValue("eur"),
+		TaxRate:              testNumber(t, "21"),
+		PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		Countries:            utils.ConvertToTerraformStringSlice([]string{"nl"}),
+		TaxCode:              types.StringNull(),
+		IncludesTax:          types.BoolNull(),
+		AutomaticTaxes:       types.BoolValue(true),
+		GiftCardsTaxable:     types.BoolValue(true),
+		TaxProviderID:        types.StringNull(),
+		CreatedAt:            types.StringValue("2024-03-01T12:00:00Z"),
+		UpdatedAt:            types.StringValue("2024-03-01T12:00:00Z"),
+		DeletionProtection:   types.BoolValue(false),
+	}
+	channel := &salesChannelResourceModel{
+		ID:                 types.StringValue("sc_1"),
+		Name:               types.StringValue("Web"),
+		Description:        types.StringValue("Online store"),
+		IsDisabled:         types.BoolValue(false),
+		AdoptExisting:      types.BoolValue(false),
+		DeletionProtection: types.BoolValue(false),
+	}
+	category := &productCategoryResourceModel{
+		ID:                 types.StringValue("pcat_1"),
+		Name:               types.StringValue("Shirts"),
+		Description:        types.StringValue(""),
+		Handle:             types.StringValue("shirts"),
+		IsInternal:         types.BoolValue(false),
+		IsActive:           types.BoolValue(true),
+		ParentCategoryId:   types.StringNull(),
+		DeletionProtection: types.BoolValue(false),
+	}
+	store := &storeResourceModel{
+		ID:                    types.StringValue("store_1"),
+		Name:                  types.StringValue("Medusa Store"),
+		DefaultCurrencyCode:   types.StringValue("eur"),
+		Currencies:            utils.ConvertToTerraformStringSlice([]string{"eur"}),
+		SwapLinkTemplate:      types.StringNull(),
+		PaymentLinkTemplate:   types.StringNull(),
+		InviteLinkTemplate:    types.StringNull(),
+		ResetOnDestroy:        types.BoolValue(false),
+		DeletionProtection:    types.BoolValue(false),
+		DefaultSalesChannelID: types.StringValue("sc_1"),
+		DefaultLocationID:     types.StringNull(),
+		PaymentProviders:      utils.ConvertToTerraformStringSet([]string{"manual"}),
+		FulfillmentProviders:  utils.ConvertToTerraformStringSet([]string{"manual"}),
+	}
+
+	tests := []struct {
+		name     string
+		resource func(utils.Client) fwresource.Resource
+		state    any
+		plan     any
+	}{
+		{
+			name:     "region",
+			resource: func(c utils.Client) fwresource.Resource { return &regionResource{client: c} },
+			state:    region,
+			plan: func() any {
+				plan := *region
+				plan.DeletionProtection = types.BoolValue(true)
+				return &plan
+			}(),
+		},
+		{
+			name:     "sales channel",
+			resource: func(c utils.Client) fwresource.Resource { return &salesChannelResource{client: c} },
+			state:    channel,
+			plan: func() any {
+				plan := *channel
+				plan.DeletionProtection = types.BoolValue(true)
+				return &plan
+			}(),
+		},
+		{
+			name:     "product category",
+			resource: func(c utils.Client) fwresource.Resource { return &productCategoryResource{client: c} },
+			state:    category,
+			plan: func() any {
+				plan := *category
+				plan.DeletionProtection = types.BoolValue(true)
+				return &plan
+			}(),
+		},
+		{
+			name:     "store",
+			resource: func(c utils.Client) fwresource.Resource { return &storeResource{client: c} },
+			state:    store,
+			plan: func() any {
+				plan := *store
+				plan.ResetOnDestroy = types.BoolValue(true)
+				plan.DeletionProtection = types.BoolValue(true)
+				return &plan
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &clientfake.FakeClient{}
+			r := tt.resource(fake)
+			s := testResourceSchema(t, r)
+
+			plan := testPlan(t, s, tt.plan)
+			req := fwresource.UpdateRequest{
+				Config: tfsdk.Config{Schema: s, Raw: plan.Raw},
+				Plan:   plan,
+				State:  testState(t, s, tt.state),
+			}
+			req.Private = testPrivate(req.Private)
+			if diags := recordUpdatedAt(ctx, req.Private, time.Unix(0, 0)); diags.HasError() {
+				t.Fatal(diags)
+			}
+			resp := fwresource.UpdateResponse{State: req.State, Private: req.Private}
+			r.Update(ctx, req, &resp)
+
+			if len(resp.Diagnostics) > 0 {
+				t.Fatalf("expected no diagnostics, got %v", resp.Diagnostics)
+			}
+			if calls := fake.Calls(); len(calls) > 0 {
+				t.Errorf("expected no requests, got %v", calls)
+			}
+			if want := testState(t, s, tt.plan); !resp.State.Raw.Equal(want.Raw) {
+				t.Errorf("expected the planned state %v, got %v", want.Raw, resp.State.Raw)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ikhvost/terraform-provider-medusa/internal/validators"
+)
+
+// handleAttribute returns the schema of the handle attribute shared by the
+// categories and collections. Medusa derives a handle from the name when
+// none is set, and keeps it when the name changes.
+func handleAttribute(entity, from string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("The handle of the %s, used in its URL. It must be unique, and a new handle must consist of "+
+			"lower case letters and digits, in words separated by single hyphens. Defaults to a handle derived from the %s.", entity, from),
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// checkHandle adds an error to the plan when the planned handle is not URL
+// safe, or is already used by another object, which Medusa would only reject
+// on apply. An unchanged handle is not checked, so objects imported with a
+// handle set outside of Terraform keep it. lookup returns the objects with a
+// handle.
+func checkHandle(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, name string, lookup importLookup) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var id, handle types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("handle"), &handle)...)
+	if resp.Diagnostics.HasError() || handle.IsNull() || handle.IsUnknown() {
+		return
+	}
+
+	// An unchanged handle is the handle of the object itself.
+	if !req.State.Raw.IsNull() {
+		var prior types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("handle"), &prior)...)
+		if resp.Diagnostics.HasError() || prior.Equal(handle) {
+			return
+		}
+	}
+
+	if !validators.IsHandle(handle.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("handle"),
+			"Invalid handle",
+			fmt.Sprintf("The handle %q must be %s.", handle.ValueString(), validators.HandleFormat),
+		)
+		return
+	}
+
+	candidates, err := lookup(ctx, handle.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error planning %s", name),
+			fmt.Sprintf("Could not look up the %s with handle %q, unexpected error: %s", name, handle.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	for _, c := range candidates {
+		if c.ID == id.ValueString() {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root("handle"),
+			"Handle already in use",
+			fmt.Sprintf("The handle %q is already used by the %s %s (%s). Handles must be unique, "+
+				"so choose another handle, or import the %s with the import id handle:%s.",
+				handle.ValueString(), name, c.Label, c.ID, name, handle.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,128 @@
+package internal
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+)
+
+func TestProductCategoryResourceModifyPlanHandle(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	ids := map[string]string{}
+	for _, handle := range []string{"shoes", "boots"} {
+		res, err := client.PostProductCategoriesWithResponse(ctx, nil, medusa.PostProductCategoriesJSONRequestBody{
+			Name:   handle,
+			Handle: &handle,
+		})
+		if err != nil || res.JSON200 == nil {
+			t.Fatalf("could not create category %s: %v", handle, err)
+		}
+		ids[handle] = res.JSON200.ProductCategory.Id
+	}
+
+	r := &productCategoryResource{client: utils.NewClient(client, "", nil)}
+	s := testResourceSchema(t, r)
+
+	category := func(id string, handle types.String) *productCategoryResourceModel {
+		model := &productCategoryResourceModel{
+			ID:               types.StringValue(id),
+			Name:             types.StringValue("Footwear"),
+			Description:      types.StringNull(),
+			Handle:           handle,
+			IsInternal:       types.BoolValue(false),
+			IsActive:         types.BoolValue(true),
+			ParentCategoryId: types.StringNull(),
+		}
+		if id == "" {
+			model.ID = types.StringUnknown()
+		}
+		return model
+	}
+
+	tests := []struct {
+		name    string
+		state   *productCategoryResourceModel
+		plan    *productCategoryResourceModel
+		wantErr string
+	}{
+		{
+			name: "new handle",
+			plan: category("", types.StringValue("sandals")),
+		},
+		{
+			name: "generated handle",
+			plan: category("", types.StringUnknown()),
+		},
+		{
+			name:    "handle of another category",
+			plan:    category("", types.StringValue("shoes")),
+			wantErr: "already used by the product category shoes (" + ids["shoes"] + ")",
+		},
+		{
+			name:    "handle that is not url safe",
+			plan:    category("", types.StringValue("Summer_Sale")),
+			wantErr: `The handle "Summer_Sale" must be a handle of lower case letters`,
+		},
+		{
+			name:  "unchanged handle that is not url safe",
+			state: category(ids["shoes"], types.StringValue("Summer_Sale")),
+			plan:  category(ids["shoes"], types.StringValue("Summer_Sale")),
+		},
+		{
+			name:    "changed to a handle that is not url safe",
+			state:   category(ids["shoes"], types.StringValue("Summer_Sale")),
+			plan:    category(ids["shoes"], types.StringValue("Winter_Sale")),
+			wantErr: `The handle "Winter_Sale" must be a handle of lower case letters`,
+		},
+		{
+			name:  "unchanged handle",
+			state: category(ids["shoes"], types.StringValue("shoes")),
+			plan:  category(ids["shoes"], types.StringValue("shoes")),
+		},
+		{
+			name:    "changed to the handle of another category",
+			state:   category(ids["shoes"], types.StringValue("shoes")),
+			plan:    category(ids["shoes"], types.StringValue("boots")),
+			wantErr: "already used by the product category boots (" + ids["boots"] + ")",
+		},
+		{
+			name:  "destroyed",
+			state: category(ids["shoes"], types.StringValue("shoes")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := fwresource.ModifyPlanRequest{
+				State: testState(t, s, nil),
+				Plan:  tfsdk.Plan{Schema: s, Raw: testState(t, s, nil).Raw},
+			}
+			if tt.state != nil {
+				req.State = testState(t, s, tt.state)
+			}
+			if tt.plan != nil {
+				req.Plan = testPlan(t, s, tt.plan)
+			}
+
+			resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+			r.ModifyPlan(ctx, req, &resp)
+
+			var details []string
+			for _, d := range resp.Diagnostics.Errors() {
+				details = append(details, d.Detail())
+			}
+			err := strings.Join(details, "\n")
+			if (tt.wantErr == "") != (err == "") || !strings.Contains(err, tt.wantErr) {
+				t.Errorf("expected error %q, got %q", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// importPageSize is the page size used to search the list endpoints.
+const importPageSize = 100
+
+// importCandidate is an object matching the natural key of an import.
+type importCandidate struct {
+	ID    string
+	Label string
+}
+
+// importLookup returns the objects whose natural key equals value.
+type importLookup func(ctx context.Context, value string) ([]importCandidate, error)
+
+// importState imports a resource by id, or by a natural key when the import
+// id is prefixed with one of the keys in lookups, such as name:Europe. Any
+// other import id is used as the id of the object.
+func importState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, name string, lookups map[string]importLookup) {
+	key, value, found := strings.Cut(req.ID, ":")
+	lookup, ok := lookups[key]
+	if !found || !ok {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	candidates, err := lookup(ctx, value)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing %s", name),
+			fmt.Sprintf("Could not look up %s with %s %q, unexpected error: %s", name, key, value, err.Error()),
+		)
+		return
+	}
+
+	switch len(candidates) {
+	case 0:
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing %s", name),
+			fmt.Sprintf("Could not find a %s with %s %q.", name, key, value),
+		)
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), candidates[0].ID)...)
+	default:
+		var list strings.Builder
+		for _, c := range candidates {
+			fmt.Fprintf(&list, "\n  - %s (%s)", c.ID, c.Label)
+		}
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing %s", name),
+			fmt.Sprintf("Found %d objects with %s %q, import one of them by id instead:%s",
+				len(candidates), key, value, list.String()),
+		)
+	}
+}
+
+// findAll searches a paginated list endpoint. The page function returns the
+// matching objects of the page at offset and the total number of objects.
+func findAll[T any](page func(offset int) ([]T, int, error)) ([]T, error) {
+	var all []T
+	for offset := 0; ; offset += importPageSize {
+		matches, count, err := page(offset)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, matches...)
+		if offset+importPageSize >= count {
+			return all, nil
+		}
+	}
+}
+
+func listError(status int, body []byte) error {
+	return fmt.Errorf("status code: %d (%s)", status, body)
+}
@@ -0,0 +1,253 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+)
+
+// testImportState imports r with the import id and returns the imported id,
+// or the error diagnostics.
+func testImportState(t *testing.T, r fwresource.ResourceWithImportState, id string) (string, string) {
+	t.Helper()
+
+	ctx := context.Background()
+	resp := fwresource.ImportStateResponse{State: testState(t, testResourceSchema(t, r), nil)}
+	resp.Private = testPrivate(resp.Private)
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: id}, &resp)
+	if resp.Diagnostics.HasError() {
+		var details []string
+		for _, d := range resp.Diagnostics.Errors() {
+			details = append(details, d.Detail())
+		}
+		return "", strings.Join(details, "\n")
+	}
+
+	var imported types.String
+	if diags := resp.State.GetAttribute(ctx, path.Root("id"), &imported); diags.HasError() {
+		t.Fatal(diags)
+	}
+	return imported.ValueString(), ""
+}
+
+func TestRegionResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	var ids []string
+	for _, region := range []struct{ name, currency, country string }{
+		{"Europe", "eur", "nl"},
+		{"Europe West", "eur", "fr"},
+		{"Nordics", "sek", "se"},
+		{"Nordics", "nok", "no"},
+	} {
+		res, err := client.PostRegionsWithResponse(ctx, medusa.PostRegionsJSONRequestBody{
+			Name:                 region.name,
+			CurrencyCode:         region.currency,
+			PaymentProviders:     []string{"manual"},
+			FulfillmentProviders: []string{"manual"},
+			Countries:            []string{region.country},
+		})
+		if err != nil || res.JSON200 == nil {
+			t.Fatalf("could not create region %s: %v %s", region.name, err, res.Body)
+		}
+		ids = append(ids, res.JSON200.Region.Id)
+	}
+
+	r := &regionResource{client: utils.NewClient(client, "", nil)}
+
+	tests := []struct {
+		name    string
+		id      string
+		want    string
+		wantErr []string
+	}{
+		{name: "id", id: ids[2], want: ids[2]},
+		{name: "exact name", id: "name:Europe", want: ids[0]},
+		{name: "unknown prefix", id: "title:Europe", want: "title:Europe"},
+		{name: "not found", id: "name:Asia", wantErr: []string{`Could not find a region with name "Asia"`}},
+		{name: "ambiguous", id: "name:Nordics", wantErr: []string{"Found 2 objects", ids[2] + " (sek)", ids[3] + " (nok)"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testImportState(t, r, tt.id)
+			if tt.wantErr != nil {
+				for _, want := range tt.wantErr {
+					if !strings.Contains(err, want) {
+						t.Errorf("expected error containing %q, got %q", want, err)
+					}
+				}
+				return
+			}
+			if err != "" {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("expected id %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+// A built-in profile is imported as adopted, with its current settings to
+// restore on destroy, and any other profile is imported as is.
+func TestShippingProfileResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+	r := &shippingProfileResource{client: utils.NewClient(client, "", nil)}
+
+	custom, err := client.PostShippingProfilesWithResponse(ctx, medusa.AdminPostShippingProfilesReq{Name: "Bulky", Type: "custom"})
+	if err != nil || custom.JSON200 == nil {
+		t.Fatalf("could not create the shipping profile: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		id        string
+		want      string
+		wantAdopt bool
+	}{
+		{name: "default", id: "type:default", want: "sp_default", wantAdopt: true},
+		{name: "custom", id: custom.JSON200.ShippingProfile.Id, want: custom.JSON200.ShippingProfile.Id},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := fwresource.ImportStateResponse{State: testState(t, testResourceSchema(t, r), nil)}
+			resp.Private = testPrivate(resp.Private)
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: tt.id}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			var state shippingProfileResourceModel
+			if diags := resp.State.Get(ctx, &state); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if state.ID.ValueString() != tt.want || state.AdoptExisting.ValueBool() != tt.wantAdopt {
+				t.Errorf("expected %q with adopt_existing %v, got %q with %v", tt.want, tt.wantAdopt, state.ID, state.AdoptExisting)
+			}
+
+			b, diags := resp.Private.GetKey(ctx, shippingProfileSettingsKey)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			original, err := readShippingProfileSettings(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantAdopt && (original == nil || original.Name != builtinShippingProfileNames["default"]) {
+				t.Errorf("expected the original settings of the profile, got %v", original)
+			}
+			if !tt.wantAdopt && original != nil {
+				t.Errorf("expected no original settings, got %v", original)
+			}
+		})
+	}
+}
+
+// A tree at the root is imported with the handles of its root categories,
+// so the other root categories of the store are not taken over.
+func TestProductCategoryTreeResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+	r := &productCategoryTreeResource{client: utils.NewClient(client, "", nil)}
+
+	create := func(handle string, parent *string) string {
+		res, err := client.PostProductCategoriesWithResponse(ctx, nil, medusa.PostProductCategoriesJSONRequestBody{
+			Name:             handle,
+			Handle:           &handle,
+			ParentCategoryId: parent,
+		})
+		if err != nil || res.JSON200 == nil {
+			t.Fatalf("could not create the category %s: %v", handle, err)
+		}
+		return res.JSON200.ProductCategory.Id
+	}
+	shoes := create("shoes", nil)
+	boots := create("boots", &shoes)
+	bags := create("bags", nil)
+	create("gift-cards", nil)
+
+	tests := []struct {
+		name       string
+		id         string
+		wantParent string
+		wantIDs    map[string]string
+		wantErr    string
+	}{
+		{name: "parent", id: shoes, wantParent: shoes},
+		{name: "root categories", id: "root:shoes, bags", wantIDs: map[string]string{"shoes": shoes, "boots": boots, "bags": bags}},
+		{name: "all root categories", id: "root", wantErr: "handles of the root categories"},
+		{name: "no handles", id: "root:", wantErr: "handles of the root categories"},
+		{name: "not a root category", id: "root:boots", wantErr: `no root category has the handle "boots"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := fwresource.ImportStateResponse{State: testState(t, testResourceSchema(t, r), nil)}
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: tt.id}, &resp)
+			if tt.wantErr != "" {
+				var details []string
+				for _, d := range resp.Diagnostics.Errors() {
+					details = append(details, d.Detail())
+				}
+				if got := strings.Join(details, "\n"); !strings.Contains(got, tt.wantErr) {
+					t.Errorf("expected error %q, got %q", tt.wantErr, got)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			var state productCategoryTreeResourceModel
+			if diags := resp.State.Get(ctx, &state); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if state.ParentCategoryID.ValueString() != tt.wantParent {
+				t.Errorf("expected the parent %q, got %s", tt.wantParent, state.ParentCategoryID)
+			}
+
+			var ids map[string]string
+			if !state.IDs.IsNull() {
+				if diags := state.IDs.ElementsAs(ctx, &ids, false); diags.HasError() {
+					t.Fatal(diags)
+				}
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) {
+				t.Errorf("expected the categories %v, got %v", tt.wantIDs, ids)
+			}
+		})
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	var offsets []int
+	matches, err := findAll(func(offset int) ([]importCandidate, int, error) {
+		offsets = append(offsets, offset)
+		return []importCandidate{{ID: "id"}}, 2*importPageSize + 1, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 3 || len(offsets) != 3 || offsets[2] != 2*importPageSize {
+		t.Errorf("expected 3 pages, got offsets %v", offsets)
+	}
+
+	_, err = findAll(func(int) ([]importCandidate, int, error) {
+		return nil, 0, errors.New("connection refused")
+	})
+	if err == nil {
+		t.Error("expected the error of the page")
+	}
+}
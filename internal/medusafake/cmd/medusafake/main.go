@@ -0,0 +1,19 @@
+// Command medusafake serves the in-memory fake admin API over HTTP, so that
+// cassettes can be recorded without a Medusa installation.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+
+	"github.com/ikhvost/terraform-provider-medusa/internal/medusafake"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:9000", "address to listen on")
+	flag.Parse()
+
+	log.Printf("serving the fake Medusa admin API on http://%s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, medusafake.NewServer()))
+}
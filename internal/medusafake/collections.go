@@ -0,0 +1,128 @@
+package medusafake
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+type collectionInput struct {
+	Title    *string         `json:"title"`
+	Handle   *string         `json:"handle"`
+	Metadata *map[string]any `json:"metadata"`
+}
+
+func (s *Server) listCollections(w http.ResponseWriter, r *http.Request) {
+	handle := r.URL.Query().Get("handle")
+	title := r.URL.Query().Get("title")
+
+	var collections []medusa.ProductCollection
+	for _, collection := range s.collections {
+		if handle != "" && *collection.Handle != handle {
+			continue
+		}
+		if title != "" && collection.Title != title {
+			continue
+		}
+		if matchesQuery(r, collection.Title, *collection.Handle) {
+			collections = append(collections, *collection)
+		}
+	}
+
+	collections, count, limit, offset := page(r, collections, func(c medusa.ProductCollection) string { return c.Id })
+	writeJSON(w, http.StatusOK, medusa.AdminCollectionsListRes{
+		Collections: collections,
+		Count:       count,
+		Limit:       limit,
+		Offset:      offset,
+	})
+}
+
+func (s *Server) getCollection(w http.ResponseWriter, r *http.Request) {
+	collection, ok := s.collections[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Product collection", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, medusa.AdminCollectionsRes{Collection: *collection})
+}
+
+func (s *Server) createCollection(w http.ResponseWriter, r *http.Request) {
+	var in collectionInput
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Title == nil {
+		writeError(w, http.StatusBadRequest, ErrInvalidData, "title must be defined")
+		return
+	}
+	if in.Handle == nil || *in.Handle == "" {
+		in.Handle = ptr(slugify(*in.Title))
+	}
+
+	now := s.now()
+	collection := &medusa.ProductCollection{
+		Id:        s.newID("pcol"),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if !s.applyCollection(w, collection, in) {
+		return
+	}
+
+	s.collections[collection.Id] = collection
+	writeJSON(w, http.StatusOK, medusa.AdminCollectionsRes{Collection: *collection})
+}
+
+func (s *Server) updateCollection(w http.ResponseWriter, r *http.Request) {
+	current, ok := s.collections[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Product collection", r.PathValue("id"))
+		return
+	}
+
+	var in collectionInput
+	if !decode(w, r, &in) {
+		return
+	}
+
+	collection := *current
+	if !s.applyCollection(w, &collection, in) {
+		return
+	}
+	collection.UpdatedAt = s.now()
+
+	*current = collection
+	writeJSON(w, http.StatusOK, medusa.AdminCollectionsRes{Collection: collection})
+}
+
+func (s *Server) deleteCollection(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.collections[id]; !ok {
+		notFound(w, "Product collection", id)
+		return
+	}
+
+	delete(s.collections, id)
+	writeDeleted(w, id, "product-collection")
+}
+
+func (s *Server) applyCollection(w http.ResponseWriter, collection *medusa.ProductCollection, in collectionInput) bool {
+	if in.Handle != nil {
+		for _, other := range s.collections {
+			if other.Id != collection.Id && *other.Handle == *in.Handle {
+				writeError(w, http.StatusUnprocessableEntity, ErrDuplicate,
+					fmt.Sprintf("Product_collection with handle %s already exists.", *in.Handle))
+				return false
+			}
+		}
+		collection.Handle = ptr(*in.Handle)
+	}
+	if in.Title != nil {
+		collection.Title = *in.Title
+	}
+	collection.Metadata = mergeMetadata(collection.Metadata, in.Metadata)
+
+	return true
+}
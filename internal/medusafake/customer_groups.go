@@ -0,0 +1,111 @@
+package medusafake
+
+import (
+	"net/http"
+
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+type customerGroupInput struct {
+	Name     *string         `json:"name"`
+	Metadata *map[string]any `json:"metadata"`
+}
+
+func (s *Server) listCustomerGroups(w http.ResponseWriter, r *http.Request) {
+	var groups []medusa.CustomerGroup
+	for _, group := range s.customerGroups {
+		if matchesQuery(r, group.Name) {
+			groups = append(groups, *group)
+		}
+	}
+
+	groups, count, limit, offset := page(r, groups, func(g medusa.CustomerGroup) string { return g.Id })
+	writeJSON(w, http.StatusOK, medusa.AdminCustomerGroupsListRes{
+		CustomerGroups: groups,
+		Count:          count,
+		Limit:          limit,
+		Offset:         offset,
+	})
+}
+
+func (s *Server) getCustomerGroup(w http.ResponseWriter, r *http.Request) {
+	group, ok := s.customerGroups[r.PathValue("id")]
+	if !ok {
+		notFound(w, "CustomerGroup", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, medusa.AdminCustomerGroupsRes{CustomerGroup: *group})
+}
+
+func (s *Server) createCustomerGroup(w http.ResponseWriter, r *http.Request) {
+	var in customerGroupInput
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Name == nil {
+		writeError(w, http.StatusBadRequest, ErrInvalidData, "name must be defined")
+		return
+	}
+
+	now := s.now()
+	group := &medusa.CustomerGroup{
+		Id:        s.newID("cgrp"),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if !s.applyCustomerGroup(w, group, in) {
+		return
+	}
+
+	s.customerGroups[group.Id] = group
+	writeJSON(w, http.StatusOK, medusa.AdminCustomerGroupsRes{CustomerGroup: *group})
+}
+
+func (s *Server) updateCustomerGroup(w http.ResponseWriter, r *http.Request) {
+	current, ok := s.customerGroups[r.PathValue("id")]
+	if !ok {
+		notFound(w, "CustomerGroup", r.PathValue("id"))
+		return
+	}
+
+	var in customerGroupInput
+	if !decode(w, r, &in) {
+		return
+	}
+
+	group := *current
+	if !s.applyCustomerGroup(w, &group, in) {
+		return
+	}
+	group.UpdatedAt = s.now()
+
+	*current = group
+	writeJSON(w, http.StatusOK, medusa.AdminCustomerGroupsRes{CustomerGroup: group})
+}
+
+func (s *Server) deleteCustomerGroup(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.customerGroups[id]; !ok {
+		notFound(w, "CustomerGroup", id)
+		return
+	}
+
+	delete(s.customerGroups, id)
+	writeDeleted(w, id, "customer_group")
+}
+
+func (s *Server) applyCustomerGroup(w http.ResponseWriter, group *medusa.CustomerGroup, in customerGroupInput) bool {
+	if in.Name != nil {
+		for _, other := range s.customerGroups {
+			if other.Id != group.Id && other.Name == *in.Name {
+				writeError(w, http.StatusUnprocessableEntity, ErrDuplicate,
+					"A customer group with the given name already exists")
+				return false
+			}
+		}
+		group.Name = *in.Name
+	}
+	group.Metadata = mergeMetadata(group.Metadata, in.Metadata)
+
+	return true
+}
@@ -0,0 +1,84 @@
+package medusafake
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fault changes how the server answers matching requests.
+type Fault struct {
+	// Method restricts the fault to one HTTP method. Empty matches any.
+	Method string
+	// Path restricts the fault to paths starting with it. Empty matches any.
+	Path string
+
+	// Status is returned with an error envelope instead of handling the
+	// request. Zero handles the request normally after the latency.
+	Status int
+	// RetryAfter is sent as the Retry-After header, in whole seconds.
+	RetryAfter time.Duration
+	// Latency delays the answer.
+	Latency time.Duration
+
+	// Times is the number of requests the fault applies to. Zero applies
+	// it to every matching request.
+	Times int
+}
+
+// InjectFault adds a fault. Faults are checked in the order they were added
+// and the first matching one is applied.
+func (s *Server) InjectFault(f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, &f)
+}
+
+// ClearFaults removes all injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = nil
+}
+
+func (s *Server) takeFault(r *http.Request) *Fault {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, f := range s.faults {
+		if f.Method != "" && f.Method != r.Method {
+			continue
+		}
+		if !strings.HasPrefix(r.URL.Path, f.Path) {
+			continue
+		}
+
+		if f.Times > 0 {
+			f.Times--
+			if f.Times == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return f
+	}
+
+	return nil
+}
+
+func (f *Fault) write(w http.ResponseWriter) {
+	if f.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(f.RetryAfter.Seconds())))
+	}
+
+	switch f.Status {
+	case http.StatusTooManyRequests:
+		writeError(w, f.Status, ErrTooManyRequest, "Too many requests")
+	case http.StatusUnauthorized:
+		writeError(w, f.Status, ErrUnauthorized, "Unauthorized")
+	default:
+		writeError(w, f.Status, ErrUnknown, "An unknown error occurred.")
+	}
+}
@@ -0,0 +1,92 @@
+package medusafake
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const defaultLimit = 50
+
+// page sorts the items by id and returns the window selected by the limit
+// and offset query parameters, together with the total count.
+func page[T any](r *http.Request, items []T, id func(T) string) ([]T, int, int, int) {
+	if items == nil {
+		items = []T{}
+	}
+	sort.Slice(items, func(i, j int) bool { return id(items[i]) < id(items[j]) })
+
+	limit := queryInt(r, "limit", defaultLimit)
+	offset := queryInt(r, "offset", 0)
+
+	count := len(items)
+	if offset > count {
+		offset = count
+	}
+	end := offset + limit
+	if end > count {
+		end = count
+	}
+
+	return items[offset:end], count, limit, offset
+}
+
+func queryInt(r *http.Request, key string, fallback int) int {
+	v, err := strconv.Atoi(r.URL.Query().Get(key))
+	if err != nil || v < 0 {
+		return fallback
+	}
+	return v
+}
+
+// matchesQuery implements the free text q parameter of the list endpoints.
+func matchesQuery(r *http.Request, values ...string) bool {
+	q := strings.ToLower(r.URL.Query().Get("q"))
+	if q == "" {
+		return true
+	}
+	for _, v := range values {
+		if strings.Contains(strings.ToLower(v), q) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeMetadata applies an update the way Medusa does: keys are added or
+// replaced, and keys set to an empty string are removed.
+func mergeMetadata(current *map[string]any, update *map[string]any) *map[string]any {
+	if update == nil {
+		return current
+	}
+
+	merged := map[string]any{}
+	if current != nil {
+		for k, v := range *current {
+			merged[k] = v
+		}
+	}
+	for k, v := range *update {
+		if v == "" {
+			delete(merged, k)
+			continue
+		}
+		merged[k] = v
+	}
+
+	return &merged
+}
+
+var nonSlug = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slugify derives a handle from a name, as Medusa does for categories and
+// collections created without one.
+func slugify(name string) string {
+	return strings.Trim(nonSlug.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
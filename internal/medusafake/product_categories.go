@@ -0,0 +1,305 @@
+package medusafake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+type categoryInput struct {
+	Name             *string         `json:"name"`
+	Description      *string         `json:"description"`
+	Handle           *string         `json:"handle"`
+	IsInternal       *bool           `json:"is_internal"`
+	IsActive         *bool           `json:"is_active"`
+	ParentCategoryId parentInput     `json:"parent_category_id"`
+	Rank             *int            `json:"rank"`
+	Metadata         *map[string]any `json:"metadata"`
+}
+
+// parentInput is the parent_category_id of a request. A null parent moves
+// the category to the root.
+type parentInput struct {
+	set bool
+	id  string
+}
+
+func (p *parentInput) UnmarshalJSON(b []byte) error {
+	p.set = true
+	if string(b) == "null" {
+		return nil
+	}
+	return json.Unmarshal(b, &p.id)
+}
+
+func (s *Server) listCategories(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	handle := query.Get("handle")
+	parent, filterParent := query.Get("parent_category_id"), query.Has("parent_category_id")
+	tree, _ := strconv.ParseBool(query.Get("include_descendants_tree"))
+
+	var categories []medusa.ProductCategory
+	for _, category := range s.categories {
+		if handle != "" && category.Handle != handle {
+			continue
+		}
+		if filterParent && parentOf(category) != parent {
+			continue
+		}
+		if matchesQuery(r, category.Name, category.Handle) {
+			categories = append(categories, s.categoryView(category, tree))
+		}
+	}
+
+	categories, count, limit, offset := page(r, categories, func(c medusa.ProductCategory) string { return c.Id })
+	writeJSON(w, http.StatusOK, medusa.AdminProductCategoriesListRes{
+		ProductCategories: categories,
+		Count:             count,
+		Limit:             limit,
+		Offset:            offset,
+	})
+}
+
+func (s *Server) getCategory(w http.ResponseWriter, r *http.Request) {
+	category, ok := s.categories[r.PathValue("id")]
+	if !ok {
+		notFound(w, "ProductCategory", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, medusa.AdminProductCategoriesCategoryRes{ProductCategory: s.categoryView(category, false)})
+}
+
+func (s *Server) createCategory(w http.ResponseWriter, r *http.Request) {
+	var in categoryInput
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Name == nil {
+		writeError(w, http.StatusBadRequest, ErrInvalidData, "name must be defined")
+		return
+	}
+	if in.Handle == nil || *in.Handle == "" {
+		in.Handle = ptr(slugify(*in.Name))
+	}
+
+	now := s.now()
+	category := &medusa.ProductCategory{
+		Id:        s.newID("pcat"),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	in.ParentCategoryId.set = true
+	if !s.applyCategory(w, category, in) {
+		return
+	}
+
+	s.categories[category.Id] = category
+	writeJSON(w, http.StatusOK, medusa.AdminProductCategoriesCategoryRes{ProductCategory: s.categoryView(category, false)})
+}
+
+func (s *Server) updateCategory(w http.ResponseWriter, r *http.Request) {
+	current, ok := s.categories[r.PathValue("id")]
+	if !ok {
+		notFound(w, "ProductCategory", r.PathValue("id"))
+		return
+	}
+
+	var in categoryInput
+	if !decode(w, r, &in) {
+		return
+	}
+
+	if !s.applyCategory(w, current, in) {
+		return
+	}
+	current.UpdatedAt = s.now()
+
+	writeJSON(w, http.StatusOK, medusa.AdminProductCategoriesCategoryRes{ProductCategory: s.categoryView(current, false)})
+}
+
+func (s *Server) deleteCategory(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	category, ok := s.categories[id]
+	if !ok {
+		notFound(w, "ProductCategory", id)
+		return
+	}
+	if len(s.children(id)) > 0 {
+		writeError(w, http.StatusBadRequest, ErrNotAllowed,
+			fmt.Sprintf("Deleting ProductCategory (%s) with category children is not allowed", id))
+		return
+	}
+
+	delete(s.categories, id)
+	s.rerank(parentOf(category))
+	writeDeleted(w, id, "product-category")
+}
+
+// applyCategory validates the input and copies it to the category. A parent
+// change moves the category to the end of its new siblings unless a rank is
+// given; a rank moves it within its siblings.
+func (s *Server) applyCategory(w http.ResponseWriter, category *medusa.ProductCategory, in categoryInput) bool {
+	if in.Handle != nil {
+		for _, other := range s.categories {
+			if other.Id != category.Id && other.Handle == *in.Handle {
+				writeError(w, http.StatusUnprocessableEntity, ErrDuplicate,
+					fmt.Sprintf("Product_category with handle %s already exists.", *in.Handle))
+				return false
+			}
+		}
+	}
+
+	oldParent := parentOf(category)
+	newParent := oldParent
+	if in.ParentCategoryId.set {
+		newParent = in.ParentCategoryId.id
+		if newParent != "" {
+			if _, ok := s.categories[newParent]; !ok {
+				notFound(w, "ProductCategory", newParent)
+				return false
+			}
+			for id := newParent; id != ""; id = parentOf(s.categories[id]) {
+				if id == category.Id {
+					writeError(w, http.StatusBadRequest, ErrInvalidData, "A category cannot be its own ancestor")
+					return false
+				}
+			}
+		}
+	}
+
+	if in.Name != nil {
+		category.Name = *in.Name
+	}
+	if in.Description != nil {
+		category.Description = in.Description
+	}
+	if in.Handle != nil {
+		category.Handle = *in.Handle
+	}
+	if in.IsInternal != nil {
+		category.IsInternal = *in.IsInternal
+	}
+	if in.IsActive != nil {
+		category.IsActive = *in.IsActive
+	}
+	category.Metadata = mergeMetadata(category.Metadata, in.Metadata)
+
+	moved := category.Rank == nil || newParent != oldParent
+	if newParent == "" {
+		category.ParentCategoryId = nil
+	} else {
+		category.ParentCategoryId = ptr(newParent)
+	}
+
+	if moved || in.Rank != nil {
+		siblings := s.children(newParent)
+		siblings = deleteCategory(siblings, category.Id)
+
+		rank := len(siblings)
+		if in.Rank != nil && *in.Rank < rank {
+			rank = max(*in.Rank, 0)
+		}
+		siblings = append(siblings[:rank], append([]*medusa.ProductCategory{category}, siblings[rank:]...)...)
+		for i, sibling := range siblings {
+			sibling.Rank = ptr(i)
+		}
+	}
+	if moved && newParent != oldParent {
+		s.rerank(oldParent)
+	}
+
+	return true
+}
+
+// categoryView returns the category as the API presents it, with its parent
+// and children. With tree set the children are expanded recursively.
+func (s *Server) categoryView(category *medusa.ProductCategory, tree bool) medusa.ProductCategory {
+	view := *category
+	view.Mpath = ptr(s.mpath(category))
+	view.CategoryChildren = []map[string]any{}
+	for _, child := range s.children(category.Id) {
+		if tree {
+			view.CategoryChildren = append(view.CategoryChildren, toMap(s.categoryView(child, true)))
+			continue
+		}
+		c := *child
+		c.CategoryChildren = []map[string]any{}
+		view.CategoryChildren = append(view.CategoryChildren, toMap(c))
+	}
+	if parent, ok := s.categories[parentOf(category)]; ok {
+		p := *parent
+		p.CategoryChildren = []map[string]any{}
+		m := toMap(p)
+		view.ParentCategory = &m
+	}
+	return view
+}
+
+// children returns the categories below parent, ordered by rank. An empty
+// parent returns the root categories.
+func (s *Server) children(parent string) []*medusa.ProductCategory {
+	var children []*medusa.ProductCategory
+	for _, category := range s.categories {
+		if parentOf(category) == parent {
+			children = append(children, category)
+		}
+	}
+	sort.Slice(children, func(i, j int) bool {
+		ri, rj := rankOf(children[i]), rankOf(children[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return children[i].Id < children[j].Id
+	})
+	return children
+}
+
+// rerank closes the gaps in the ranks below parent.
+func (s *Server) rerank(parent string) {
+	for i, child := range s.children(parent) {
+		child.Rank = ptr(i)
+	}
+}
+
+func (s *Server) mpath(category *medusa.ProductCategory) string {
+	path := category.Id + "."
+	for id := parentOf(category); id != ""; id = parentOf(s.categories[id]) {
+		path = id + "." + path
+	}
+	return path
+}
+
+func parentOf(category *medusa.ProductCategory) string {
+	if category == nil || category.ParentCategoryId == nil {
+		return ""
+	}
+	return *category.ParentCategoryId
+}
+
+func rankOf(category *medusa.ProductCategory) int {
+	if category.Rank == nil {
+		return int(^uint(0) >> 1)
+	}
+	return *category.Rank
+}
+
+func deleteCategory(categories []*medusa.ProductCategory, id string) []*medusa.ProductCategory {
+	var result []*medusa.ProductCategory
+	for _, category := range categories {
+		if category.Id != id {
+			result = append(result, category)
+		}
+	}
+	return result
+}
+
+func toMap(v any) map[string]any {
+	b, _ := json.Marshal(v)
+	var m map[string]any
+	_ = json.Unmarshal(b, &m)
+	return m
+}
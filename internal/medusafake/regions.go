@@ -0,0 +1,216 @@
+package medusafake
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+type regionInput struct {
+	Name                 *string         `json:"name"`
+	CurrencyCode         *string         `json:"currency_code"`
+	TaxRate              *float32        `json:"tax_rate"`
+	TaxCode              *string         `json:"tax_code"`
+	IncludesTax          *bool           `json:"includes_tax"`
+	AutomaticTaxes       *bool           `json:"automatic_taxes"`
+	GiftCardsTaxable     *bool           `json:"gift_cards_taxable"`
+	TaxProviderId        *string         `json:"tax_provider_id"`
+	PaymentProviders     *[]string       `json:"payment_providers"`
+	FulfillmentProviders *[]string       `json:"fulfillment_providers"`
+	Countries            *[]string       `json:"countries"`
+	Metadata             *map[string]any `json:"metadata"`
+}
+
+func (s *Server) listRegions(w http.ResponseWriter, r *http.Request) {
+	var regions []medusa.Region
+	for _, region := range s.regions {
+		if matchesQuery(r, region.Name) {
+			regions = append(regions, *region)
+		}
+	}
+
+	regions, count, limit, offset := page(r, regions, func(region medusa.Region) string { return region.Id })
+	writeJSON(w, http.StatusOK, medusa.AdminRegionsListRes{
+		Regions: regions,
+		Count:   count,
+		Limit:   limit,
+		Offset:  offset,
+	})
+}
+
+func (s *Server) getRegion(w http.ResponseWriter, r *http.Request) {
+	region, ok := s.regions[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Region", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, medusa.AdminRegionsRes{Region: *region})
+}
+
+func (s *Server) createRegion(w http.ResponseWriter, r *http.Request) {
+	var in regionInput
+	if !decode(w, r, &in) {
+		return
+	}
+
+	for field, missing := range map[string]bool{
+		"name":                  in.Name == nil,
+		"currency_code":         in.CurrencyCode == nil,
+		"tax_rate":              in.TaxRate == nil,
+		"payment_providers":     in.PaymentProviders == nil,
+		"fulfillment_providers": in.FulfillmentProviders == nil,
+		"countries":             in.Countries == nil,
+	} {
+		if missing {
+			writeError(w, http.StatusBadRequest, ErrInvalidData, field+" must be defined")
+			return
+		}
+	}
+
+	// includes_tax stays unset, as it is without the tax inclusive pricing
+	// feature flag.
+	now := s.now()
+	region := &medusa.Region{
+		Id:               s.newID("reg"),
+		AutomaticTaxes:   true,
+		GiftCardsTaxable: true,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	if !s.applyRegion(w, region, in) {
+		return
+	}
+
+	s.regions[region.Id] = region
+	writeJSON(w, http.StatusOK, medusa.AdminRegionsRes{Region: *region})
+}
+
+func (s *Server) updateRegion(w http.ResponseWriter, r *http.Request) {
+	current, ok := s.regions[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Region", r.PathValue("id"))
+		return
+	}
+
+	var in regionInput
+	if !decode(w, r, &in) {
+		return
+	}
+
+	region := *current
+	if !s.applyRegion(w, &region, in) {
+		return
+	}
+	region.UpdatedAt = s.now()
+
+	*current = region
+	writeJSON(w, http.StatusOK, medusa.AdminRegionsRes{Region: region})
+}
+
+func (s *Server) deleteRegion(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.regions[id]; !ok {
+		notFound(w, "Region", id)
+		return
+	}
+
+	delete(s.regions, id)
+	writeDeleted(w, id, "region")
+}
+
+// applyRegion validates the input and copies it to the region. It writes an
+// error and returns false when the input is invalid.
+func (s *Server) applyRegion(w http.ResponseWriter, region *medusa.Region, in regionInput) bool {
+	if in.CurrencyCode != nil {
+		if !isCurrencyCode(*in.CurrencyCode) {
+			writeError(w, http.StatusBadRequest, ErrInvalidData, fmt.Sprintf("Invalid currency %s", *in.CurrencyCode))
+			return false
+		}
+		region.CurrencyCode = *in.CurrencyCode
+	}
+
+	if in.Countries != nil {
+		var countries []medusa.Country
+		for _, code := range *in.Countries {
+			code = strings.ToLower(code)
+			if len(code) != 2 {
+				writeError(w, http.StatusBadRequest, ErrInvalidData, fmt.Sprintf("Invalid country %s", code))
+				return false
+			}
+			if other := s.regionWithCountry(code); other != nil && other.Id != region.Id {
+				writeError(w, http.StatusUnprocessableEntity, ErrDuplicate,
+					fmt.Sprintf("%s already exists in region %s", strings.ToUpper(code), other.Id))
+				return false
+			}
+			countries = append(countries, medusa.Country{
+				Iso2:        code,
+				Name:        strings.ToUpper(code),
+				DisplayName: strings.ToUpper(code),
+				RegionId:    &region.Id,
+			})
+		}
+		// Medusa returns the countries in database order, not in the
+		// order they were sent.
+		sort.Slice(countries, func(i, j int) bool { return countries[i].Iso2 < countries[j].Iso2 })
+		region.Countries = &countries
+	}
+
+	if in.PaymentProviders != nil {
+		var providers []medusa.PaymentProvider
+		for _, id := range *in.PaymentProviders {
+			providers = append(providers, medusa.PaymentProvider{Id: id, IsInstalled: true})
+		}
+		region.PaymentProviders = &providers
+	}
+
+	if in.FulfillmentProviders != nil {
+		var providers []medusa.FulfillmentProvider
+		for _, id := range *in.FulfillmentProviders {
+			providers = append(providers, medusa.FulfillmentProvider{Id: id, IsInstalled: true})
+		}
+		region.FulfillmentProviders = &providers
+	}
+
+	if in.Name != nil {
+		region.Name = *in.Name
+	}
+	if in.TaxRate != nil {
+		region.TaxRate = *in.TaxRate
+	}
+	if in.TaxCode != nil {
+		region.TaxCode = in.TaxCode
+	}
+	if in.IncludesTax != nil {
+		region.IncludesTax = in.IncludesTax
+	}
+	if in.AutomaticTaxes != nil {
+		region.AutomaticTaxes = *in.AutomaticTaxes
+	}
+	if in.GiftCardsTaxable != nil {
+		region.GiftCardsTaxable = *in.GiftCardsTaxable
+	}
+	if in.TaxProviderId != nil {
+		region.TaxProviderId = in.TaxProviderId
+	}
+	region.Metadata = mergeMetadata(region.Metadata, in.Metadata)
+
+	return true
+}
+
+func (s *Server) regionWithCountry(code string) *medusa.Region {
+	for _, region := range s.regions {
+		if region.Countries == nil {
+			continue
+		}
+		for _, country := range *region.Countries {
+			if country.Iso2 == code {
+				return region
+			}
+		}
+	}
+	return nil
+}
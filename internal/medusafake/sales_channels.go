@@ -0,0 +1,117 @@
+package medusafake
+
+import (
+	"net/http"
+
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+type salesChannelInput struct {
+	Name        *string         `json:"name"`
+	Description *string         `json:"description"`
+	IsDisabled  *bool           `json:"is_disabled"`
+	Metadata    *map[string]any `json:"metadata"`
+}
+
+func (s *Server) listSalesChannels(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+
+	var channels []medusa.SalesChannel
+	for _, channel := range s.salesChannels {
+		if name != "" && channel.Name != name {
+			continue
+		}
+		description := ""
+		if channel.Description != nil {
+			description = *channel.Description
+		}
+		if matchesQuery(r, channel.Name, description) {
+			channels = append(channels, *channel)
+		}
+	}
+
+	channels, count, limit, offset := page(r, channels, func(c medusa.SalesChannel) string { return c.Id })
+	writeJSON(w, http.StatusOK, medusa.AdminSalesChannelsListRes{
+		SalesChannels: channels,
+		Count:         count,
+		Limit:         limit,
+		Offset:        offset,
+	})
+}
+
+func (s *Server) getSalesChannel(w http.ResponseWriter, r *http.Request) {
+	channel, ok := s.salesChannels[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Sales channel", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, medusa.AdminSalesChannelsRes{SalesChannel: *channel})
+}
+
+func (s *Server) createSalesChannel(w http.ResponseWriter, r *http.Request) {
+	var in salesChannelInput
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Name == nil {
+		writeError(w, http.StatusBadRequest, ErrInvalidData, "name must be defined")
+		return
+	}
+
+	now := s.now()
+	channel := &medusa.SalesChannel{
+		Id:        s.newID("sc"),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	applySalesChannel(channel, in)
+
+	s.salesChannels[channel.Id] = channel
+	writeJSON(w, http.StatusOK, medusa.AdminSalesChannelsRes{SalesChannel: *channel})
+}
+
+func (s *Server) updateSalesChannel(w http.ResponseWriter, r *http.Request) {
+	channel, ok := s.salesChannels[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Sales channel", r.PathValue("id"))
+		return
+	}
+
+	var in salesChannelInput
+	if !decode(w, r, &in) {
+		return
+	}
+
+	applySalesChannel(channel, in)
+	channel.UpdatedAt = s.now()
+
+	writeJSON(w, http.StatusOK, medusa.AdminSalesChannelsRes{SalesChannel: *channel})
+}
+
+func (s *Server) deleteSalesChannel(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if _, ok := s.salesChannels[id]; !ok {
+		notFound(w, "Sales channel", id)
+		return
+	}
+	if s.store.DefaultSalesChannelId != nil && *s.store.DefaultSalesChannelId == id {
+		writeError(w, http.StatusBadRequest, ErrNotAllowed, "You cannot delete the default sales channel")
+		return
+	}
+
+	delete(s.salesChannels, id)
+	writeDeleted(w, id, "sales-channel")
+}
+
+func applySalesChannel(channel *medusa.SalesChannel, in salesChannelInput) {
+	if in.Name != nil {
+		channel.Name = *in.Name
+	}
+	if in.Description != nil {
+		channel.Description = in.Description
+	}
+	if in.IsDisabled != nil {
+		channel.IsDisabled = *in.IsDisabled
+	}
+	channel.Metadata = mergeMetadata(channel.Metadata, in.Metadata)
+}
@@ -0,0 +1,250 @@
+// Package medusafake provides an in-memory stand-in for the parts of the
+// Medusa admin API used by the provider. It is meant to be served with
+// httptest.NewServer in tests.
+package medusafake
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+// Credentials accepted by a new Server.
+const (
+	Email    = "admin@medusa-test.com"
+	Password = "supersecret"
+)
+
+// Error types used in the error envelopes, as defined by MedusaError.
+const (
+	ErrNotFound       = "not_found"
+	ErrInvalidData    = "invalid_data"
+	ErrDuplicate      = "duplicate_error"
+	ErrNotAllowed     = "not_allowed"
+	ErrUnauthorized   = "unauthorized"
+	ErrUnknown        = "unknown_error"
+	ErrTooManyRequest = "too_many_requests"
+)
+
+// Server serves the fake admin API. All state is kept in memory and guarded
+// by a single mutex, so the handlers can be kept simple.
+type Server struct {
+	mu  sync.Mutex
+	mux *http.ServeMux
+
+	email    string
+	password string
+	tokens   map[string]bool
+
+	faults []*Fault
+	ids    map[string]int
+	now    func() time.Time
+
+	store            medusa.Store
+	regions          map[string]*medusa.Region
+	salesChannels    map[string]*medusa.SalesChannel
+	shippingProfiles map[string]*medusa.ShippingProfile
+	customerGroups   map[string]*medusa.CustomerGroup
+	categories       map[string]*medusa.ProductCategory
+	collections      map[string]*medusa.ProductCollection
+}
+
+// NewServer returns a server seeded like a fresh Medusa installation: a
+// store using usd, the default sales channel and the default and gift card
+// shipping profiles.
+func NewServer() *Server {
+	s := &Server{
+		mux:              http.NewServeMux(),
+		email:            Email,
+		password:         Password,
+		tokens:           map[string]bool{},
+		ids:              map[string]int{},
+		now:              func() time.Time { return time.Now().UTC().Truncate(time.Millisecond) },
+		regions:          map[string]*medusa.Region{},
+		salesChannels:    map[string]*medusa.SalesChannel{},
+		shippingProfiles: map[string]*medusa.ShippingProfile{},
+		customerGroups:   map[string]*medusa.CustomerGroup{},
+		categories:       map[string]*medusa.ProductCategory{},
+		collections:      map[string]*medusa.ProductCollection{},
+	}
+
+	s.seed()
+	s.routes()
+
+	return s
+}
+
+func (s *Server) routes() {
+	s.mux.HandleFunc("POST /admin/auth/token", s.postToken)
+
+	s.mux.HandleFunc("GET /admin/store", s.authorized(s.getStore))
+	s.mux.HandleFunc("POST /admin/store", s.authorized(s.postStore))
+
+	s.mux.HandleFunc("GET /admin/regions", s.authorized(s.listRegions))
+	s.mux.HandleFunc("POST /admin/regions", s.authorized(s.createRegion))
+	s.mux.HandleFunc("GET /admin/regions/{id}", s.authorized(s.getRegion))
+	s.mux.HandleFunc("POST /admin/regions/{id}", s.authorized(s.updateRegion))
+	s.mux.HandleFunc("DELETE /admin/regions/{id}", s.authorized(s.deleteRegion))
+
+	s.mux.HandleFunc("GET /admin/sales-channels", s.authorized(s.listSalesChannels))
+	s.mux.HandleFunc("POST /admin/sales-channels", s.authorized(s.createSalesChannel))
+	s.mux.HandleFunc("GET /admin/sales-channels/{id}", s.authorized(s.getSalesChannel))
+	s.mux.HandleFunc("POST /admin/sales-channels/{id}", s.authorized(s.updateSalesChannel))
+	s.mux.HandleFunc("DELETE /admin/sales-channels/{id}", s.authorized(s.deleteSalesChannel))
+
+	s.mux.HandleFunc("GET /admin/shipping-profiles", s.authorized(s.listShippingProfiles))
+	s.mux.HandleFunc("POST /admin/shipping-profiles", s.authorized(s.createShippingProfile))
+	s.mux.HandleFunc("GET /admin/shipping-profiles/{id}", s.authorized(s.getShippingProfile))
+	s.mux.HandleFunc("POST /admin/shipping-profiles/{id}", s.authorized(s.updateShippingProfile))
+	s.mux.HandleFunc("DELETE /admin/shipping-profiles/{id}", s.authorized(s.deleteShippingProfile))
+
+	s.mux.HandleFunc("GET /admin/customer-groups", s.authorized(s.listCustomerGroups))
+	s.mux.HandleFunc("POST /admin/customer-groups", s.authorized(s.createCustomerGroup))
+	s.mux.HandleFunc("GET /admin/customer-groups/{id}", s.authorized(s.getCustomerGroup))
+	s.mux.HandleFunc("POST /admin/customer-groups/{id}", s.authorized(s.updateCustomerGroup))
+	s.mux.HandleFunc("DELETE /admin/customer-groups/{id}", s.authorized(s.deleteCustomerGroup))
+
+	s.mux.HandleFunc("GET /admin/product-categories", s.authorized(s.listCategories))
+	s.mux.HandleFunc("POST /admin/product-categories", s.authorized(s.createCategory))
+	s.mux.HandleFunc("GET /admin/product-categories/{id}", s.authorized(s.getCategory))
+	s.mux.HandleFunc("POST /admin/product-categories/{id}", s.authorized(s.updateCategory))
+	s.mux.HandleFunc("DELETE /admin/product-categories/{id}", s.authorized(s.deleteCategory))
+
+	s.mux.HandleFunc("GET /admin/shipping-options", s.authorized(s.listShippingOptions))
+
+	s.mux.HandleFunc("GET /admin/collections", s.authorized(s.listCollections))
+	s.mux.HandleFunc("POST /admin/collections", s.authorized(s.createCollection))
+	s.mux.HandleFunc("GET /admin/collections/{id}", s.authorized(s.getCollection))
+	s.mux.HandleFunc("POST /admin/collections/{id}", s.authorized(s.updateCollection))
+	s.mux.HandleFunc("DELETE /admin/collections/{id}", s.authorized(s.deleteCollection))
+}
+
+// ServeHTTP applies the injected faults before handing the request to the
+// matching handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if fault := s.takeFault(r); fault != nil {
+		if fault.Latency > 0 {
+			select {
+			case <-time.After(fault.Latency):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if fault.Status != 0 {
+			fault.write(w)
+			return
+		}
+	}
+
+	s.mux.ServeHTTP(w, r)
+}
+
+// SetCredentials changes the email and password accepted by the token
+// endpoint.
+func (s *Server) SetCredentials(email, password string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.email = email
+	s.password = password
+}
+
+// ExpireTokens invalidates every access token issued so far. Requests that
+// use one of them are answered with 401 until the client logs in again.
+func (s *Server) ExpireTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for token := range s.tokens {
+		s.tokens[token] = false
+	}
+}
+
+func (s *Server) postToken(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Email    string `json:"email"`
+		Password string `json:"password"`
+	}
+	if !decode(w, r, &body) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if body.Email != s.email || body.Password != s.password {
+		writeError(w, http.StatusUnauthorized, ErrUnauthorized, "Unauthorized")
+		return
+	}
+
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	token := hex.EncodeToString(b)
+	s.tokens[token] = true
+
+	writeJSON(w, http.StatusOK, medusa.AdminBearerAuthRes{AccessToken: &token})
+}
+
+// authorized rejects requests without a valid bearer token and holds the
+// lock while the handler runs.
+func (s *Server) authorized(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || !s.tokens[token] {
+			writeError(w, http.StatusUnauthorized, ErrUnauthorized, "Unauthorized")
+			return
+		}
+
+		handler(w, r)
+	}
+}
+
+// newID returns a new id with the given prefix, such as reg_000001.
+func (s *Server) newID(prefix string) string {
+	s.ids[prefix]++
+	return fmt.Sprintf("%s_%06d", prefix, s.ids[prefix])
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, ErrInvalidData, "Invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error envelope in the shape Medusa uses.
+func writeError(w http.ResponseWriter, status int, errType, message string) {
+	writeJSON(w, status, map[string]string{
+		"type":    errType,
+		"message": message,
+	})
+}
+
+func writeDeleted(w http.ResponseWriter, id, object string) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"id":      id,
+		"object":  object,
+		"deleted": true,
+	})
+}
+
+func notFound(w http.ResponseWriter, entity, id string) {
+	writeError(w, http.StatusNotFound, ErrNotFound, fmt.Sprintf("%s with id: %s was not found", entity, id))
+}
@@ -0,0 +1,347 @@
+package medusafake
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+func newTestClient(t *testing.T) (*Server, medusa.ClientWithResponsesInterface) {
+	t.Helper()
+
+	fake := NewServer()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	login, err := medusa.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := login.PostTokenWithResponse(context.Background(), medusa.PostTokenJSONRequestBody{
+		Email:    Email,
+		Password: Password,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.JSON200 == nil {
+		t.Fatalf("login failed with status %d", res.StatusCode())
+	}
+
+	token := *res.JSON200.AccessToken
+	client, err := medusa.NewClientWithResponses(server.URL, medusa.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return fake, client
+}
+
+func errorType(t *testing.T, body []byte) string {
+	t.Helper()
+
+	var envelope struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		t.Fatalf("invalid error envelope %q: %s", body, err)
+	}
+	if envelope.Message == "" {
+		t.Errorf("error envelope %q has no message", body)
+	}
+	return envelope.Type
+}
+
+func TestLogin(t *testing.T) {
+	server := httptest.NewServer(NewServer())
+	defer server.Close()
+
+	client, err := medusa.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := client.PostTokenWithResponse(context.Background(), medusa.PostTokenJSONRequestBody{
+		Email:    Email,
+		Password: "wrong",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode() != http.StatusUnauthorized {
+		t.Errorf("expected 401 for a wrong password, got %d", res.StatusCode())
+	}
+
+	store, err := client.GetStoreWithResponse(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if store.StatusCode() != http.StatusUnauthorized {
+		t.Errorf("expected 401 without a token, got %d", store.StatusCode())
+	}
+}
+
+func TestFaults(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		fault     Fault
+		status    int
+		errorType string
+		check     func(t *testing.T, header http.Header, elapsed time.Duration)
+	}{
+		{
+			name:      "rate limited",
+			fault:     Fault{Path: "/admin/regions", Status: http.StatusTooManyRequests, RetryAfter: 2 * time.Second},
+			status:    http.StatusTooManyRequests,
+			errorType: ErrTooManyRequest,
+			check: func(t *testing.T, header http.Header, _ time.Duration) {
+				if got := header.Get("Retry-After"); got != "2" {
+					t.Errorf("expected Retry-After 2, got %q", got)
+				}
+			},
+		},
+		{
+			name:      "server error",
+			fault:     Fault{Method: http.MethodGet, Status: http.StatusInternalServerError},
+			status:    http.StatusInternalServerError,
+			errorType: ErrUnknown,
+		},
+		{
+			name:   "latency",
+			fault:  Fault{Latency: 50 * time.Millisecond},
+			status: http.StatusOK,
+			check: func(t *testing.T, _ http.Header, elapsed time.Duration) {
+				if elapsed < 50*time.Millisecond {
+					t.Errorf("expected the answer to take at least 50ms, took %s", elapsed)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake, client := newTestClient(t)
+			fake.InjectFault(Fault{Method: http.MethodPost, Status: http.StatusBadGateway})
+			tt.fault.Times = 1
+			fake.InjectFault(tt.fault)
+
+			start := time.Now()
+			res, err := client.GetRegionsWithResponse(ctx, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.StatusCode() != tt.status {
+				t.Fatalf("expected status %d, got %d", tt.status, res.StatusCode())
+			}
+			if tt.errorType != "" {
+				if got := errorType(t, res.Body); got != tt.errorType {
+					t.Errorf("expected error type %s, got %s", tt.errorType, got)
+				}
+			}
+			if tt.check != nil {
+				tt.check(t, res.HTTPResponse.Header, time.Since(start))
+			}
+
+			res, err = client.GetRegionsWithResponse(ctx, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.StatusCode() != http.StatusOK {
+				t.Errorf("expected the fault to apply once, got status %d", res.StatusCode())
+			}
+		})
+	}
+}
+
+func TestExpireTokens(t *testing.T) {
+	fake, client := newTestClient(t)
+
+	fake.ExpireTokens()
+
+	res, err := client.GetStoreWithResponse(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode() != http.StatusUnauthorized {
+		t.Fatalf("expected 401 with an expired token, got %d", res.StatusCode())
+	}
+	if got := errorType(t, res.Body); got != ErrUnauthorized {
+		t.Errorf("expected error type %s, got %s", ErrUnauthorized, got)
+	}
+}
+
+func TestRegions(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+
+	created, err := client.PostRegionsWithResponse(ctx, medusa.PostRegionsJSONRequestBody{
+		Name:                 "Europe",
+		CurrencyCode:         "eur",
+		TaxRate:              21,
+		PaymentProviders:     []string{"manual"},
+		FulfillmentProviders: []string{"manual"},
+		Countries:            []string{"NL", "be"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.JSON200 == nil {
+		t.Fatalf("create failed with status %d: %s", created.StatusCode(), created.Body)
+	}
+	region := created.JSON200.Region
+	if countries := *region.Countries; len(countries) != 2 || countries[0].Iso2 != "be" || countries[1].Iso2 != "nl" {
+		t.Errorf("expected the countries to be lower cased and sorted, got %+v", countries)
+	}
+
+	duplicate, err := client.PostRegionsWithResponse(ctx, medusa.PostRegionsJSONRequestBody{
+		Name:                 "Benelux",
+		CurrencyCode:         "eur",
+		PaymentProviders:     []string{"manual"},
+		FulfillmentProviders: []string{"manual"},
+		Countries:            []string{"nl"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if duplicate.StatusCode() != http.StatusUnprocessableEntity || errorType(t, duplicate.Body) != ErrDuplicate {
+		t.Errorf("expected a duplicate error for a country in another region, got %d: %s", duplicate.StatusCode(), duplicate.Body)
+	}
+
+	name := "Western Europe"
+	updated, err := client.PostRegionsRegionWithResponse(ctx, region.Id, medusa.PostRegionsRegionJSONRequestBody{Name: &name})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.JSON200 == nil || updated.JSON200.Region.Name != name || updated.JSON200.Region.TaxRate != 21 {
+		t.Errorf("expected only the name to change, got %d: %s", updated.StatusCode(), updated.Body)
+	}
+
+	deleted, err := client.DeleteRegionsRegionWithResponse(ctx, region.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.StatusCode() != http.StatusOK {
+		t.Errorf("expected delete to succeed, got %d", deleted.StatusCode())
+	}
+
+	got, err := client.GetRegionsRegionWithResponse(ctx, region.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.StatusCode() != http.StatusNotFound || errorType(t, got.Body) != ErrNotFound {
+		t.Errorf("expected not found after delete, got %d: %s", got.StatusCode(), got.Body)
+	}
+}
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+
+	code := "eur"
+	res, err := client.PostStoreWithResponse(ctx, medusa.PostStoreJSONRequestBody{DefaultCurrencyCode: &code})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode() != http.StatusBadRequest || errorType(t, res.Body) != ErrInvalidData {
+		t.Errorf("expected invalid data for a default currency outside the currencies, got %d: %s", res.StatusCode(), res.Body)
+	}
+
+	currencies := []string{"usd", "eur"}
+	res, err = client.PostStoreWithResponse(ctx, medusa.PostStoreJSONRequestBody{DefaultCurrencyCode: &code, Currencies: &currencies})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.JSON200 == nil || res.JSON200.Store.DefaultCurrencyCode != "eur" {
+		t.Errorf("expected the default currency to change, got %d: %s", res.StatusCode(), res.Body)
+	}
+}
+
+func TestDefaultsCannotBeDeleted(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+
+	channel, err := client.DeleteSalesChannelsSalesChannelWithResponse(ctx, "sc_default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if channel.StatusCode() != http.StatusBadRequest || errorType(t, channel.Body) != ErrNotAllowed {
+		t.Errorf("expected the default sales channel to be protected, got %d: %s", channel.StatusCode(), channel.Body)
+	}
+
+	profile, err := client.DeleteShippingProfilesProfileWithResponse(ctx, "sp_default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.StatusCode() != http.StatusBadRequest || errorType(t, profile.Body) != ErrNotAllowed {
+		t.Errorf("expected the default shipping profile to be protected, got %d: %s", profile.StatusCode(), profile.Body)
+	}
+}
+
+func TestProductCategories(t *testing.T) {
+	ctx := context.Background()
+	_, client := newTestClient(t)
+
+	create := func(name string, parent *string) medusa.ProductCategory {
+		t.Helper()
+
+		res, err := client.PostProductCategoriesWithResponse(ctx, nil, medusa.PostProductCategoriesJSONRequestBody{
+			Name:             name,
+			ParentCategoryId: parent,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.JSON200 == nil {
+			t.Fatalf("create failed with status %d: %s", res.StatusCode(), res.Body)
+		}
+		return res.JSON200.ProductCategory
+	}
+
+	root := create("Men's Shoes", nil)
+	if root.Handle != "men-s-shoes" {
+		t.Errorf("expected the handle to be derived from the name, got %s", root.Handle)
+	}
+	first := create("Sneakers", &root.Id)
+	second := create("Boots", &root.Id)
+	if *first.Rank != 0 || *second.Rank != 1 {
+		t.Errorf("expected ranks 0 and 1, got %d and %d", *first.Rank, *second.Rank)
+	}
+
+	got, err := client.GetProductCategoriesCategoryWithResponse(ctx, root.Id, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if children := got.JSON200.ProductCategory.CategoryChildren; len(children) != 2 || children[0]["id"] != first.Id {
+		t.Errorf("expected the children ordered by rank, got %v", children)
+	}
+
+	deleted, err := client.DeleteProductCategoriesCategoryWithResponse(ctx, root.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.StatusCode() != http.StatusBadRequest || errorType(t, deleted.Body) != ErrNotAllowed {
+		t.Errorf("expected a category with children to be protected, got %d: %s", deleted.StatusCode(), deleted.Body)
+	}
+
+	// A null parent moves the category to the root.
+	moved, err := client.PostProductCategoriesCategoryWithBodyWithResponse(ctx, second.Id, nil, "application/json",
+		strings.NewReader(`{"parent_category_id":null}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moved.JSON200 == nil || moved.JSON200.ProductCategory.ParentCategoryId != nil || *moved.JSON200.ProductCategory.Rank != 1 {
+		t.Errorf("expected the category to be moved to the end of the root categories, got %d: %s", moved.StatusCode(), moved.Body)
+	}
+}
@@ -0,0 +1,129 @@
+package medusafake
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+type shippingProfileInput struct {
+	Name     *string         `json:"name"`
+	Type     *string         `json:"type"`
+	Metadata *map[string]any `json:"metadata"`
+}
+
+func (s *Server) listShippingProfiles(w http.ResponseWriter, r *http.Request) {
+	profiles := []medusa.ShippingProfile{}
+	for _, profile := range s.shippingProfiles {
+		profiles = append(profiles, *profile)
+	}
+
+	// The shipping profiles list is not paginated.
+	sort.Slice(profiles, func(i, j int) bool { return profiles[i].Id < profiles[j].Id })
+	writeJSON(w, http.StatusOK, medusa.AdminShippingProfilesListRes{ShippingProfiles: profiles})
+}
+
+func (s *Server) getShippingProfile(w http.ResponseWriter, r *http.Request) {
+	profile, ok := s.shippingProfiles[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Profile", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, medusa.AdminShippingProfilesRes{ShippingProfile: *profile})
+}
+
+func (s *Server) createShippingProfile(w http.ResponseWriter, r *http.Request) {
+	var in shippingProfileInput
+	if !decode(w, r, &in) {
+		return
+	}
+	if in.Name == nil || in.Type == nil {
+		writeError(w, http.StatusBadRequest, ErrInvalidData, "name and type must be defined")
+		return
+	}
+
+	now := s.now()
+	profile := &medusa.ShippingProfile{
+		Id:        s.newID("sp"),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if !applyShippingProfile(w, profile, in) {
+		return
+	}
+
+	s.shippingProfiles[profile.Id] = profile
+	writeJSON(w, http.StatusOK, medusa.AdminShippingProfilesRes{ShippingProfile: *profile})
+}
+
+func (s *Server) updateShippingProfile(w http.ResponseWriter, r *http.Request) {
+	current, ok := s.shippingProfiles[r.PathValue("id")]
+	if !ok {
+		notFound(w, "Profile", r.PathValue("id"))
+		return
+	}
+
+	var in shippingProfileInput
+	if !decode(w, r, &in) {
+		return
+	}
+
+	profile := *current
+	if !applyShippingProfile(w, &profile, in) {
+		return
+	}
+	profile.UpdatedAt = s.now()
+
+	*current = profile
+	writeJSON(w, http.StatusOK, medusa.AdminShippingProfilesRes{ShippingProfile: profile})
+}
+
+func (s *Server) deleteShippingProfile(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	profile, ok := s.shippingProfiles[id]
+	if !ok {
+		notFound(w, "Profile", id)
+		return
+	}
+	if profile.Type == "default" || profile.Type == "gift_card" {
+		writeError(w, http.StatusBadRequest, ErrNotAllowed,
+			fmt.Sprintf("The %s shipping profile cannot be deleted", profile.Type))
+		return
+	}
+
+	delete(s.shippingProfiles, id)
+	writeDeleted(w, id, "shipping_profile")
+}
+
+func applyShippingProfile(w http.ResponseWriter, profile *medusa.ShippingProfile, in shippingProfileInput) bool {
+	if in.Type != nil {
+		switch *in.Type {
+		case "default", "gift_card", "custom":
+			profile.Type = *in.Type
+		default:
+			writeError(w, http.StatusBadRequest, ErrInvalidData,
+				fmt.Sprintf("type must be one of default, gift_card, custom, got %s", *in.Type))
+			return false
+		}
+	}
+	if in.Name != nil {
+		profile.Name = *in.Name
+	}
+	profile.Metadata = mergeMetadata(profile.Metadata, in.Metadata)
+
+	return true
+}
+
+// listShippingOptions always returns an empty list. The provider does not
+// manage shipping options; the endpoint only serves the test sweepers.
+func (s *Server) listShippingOptions(w http.ResponseWriter, r *http.Request) {
+	options, count, limit, offset := page(r, []medusa.ShippingOption{}, func(o medusa.ShippingOption) string { return o.Id })
+	writeJSON(w, http.StatusOK, medusa.AdminShippingOptionsListRes{
+		ShippingOptions: options,
+		Count:           count,
+		Limit:           limit,
+		Offset:          offset,
+	})
+}
@@ -0,0 +1,150 @@
+package medusafake
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+// installedProviders are the payment and fulfillment providers of the fake.
+var installedProviders = []string{"manual"}
+
+type storeInput struct {
+	Name                *string         `json:"name"`
+	SwapLinkTemplate    *string         `json:"swap_link_template"`
+	PaymentLinkTemplate *string         `json:"payment_link_template"`
+	InviteLinkTemplate  *string         `json:"invite_link_template"`
+	DefaultCurrencyCode *string         `json:"default_currency_code"`
+	Currencies          *[]string       `json:"currencies"`
+	Metadata            *map[string]any `json:"metadata"`
+}
+
+func (s *Server) seed() {
+	now := s.now()
+
+	defaultChannel := &medusa.SalesChannel{
+		Id:          "sc_default",
+		Name:        "Default Sales Channel",
+		Description: ptr("Created by Medusa"),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	s.salesChannels[defaultChannel.Id] = defaultChannel
+
+	for _, profile := range []*medusa.ShippingProfile{
+		{Id: "sp_default", Name: "Default Shipping Profile", Type: "default"},
+		{Id: "sp_gift_card", Name: "Gift Card Profile", Type: "gift_card"},
+	} {
+		profile.CreatedAt = now
+		profile.UpdatedAt = now
+		s.shippingProfiles[profile.Id] = profile
+	}
+
+	s.store = medusa.Store{
+		Id:                    "store_default",
+		Name:                  "Medusa Store",
+		DefaultCurrencyCode:   "usd",
+		Currencies:            &[]medusa.Currency{currency("usd")},
+		DefaultSalesChannelId: &defaultChannel.Id,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	}
+}
+
+func (s *Server) getStore(w http.ResponseWriter, _ *http.Request) {
+	var providers []medusa.PaymentProvider
+	var fulfillment []medusa.FulfillmentProvider
+	for _, id := range installedProviders {
+		providers = append(providers, medusa.PaymentProvider{Id: id, IsInstalled: true})
+		fulfillment = append(fulfillment, medusa.FulfillmentProvider{Id: id, IsInstalled: true})
+	}
+
+	writeJSON(w, http.StatusOK, medusa.AdminExtendedStoresRes{
+		Store: medusa.ExtendedStoreDTO{
+			Id:                    s.store.Id,
+			Name:                  s.store.Name,
+			DefaultCurrencyCode:   s.store.DefaultCurrencyCode,
+			Currencies:            s.store.Currencies,
+			DefaultSalesChannelId: s.store.DefaultSalesChannelId,
+			DefaultLocationId:     s.store.DefaultLocationId,
+			SwapLinkTemplate:      s.store.SwapLinkTemplate,
+			PaymentLinkTemplate:   s.store.PaymentLinkTemplate,
+			InviteLinkTemplate:    s.store.InviteLinkTemplate,
+			Metadata:              s.store.Metadata,
+			PaymentProviders:      providers,
+			FulfillmentProviders:  fulfillment,
+			FeatureFlags:          medusa.FeatureFlagsResponse{},
+			Modules:               medusa.ModulesResponse{},
+			CreatedAt:             s.store.CreatedAt,
+			UpdatedAt:             s.store.UpdatedAt,
+		},
+	})
+}
+
+func (s *Server) postStore(w http.ResponseWriter, r *http.Request) {
+	var in storeInput
+	if !decode(w, r, &in) {
+		return
+	}
+
+	store := s.store
+
+	if in.Currencies != nil {
+		var currencies []medusa.Currency
+		for _, code := range *in.Currencies {
+			if !isCurrencyCode(code) {
+				writeError(w, http.StatusBadRequest, ErrInvalidData, fmt.Sprintf("Invalid currency %s", code))
+				return
+			}
+			currencies = append(currencies, currency(code))
+		}
+		store.Currencies = &currencies
+	}
+
+	if in.DefaultCurrencyCode != nil {
+		store.DefaultCurrencyCode = strings.ToLower(*in.DefaultCurrencyCode)
+	}
+
+	if !slices.ContainsFunc(*store.Currencies, func(c medusa.Currency) bool {
+		return c.Code == store.DefaultCurrencyCode
+	}) {
+		writeError(w, http.StatusBadRequest, ErrInvalidData,
+			fmt.Sprintf("Store does not have currency: %s", store.DefaultCurrencyCode))
+		return
+	}
+
+	if in.Name != nil {
+		store.Name = *in.Name
+	}
+	if in.SwapLinkTemplate != nil {
+		store.SwapLinkTemplate = in.SwapLinkTemplate
+	}
+	if in.PaymentLinkTemplate != nil {
+		store.PaymentLinkTemplate = in.PaymentLinkTemplate
+	}
+	if in.InviteLinkTemplate != nil {
+		store.InviteLinkTemplate = in.InviteLinkTemplate
+	}
+	store.Metadata = mergeMetadata(store.Metadata, in.Metadata)
+	store.UpdatedAt = s.now()
+
+	s.store = store
+	writeJSON(w, http.StatusOK, medusa.AdminStoresRes{Store: store})
+}
+
+func currency(code string) medusa.Currency {
+	code = strings.ToLower(code)
+	return medusa.Currency{
+		Code:         code,
+		Name:         strings.ToUpper(code),
+		Symbol:       strings.ToUpper(code),
+		SymbolNative: strings.ToUpper(code),
+	}
+}
+
+func isCurrencyCode(code string) bool {
+	return len(code) == 3 && strings.ToLower(code) == code && strings.Trim(code, "abcdefghijklmnopqrstuvwxyz") == ""
+}
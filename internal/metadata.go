@@ -0,0 +1,28 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// metadataAttribute returns the schema of the metadata attribute shared by
+// the resources. Only the keys in the configuration are managed, so keys
+// written by other integrations are kept. Empty values are rejected, as
+// Medusa removes a key that is set to an empty string.
+func metadataAttribute(entity string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: fmt.Sprintf("Key-value pairs holding additional information about the %s. "+
+			"Only the keys set here are managed: keys removed from the configuration are removed from the %s, "+
+			"and keys set outside of Terraform are left untouched.", entity, entity),
+		Optional:    true,
+		ElementType: types.StringType,
+		Validators: []validator.Map{
+			mapvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+		},
+	}
+}
@@ -0,0 +1,132 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+)
+
+// The model tests send a plan through the in-memory fake API and check that
+// the state read back from the response equals the plan.
+
+// modelDiff returns the attributes whose values differ between two resource
+// models of the same type.
+func modelDiff(want, got any) []string {
+	var diff []string
+
+	wv, gv := reflect.ValueOf(want), reflect.ValueOf(got)
+	for i := 0; i < wv.NumField(); i++ {
+		if !modelValueEqual(wv.Field(i), gv.Field(i)) {
+			diff = append(diff, fmt.Sprintf("%s: want %v, got %v",
+				wv.Type().Field(i).Tag.Get("tfsdk"), wv.Field(i).Interface(), gv.Field(i).Interface()))
+		}
+	}
+
+	return diff
+}
+
+func modelValueEqual(want, got reflect.Value) bool {
+	if value, ok := want.Interface().(attr.Value); ok {
+		return value.Equal(got.Interface().(attr.Value))
+	}
+
+	// Slices hold set attributes, so their order does not matter.
+	if want.Kind() == reflect.Slice {
+		if want.Len() != got.Len() {
+			return false
+		}
+		matched := make([]bool, got.Len())
+		for i := 0; i < want.Len(); i++ {
+			found := false
+			for j := 0; j < got.Len(); j++ {
+				if !matched[j] && modelValueEqual(want.Index(i), got.Index(j)) {
+					matched[j], found = true, true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+
+	return reflect.DeepEqual(want.Interface(), got.Interface())
+}
+
+func checkModel(t *testing.T, want, got any) {
+	t.Helper()
+
+	for _, diff := range modelDiff(want, got) {
+		t.Error(diff)
+	}
+}
+
+// checkModelProperty runs a testing/quick property that reports the
+// differences between the plan and the state.
+func checkModelProperty(t *testing.T, property any) {
+	t.Helper()
+
+	if err := quick.Check(property, &quick.Config{MaxCount: 50}); err != nil {
+		t.Error(err)
+	}
+}
+
+// quickString turns a generated string into a valid, non-empty attribute
+// value, as Terraform only allows UTF-8 strings.
+func quickString(s string) types.String {
+	s = strings.ToValidUTF8(s, "")
+	if s == "" {
+		s = "x"
+	}
+	return types.StringValue(s)
+}
+
+// quickOptionalString returns null for nil, so both set and unset optional
+// attributes are generated.
+func quickOptionalString(s *string) types.String {
+	if s == nil {
+		return types.StringNull()
+	}
+	return quickString(*s)
+}
+
+// testNumber parses a decimal the way Terraform parses numbers in
+// configuration.
+func testNumber(t *testing.T, decimal string) types.Number {
+	t.Helper()
+
+	f, _, err := big.ParseFloat(decimal, 10, 512, big.ToNearestEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.NumberValue(f)
+}
+
+// testJSONBody encodes a request for the raw body variants of the SDK.
+func testJSONBody(t *testing.T, v any) io.Reader {
+	t.Helper()
+
+	body, err := utils.JSONBody(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+// testMetadata returns a metadata attribute value from key-value pairs.
+func testMetadata(kv ...string) map[string]types.String {
+	result := map[string]types.String{}
+	for i := 0; i < len(kv); i += 2 {
+		result[kv[i]] = types.StringValue(kv[i+1])
+	}
+	return result
+}
@@ -5,38 +5,44 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
 )
 
 // productCategoryResourceModel maps the resource schema data.
 type productCategoryResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Description      types.String `tfsdk:"description"`
-	Handle           types.String `tfsdk:"handle"`
-	IsInternal       types.Bool   `tfsdk:"is_internal"`
-	IsActive         types.Bool   `tfsdk:"is_active"`
-	ParentCategoryId types.String `tfsdk:"parent_category_id"`
+	ID               types.String            `tfsdk:"id"`
+	Name             types.String            `tfsdk:"name"`
+	Description      types.String            `tfsdk:"description"`
+	Handle           types.String            `tfsdk:"handle"`
+	IsInternal       types.Bool              `tfsdk:"is_internal"`
+	IsActive         types.Bool              `tfsdk:"is_active"`
+	ParentCategoryId types.String            `tfsdk:"parent_category_id"`
+	Metadata         map[string]types.String `tfsdk:"metadata"`
+
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
 }
 
 func (m *productCategoryResourceModel) toCreateInput() medusa.AdminPostProductCategoriesReq {
 	return medusa.AdminPostProductCategoriesReq{
 		Name:             m.Name.ValueString(),
 		Description:      m.Description.ValueStringPointer(),
-		Handle:           m.Handle.ValueStringPointer(),
+		Handle:           utils.ConvertToPointerString(m.Handle),
 		IsInternal:       m.IsInternal.ValueBoolPointer(),
 		IsActive:         m.IsActive.ValueBoolPointer(),
 		ParentCategoryId: m.ParentCategoryId.ValueStringPointer(),
+		Metadata:         utils.ConvertToMetadata(m.Metadata),
 	}
 }
 
-func (m *productCategoryResourceModel) toUpdateInput() medusa.AdminPostProductCategoriesCategoryReq {
+func (m *productCategoryResourceModel) toUpdateInput(prior *productCategoryResourceModel) medusa.AdminPostProductCategoriesCategoryReq {
 	return medusa.AdminPostProductCategoriesCategoryReq{
-		Name:             m.Name.ValueStringPointer(),
-		Description:      m.Description.ValueStringPointer(),
-		Handle:           m.Handle.ValueStringPointer(),
-		IsInternal:       m.IsInternal.ValueBoolPointer(),
-		IsActive:         m.IsActive.ValueBoolPointer(),
-		ParentCategoryId: m.ParentCategoryId.ValueStringPointer(),
+		Name:             utils.ChangedString(m.Name, prior.Name),
+		Description:      utils.ChangedString(m.Description, prior.Description),
+		Handle:           utils.ChangedString(m.Handle, prior.Handle),
+		IsInternal:       utils.ChangedBool(m.IsInternal, prior.IsInternal),
+		IsActive:         utils.ChangedBool(m.IsActive, prior.IsActive),
+		ParentCategoryId: utils.ChangedString(m.ParentCategoryId, prior.ParentCategoryId),
+		Metadata:         utils.ConvertToMetadataUpdate(m.Metadata, prior.Metadata),
 	}
 }
 
@@ -52,6 +58,7 @@ func (m *productCategoryResourceModel) fromRemote(c *medusa.AdminProductCategori
 	m.IsInternal = types.BoolValue(c.ProductCategory.IsInternal)
 	m.IsActive = types.BoolValue(c.ProductCategory.IsActive)
 	m.ParentCategoryId = types.StringPointerValue(c.ProductCategory.ParentCategoryId)
+	m.Metadata = utils.ConvertToTerraformMetadata(m.Metadata, c.ProductCategory.Metadata)
 
 	return nil
 }
@@ -0,0 +1,158 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProductCategoryModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	parent := productCategoryResourceModel{
+		Name:             types.StringValue("Clothing"),
+		Description:      types.StringNull(),
+		Handle:           types.StringValue("clothing"),
+		IsInternal:       types.BoolValue(false),
+		IsActive:         types.BoolValue(true),
+		ParentCategoryId: types.StringNull(),
+	}
+	created, err := client.PostProductCategoriesWithResponse(ctx, nil, parent.toCreateInput())
+	if err != nil || created.JSON200 == nil {
+		t.Fatalf("unable to create parent category: %v", err)
+	}
+	parentID := types.StringValue(created.JSON200.ProductCategory.Id)
+
+	tests := []struct {
+		name   string
+		create productCategoryResourceModel
+		update productCategoryResourceModel
+	}{
+		{
+			name: "root category",
+			create: productCategoryResourceModel{
+				Name:             types.StringValue("Shoes"),
+				Description:      types.StringNull(),
+				Handle:           types.StringValue("shoes"),
+				IsInternal:       types.BoolValue(false),
+				IsActive:         types.BoolValue(false),
+				ParentCategoryId: types.StringNull(),
+			},
+			update: productCategoryResourceModel{
+				Name:             types.StringValue("Shoes"),
+				Description:      types.StringValue("All shoes"),
+				Handle:           types.StringValue("all-shoes"),
+				IsInternal:       types.BoolValue(true),
+				IsActive:         types.BoolValue(true),
+				ParentCategoryId: parentID,
+			},
+		},
+		{
+			name: "metadata",
+			create: productCategoryResourceModel{
+				Name:             types.StringValue("Boots"),
+				Description:      types.StringNull(),
+				Handle:           types.StringValue("boots"),
+				IsInternal:       types.BoolValue(false),
+				IsActive:         types.BoolValue(false),
+				ParentCategoryId: types.StringNull(),
+				Metadata:         testMetadata("erp_id", "42", "channel", "web"),
+			},
+			update: productCategoryResourceModel{
+				Name:             types.StringValue("Boots"),
+				Description:      types.StringValue("All boots"),
+				Handle:           types.StringValue("all-boots"),
+				IsInternal:       types.BoolValue(true),
+				IsActive:         types.BoolValue(true),
+				ParentCategoryId: parentID,
+				Metadata:         testMetadata("erp_id", "43"),
+			},
+		},
+		{
+			name: "child category",
+			create: productCategoryResourceModel{
+				Name:             types.StringValue("Shirts"),
+				Description:      types.StringValue(""),
+				Handle:           types.StringValue("shirts"),
+				IsInternal:       types.BoolValue(true),
+				IsActive:         types.BoolValue(true),
+				ParentCategoryId: parentID,
+			},
+			update: productCategoryResourceModel{
+				Name:             types.StringValue("T-Shirts"),
+				Description:      types.StringValue("Short sleeves"),
+				Handle:           types.StringValue("t-shirts"),
+				IsInternal:       types.BoolValue(false),
+				IsActive:         types.BoolValue(true),
+				ParentCategoryId: parentID,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created, err := client.PostProductCategoriesWithResponse(ctx, nil, tt.create.toCreateInput())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			state := productCategoryResourceModel{Metadata: tt.create.Metadata}
+			if err := state.fromRemote(created.JSON200); err != nil {
+				t.Fatalf("%s: %s", err, created.Body)
+			}
+			tt.create.ID = state.ID
+			checkModel(t, tt.create, state)
+
+			prior := state
+			updated, err := client.PostProductCategoriesCategoryWithResponse(ctx, state.ID.ValueString(), nil, tt.update.toUpdateInput(&prior))
+			if err != nil {
+				t.Fatal(err)
+			}
+			state = productCategoryResourceModel{Metadata: tt.update.Metadata}
+			if err := state.fromRemote(updated.JSON200); err != nil {
+				t.Fatalf("%s: %s", err, updated.Body)
+			}
+			tt.update.ID = state.ID
+			checkModel(t, tt.update, state)
+		})
+	}
+}
+
+func TestProductCategoryModelProperties(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	checkModelProperty(t, func(name, handle string, description *string, internal, active bool) bool {
+		plan := productCategoryResourceModel{
+			Name:             quickString(name),
+			Description:      quickOptionalString(description),
+			Handle:           quickString(handle),
+			IsInternal:       types.BoolValue(internal),
+			IsActive:         types.BoolValue(active),
+			ParentCategoryId: types.StringNull(),
+		}
+
+		created, err := client.PostProductCategoriesWithResponse(ctx, nil, plan.toCreateInput())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if created.JSON200 == nil {
+			// Generated handles can collide with an earlier one.
+			return true
+		}
+
+		var state productCategoryResourceModel
+		if err := state.fromRemote(created.JSON200); err != nil {
+			t.Fatal(err)
+		}
+		plan.ID = state.ID
+
+		diff := modelDiff(plan, state)
+		for _, d := range diff {
+			t.Log(d)
+		}
+		return len(diff) == 0
+	})
+}
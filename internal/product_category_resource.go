@@ -2,17 +2,17 @@ package internal
 
 import (
 	"context"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
-
 	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                = &productCategoryResource{}
 	_ resource.ResourceWithConfigure   = &productCategoryResource{}
 	_ resource.ResourceWithImportState = &productCategoryResource{}
+	_ resource.ResourceWithModifyPlan  = &productCategoryResource{}
 )
 
 // NewProductCategoryResource is a helper function to simplify the provider implementation.
@@ -29,7 +30,7 @@ func NewProductCategoryResource() resource.Resource {
 
 // productCategoryResource is the resource implementation.
 type productCategoryResource struct {
-	client medusa.ClientWithResponsesInterface
+	client utils.Client
 }
 
 // Metadata returns the data source type name.
@@ -58,11 +59,7 @@ func (r *productCategoryResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:    true,
 				Optional:    true,
 			},
-			"handle": schema.StringAttribute{
-				Description: "The handle of the product category.",
-				Computed:    true,
-				Optional:    true,
-			},
+			"handle": handleAttribute("product category", "name"),
 			"is_internal": schema.BoolAttribute{
 				Description: "If set to true, the product category will only be available to admins.",
 				Computed:    true,
@@ -78,17 +75,25 @@ func (r *productCategoryResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:    true,
 				Optional:    true,
 			},
+			"metadata":            metadataAttribute("product category"),
+			"deletion_protection": deletionProtectionAttribute("product category"),
 		},
 	}
 }
 
 // Configure adds the provider configured client to the data source.
-func (r *productCategoryResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (r *productCategoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	r.client = utils.GetClient(req.ProviderData)
+	client, d := utils.GetClient(req.ProviderData)
+	if d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	r.client = client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -128,6 +133,8 @@ func (r *productCategoryResource) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.ProductCategory.UpdatedAt)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -157,6 +164,7 @@ func (r *productCategoryResource) Read(ctx context.Context, req resource.ReadReq
 		)
 		return
 	}
+	state.DeletionProtection = stateFlag(state.DeletionProtection)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -164,6 +172,8 @@ func (r *productCategoryResource) Read(ctx context.Context, req resource.ReadReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.ProductCategory.UpdatedAt)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -176,8 +186,34 @@ func (r *productCategoryResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
-	// Generate API request body from plan
-	input := plan.toUpdateInput()
+	// Retrieve values from state, to know the metadata keys to remove
+	var state productCategoryResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only attributes kept in the Terraform state changed, so there is
+	// nothing to send to Medusa
+	input := plan.toUpdateInput(&state)
+	if utils.NoChanges(input) {
+		state.DeletionProtection = plan.DeletionProtection
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
+	}
+
+	// Check that the product category did not change since Terraform last read it
+	checkConcurrentChange(ctx, r.client, req.Private, "product category", plan.ID.ValueString(), func() (time.Time, *diag.ErrorDiagnostic) {
+		content, err := r.client.GetProductCategoriesCategoryWithResponse(ctx, plan.ID.ValueString(), nil)
+		if d := utils.CheckGetError("product_category", plan.ID.ValueString(), content, err); d != nil {
+			return time.Time{}, d
+		}
+		return content.JSON200.ProductCategory.UpdatedAt, nil
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	content, err := r.client.PostProductCategoriesCategoryWithResponse(ctx, plan.ID.ValueString(), nil, input)
 	if d := utils.CheckUpdateError("product_category", content, err); d != nil {
@@ -202,6 +238,8 @@ func (r *productCategoryResource) Update(ctx context.Context, req resource.Updat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.ProductCategory.UpdatedAt)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -214,6 +252,10 @@ func (r *productCategoryResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	if checkDeletionProtection(ctx, req.State, &resp.Diagnostics, "product_category") {
+		return
+	}
+
 	content, err := r.client.DeleteProductCategoriesCategoryWithResponse(ctx, state.ID.ValueString())
 	if d := utils.CheckDeleteError("product_category", content, err); d != nil {
 		resp.Diagnostics.Append(d)
@@ -221,7 +263,51 @@ func (r *productCategoryResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+// ModifyPlan warns when a protected product category would be destroyed,
+// and checks that the planned handle is not used by another product
+// category.
+func (r *productCategoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnDeletionProtection(ctx, req, resp, "product category")
+
+	// The client is not configured yet when the provider configuration is
+	// unknown.
+	if r.client == nil {
+		return
+	}
+	checkHandle(ctx, req, resp, "product category", r.findByHandle)
+}
+
+// ImportState imports a product category by id, or by its handle as
+// handle:<handle>.
 func (r *productCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importState(ctx, req, resp, "product category", map[string]importLookup{
+		"handle": r.findByHandle,
+	})
+}
+
+func (r *productCategoryResource) findByHandle(ctx context.Context, handle string) ([]importCandidate, error) {
+	return findCategoriesByHandle(ctx, r.client, handle)
+}
+
+// findCategoriesByHandle returns the categories with a handle, which the
+// category tree looks up as well.
+func findCategoriesByHandle(ctx context.Context, client utils.Client, handle string) ([]importCandidate, error) {
+	return findAll(func(offset int) ([]importCandidate, int, error) {
+		limit := importPageSize
+		content, err := client.GetProductCategoriesWithResponse(ctx, &medusa.GetProductCategoriesParams{Handle: &handle, Offset: &offset, Limit: &limit})
+		if err != nil {
+			return nil, 0, err
+		}
+		if content.JSON200 == nil {
+			return nil, 0, listError(content.StatusCode(), content.Body)
+		}
+
+		var matches []importCandidate
+		for _, category := range content.JSON200.ProductCategories {
+			if category.Handle == handle {
+				matches = append(matches, importCandidate{ID: category.Id, Label: category.Name})
+			}
+		}
+		return matches, content.JSON200.Count, nil
+	})
 }
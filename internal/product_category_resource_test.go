@@ -0,0 +1,210 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+func init() {
+	resource.AddTestSweepers("medusa_product_category", &resource.Sweeper{
+		Name: "medusa_product_category",
+		F:    sweepProductCategories,
+	})
+}
+
+// sweepProductCategories deletes the deepest categories first, as a
+// category with children cannot be deleted.
+func sweepProductCategories(_ string) error {
+	ctx := context.Background()
+
+	client, err := sweepClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	depth := map[string]int{}
+	categories, err := findAll(func(offset int) ([]sweepable, int, error) {
+		res, err := client.GetProductCategoriesWithResponse(ctx, &medusa.GetProductCategoriesParams{
+			Q:      ptr(testAccNamePrefix),
+			Offset: &offset,
+			Limit:  ptr(importPageSize),
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		if res.JSON200 == nil {
+			return nil, 0, listError(res.StatusCode(), res.Body)
+		}
+
+		var objects []sweepable
+		for _, category := range res.JSON200.ProductCategories {
+			if category.Mpath != nil {
+				depth[category.Id] = strings.Count(*category.Mpath, ".")
+			}
+			objects = append(objects, sweepable{ID: category.Id, Name: category.Name})
+		}
+		return objects, res.JSON200.Count, nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list medusa_product_category: %w", err)
+	}
+
+	leaked := testAccObjects(categories)
+	sort.SliceStable(leaked, func(i, j int) bool {
+		return depth[leaked[i].ID] > depth[leaked[j].ID]
+	})
+
+	return sweepObjects(ctx, client, "medusa_product_category", leaked,
+		func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error) {
+			res, err := client.DeleteProductCategoriesCategoryWithResponse(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return res.StatusCode(), nil
+		})
+}
+
+func TestAccProductCategoryResource(t *testing.T) {
+	h := newTestAccHarness(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		CheckDestroy: h.checkDestroy(t, "medusa_product_category", func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error) {
+			content, err := client.GetProductCategoriesCategoryWithResponse(ctx, id, nil)
+			if err != nil {
+				return 0, err
+			}
+			return content.StatusCode(), nil
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: h.providerConfig() + `
+resource "medusa_product_category" "parent" {
+  name        = "tf-acc-category-parent"
+  description = "created by an acceptance test"
+  handle      = "tf-acc-category-parent"
+  is_internal = false
+  is_active   = true
+}
+
+resource "medusa_product_category" "test" {
+  name               = "tf-acc-category"
+  handle             = "tf-acc-category"
+  is_internal        = false
+  is_active          = false
+  parent_category_id = medusa_product_category.parent.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("medusa_product_category.test", "id"),
+					resource.TestCheckResourceAttr("medusa_product_category.test", "name", "tf-acc-category"),
+					resource.TestCheckResourceAttr("medusa_product_category.test", "handle", "tf-acc-category"),
+					resource.TestCheckResourceAttr("medusa_product_category.test", "is_active", "false"),
+					resource.TestCheckResourceAttrPair(
+						"medusa_product_category.test", "parent_category_id",
+						"medusa_product_category.parent", "id",
+					),
+				),
+			},
+			{
+				ResourceName:      "medusa_product_category.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "medusa_product_category.test",
+				ImportState:       true,
+				ImportStateId:     "handle:tf-acc-category",
+				ImportStateVerify: true,
+			},
+			{
+				Config: h.providerConfig() + `
+resource "medusa_product_category" "parent" {
+  name        = "tf-acc-category-parent"
+  description = "created by an acceptance test"
+  handle      = "tf-acc-category-parent"
+  is_internal = false
+  is_active   = true
+}
+
+resource "medusa_product_category" "test" {
+  name               = "tf-acc-category-updated"
+  description        = "updated by an acceptance test"
+  handle             = "tf-acc-category-updated"
+  is_internal        = true
+  is_active          = true
+  parent_category_id = medusa_product_category.parent.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("medusa_product_category.test", "name", "tf-acc-category-updated"),
+					resource.TestCheckResourceAttr("medusa_product_category.test", "description", "updated by an acceptance test"),
+					resource.TestCheckResourceAttr("medusa_product_category.test", "handle", "tf-acc-category-updated"),
+					resource.TestCheckResourceAttr("medusa_product_category.test", "is_internal", "true"),
+					resource.TestCheckResourceAttr("medusa_product_category.test", "is_active", "true"),
+				),
+			},
+		},
+	})
+}
+
+// A handle left out of the configuration is generated by Medusa and kept
+// when the name changes, and a handle of another category is rejected on
+// plan. The destroy of the test runs with the configuration of the last
+// step, so it ends with a valid one.
+func TestAccProductCategoryResourceHandle(t *testing.T) {
+	h := newTestAccHarness(t)
+
+	config := func(name, duplicate string) string {
+		config := h.providerConfig() + fmt.Sprintf(`
+resource "medusa_product_category" "test" {
+  name = %q
+}
+`, name)
+		if duplicate != "" {
+			config += fmt.Sprintf(`
+resource "medusa_product_category" "duplicate" {
+  name   = "tf-acc-category-duplicate"
+  handle = %q
+}
+`, duplicate)
+		}
+		return config
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("tf-acc-category generated", ""),
+				Check:  resource.TestCheckResourceAttr("medusa_product_category.test", "handle", "tf-acc-category-generated"),
+			},
+			{
+				Config: config("tf-acc-category renamed", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("medusa_product_category.test", "name", "tf-acc-category renamed"),
+					resource.TestCheckResourceAttr("medusa_product_category.test", "handle", "tf-acc-category-generated"),
+				),
+			},
+			{
+				Config:      config("tf-acc-category renamed", "tf-acc-category_duplicate"),
+				ExpectError: regexp.MustCompile(`must be a handle`),
+			},
+			{
+				Config:      config("tf-acc-category renamed", "tf-acc-category-generated"),
+				ExpectError: regexp.MustCompile(`Handle already in use`),
+			},
+			{
+				Config: config("tf-acc-category renamed", "tf-acc-category-duplicate"),
+				Check:  resource.TestCheckResourceAttr("medusa_product_category.duplicate", "handle", "tf-acc-category-duplicate"),
+			},
+		},
+	})
+}
@@ -0,0 +1,505 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+// productCategoryTreeResourceModel maps the resource schema data. The
+// categories are nested blocks of a fixed depth, so they are converted from
+// and to categoryTreeNode values by hand.
+type productCategoryTreeResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	ParentCategoryID types.String `tfsdk:"parent_category_id"`
+	Category         types.List   `tfsdk:"category"`
+	IDs              types.Map    `tfsdk:"ids"`
+}
+
+// categoryTreeNode is a category of the tree with its children, ordered by
+// rank.
+type categoryTreeNode struct {
+	Name       types.String
+	Handle     types.String
+	IsActive   types.Bool
+	IsInternal types.Bool
+	Children   []categoryTreeNode
+}
+
+// categoryTreeNodes converts a list of category blocks to nodes.
+func categoryTreeNodes(list types.List) []categoryTreeNode {
+	var nodes []categoryTreeNode
+	for _, element := range list.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+
+		attrs := object.Attributes()
+		node := categoryTreeNode{}
+		node.Name, _ = attrs["name"].(types.String)
+		node.Handle, _ = attrs["handle"].(types.String)
+		node.IsActive, _ = attrs["is_active"].(types.Bool)
+		node.IsInternal, _ = attrs["is_internal"].(types.Bool)
+		if children, ok := attrs["children"].(types.List); ok {
+			node.Children = categoryTreeNodes(children)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// categoryTreeList converts nodes to a list of category blocks of the list
+// type t. Children below the deepest level of t are left out.
+func categoryTreeList(t attr.Type, nodes []categoryTreeNode) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	listType, ok := t.(types.ListType)
+	if !ok {
+		diags.AddError("Invalid category tree type", fmt.Sprintf("Expected a list type, got %s", t))
+		return types.ListNull(t), diags
+	}
+	objectType, ok := listType.ElemType.(types.ObjectType)
+	if !ok {
+		diags.AddError("Invalid category tree type", fmt.Sprintf("Expected an object type, got %s", listType.ElemType))
+		return types.ListNull(listType.ElemType), diags
+	}
+
+	elements := make([]attr.Value, 0, len(nodes))
+	for _, node := range nodes {
+		attrs := map[string]attr.Value{
+			"name":        node.Name,
+			"handle":      node.Handle,
+			"is_active":   node.IsActive,
+			"is_internal": node.IsInternal,
+		}
+		if childType, ok := objectType.AttrTypes["children"]; ok {
+			children, d := categoryTreeList(childType, node.Children)
+			diags.Append(d...)
+			attrs["children"] = children
+		}
+
+		object, d := types.ObjectValue(objectType.AttrTypes, attrs)
+		diags.Append(d...)
+		elements = append(elements, object)
+	}
+	if diags.HasError() {
+		return types.ListNull(objectType), diags
+	}
+
+	list, d := types.ListValue(objectType, elements)
+	diags.Append(d...)
+	return list, diags
+}
+
+// categoryTreeHandles returns the handles of the nodes and their children.
+func categoryTreeHandles(nodes []categoryTreeNode) []types.String {
+	var handles []types.String
+	for _, node := range nodes {
+		handles = append(handles, node.Handle)
+		handles = append(handles, categoryTreeHandles(node.Children)...)
+	}
+	return handles
+}
+
+// categoryTreeInput is the request to create or update a category of the
+// tree. Only the fields that differ from the category are set.
+type categoryTreeInput struct {
+	Name             *string         `json:"name,omitempty"`
+	Handle           *string         `json:"handle,omitempty"`
+	IsActive         *bool           `json:"is_active,omitempty"`
+	IsInternal       *bool           `json:"is_internal,omitempty"`
+	ParentCategoryID *categoryParent `json:"parent_category_id,omitempty"`
+	Rank             *int            `json:"rank,omitempty"`
+}
+
+// categoryParent is the parent of a category in a request. The root is sent
+// as null, which the SDK request types cannot express.
+type categoryParent string
+
+func (p categoryParent) MarshalJSON() ([]byte, error) {
+	if p == "" {
+		return []byte("null"), nil
+	}
+	return json.Marshal(string(p))
+}
+
+// categoryTreeAPI makes the requests of the reconciliation of a tree.
+type categoryTreeAPI interface {
+	createCategory(ctx context.Context, input categoryTreeInput) (string, error)
+	updateCategory(ctx context.Context, id string, input categoryTreeInput) error
+	deleteCategory(ctx context.Context, id string) error
+}
+
+// categoryTreeCategory is a category as Medusa holds it.
+type categoryTreeCategory struct {
+	ID         string
+	Name       string
+	Handle     string
+	IsActive   bool
+	IsInternal bool
+	Parent     string
+}
+
+// categoryTreeRemote holds the categories of Medusa and the order of the
+// children of every category, with the root categories under "". It is kept
+// up to date while the tree is reconciled, so the requests only change what
+// is not in place yet.
+type categoryTreeRemote struct {
+	categories map[string]*categoryTreeCategory
+	children   map[string][]string
+}
+
+func newCategoryTreeRemote(categories []medusa.ProductCategory) *categoryTreeRemote {
+	r := &categoryTreeRemote{
+		categories: map[string]*categoryTreeCategory{},
+		children:   map[string][]string{},
+	}
+
+	sort.SliceStable(categories, func(i, j int) bool {
+		ri, rj := categoryRank(categories[i]), categoryRank(categories[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return categories[i].Id < categories[j].Id
+	})
+	for _, c := range categories {
+		parent := ""
+		if c.ParentCategoryId != nil {
+			parent = *c.ParentCategoryId
+		}
+		r.categories[c.Id] = &categoryTreeCategory{
+			ID:         c.Id,
+			Name:       c.Name,
+			Handle:     c.Handle,
+			IsActive:   c.IsActive,
+			IsInternal: c.IsInternal,
+			Parent:     parent,
+		}
+		r.children[parent] = append(r.children[parent], c.Id)
+	}
+	return r
+}
+
+func categoryRank(c medusa.ProductCategory) int {
+	if c.Rank == nil {
+		return int(^uint(0) >> 1)
+	}
+	return *c.Rank
+}
+
+// index returns the position of the category among its siblings, not
+// counting the siblings in skip.
+func (r *categoryTreeRemote) index(id string, skip map[string]bool) int {
+	i := 0
+	for _, sibling := range r.children[r.categories[id].Parent] {
+		if sibling == id {
+			return i
+		}
+		if !skip[sibling] {
+			i++
+		}
+	}
+	return -1
+}
+
+// move places the category at rank below parent, as Medusa does.
+func (r *categoryTreeRemote) move(id, parent string, rank int) {
+	r.detach(id)
+
+	r.categories[id].Parent = parent
+	siblings := r.children[parent]
+	rank = max(min(rank, len(siblings)), 0)
+	r.children[parent] = append(siblings[:rank:rank], append([]string{id}, siblings[rank:]...)...)
+}
+
+// remove deletes the category, as Medusa does.
+func (r *categoryTreeRemote) remove(id string) {
+	r.detach(id)
+	delete(r.categories, id)
+}
+
+// detach removes the category from the children of its parent.
+func (r *categoryTreeRemote) detach(id string) {
+	parent := r.categories[id].Parent
+	siblings := r.children[parent]
+	for i, sibling := range siblings {
+		if sibling == id {
+			r.children[parent] = append(siblings[:i:i], siblings[i+1:]...)
+			return
+		}
+	}
+}
+
+func (r *categoryTreeRemote) depth(id string) int {
+	depth := 0
+	for c, ok := r.categories[id]; ok && c.Parent != ""; c, ok = r.categories[c.Parent] {
+		depth++
+	}
+	return depth
+}
+
+// reconcile makes the requests that turn the categories below root into
+// nodes, and returns the ids of the categories by handle. managed holds the
+// ids of the categories of the tree by handle: the ones that are no longer
+// in nodes are deleted, and the ones that are missing are created again.
+// When a request fails, the ids of the categories created or kept so far
+// are returned with the error.
+func (r *categoryTreeRemote) reconcile(ctx context.Context, api categoryTreeAPI, root string, nodes []categoryTreeNode, managed map[string]string) (map[string]string, error) {
+	ids := map[string]string{}
+
+	// The categories that are deleted once the tree is in place do not count
+	// when ranking their siblings, as Medusa closes the gaps they leave.
+	planned := map[string]bool{}
+	for _, handle := range categoryTreeHandles(nodes) {
+		planned[handle.ValueString()] = true
+	}
+	deleted := map[string]bool{}
+	for handle, id := range managed {
+		if !planned[handle] {
+			deleted[id] = true
+		}
+	}
+
+	var place func(parent string, nodes []categoryTreeNode) error
+	place = func(parent string, nodes []categoryTreeNode) error {
+		previous := ""
+		for i, node := range nodes {
+			handle := node.Handle.ValueString()
+
+			id, ok := managed[handle]
+			if _, exists := r.categories[id]; !ok || !exists {
+				input := categoryTreeInput{
+					Name:       node.Name.ValueStringPointer(),
+					Handle:     &handle,
+					IsActive:   node.IsActive.ValueBoolPointer(),
+					IsInternal: node.IsInternal.ValueBoolPointer(),
+				}
+				if parent != "" {
+					p := categoryParent(parent)
+					input.ParentCategoryID = &p
+				}
+
+				created, err := api.createCategory(ctx, input)
+				if err != nil {
+					return err
+				}
+				id = created
+				r.categories[id] = &categoryTreeCategory{
+					ID:         id,
+					Name:       node.Name.ValueString(),
+					Handle:     handle,
+					IsActive:   node.IsActive.ValueBool(),
+					IsInternal: node.IsInternal.ValueBool(),
+					Parent:     parent,
+				}
+				r.children[parent] = append(r.children[parent], id)
+			}
+			ids[handle] = id
+
+			// A created category is added after its siblings, so it only
+			// needs to be ranked when categories that are not in place yet
+			// precede it. It is then ranked right after the previous node.
+			rank := 0
+			if previous != "" {
+				rank = r.index(previous, nil) + 1
+			}
+			previous = id
+
+			c := r.categories[id]
+			input := categoryTreeInput{}
+			if c.Name != node.Name.ValueString() {
+				input.Name = node.Name.ValueStringPointer()
+			}
+			if c.Handle != handle {
+				input.Handle = &handle
+			}
+			if c.IsActive != node.IsActive.ValueBool() {
+				input.IsActive = node.IsActive.ValueBoolPointer()
+			}
+			if c.IsInternal != node.IsInternal.ValueBool() {
+				input.IsInternal = node.IsInternal.ValueBoolPointer()
+			}
+			if c.Parent != parent {
+				p := categoryParent(parent)
+				input.ParentCategoryID = &p
+				input.Rank = &rank
+			} else if r.index(id, deleted) != i {
+				input.Rank = &rank
+			}
+
+			if input != (categoryTreeInput{}) {
+				if err := api.updateCategory(ctx, id, input); err != nil {
+					return err
+				}
+				c.Name = node.Name.ValueString()
+				c.Handle = handle
+				c.IsActive = node.IsActive.ValueBool()
+				c.IsInternal = node.IsInternal.ValueBool()
+				if input.Rank != nil {
+					r.move(id, parent, rank)
+				}
+			}
+
+			if err := place(id, node.Children); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err := place(root, nodes)
+
+	// Categories are deleted once the categories that stay have been moved
+	// out of them, the deepest first, as a category with children cannot be
+	// deleted.
+	var removed []string
+	for handle, id := range managed {
+		if _, kept := ids[handle]; kept {
+			continue
+		}
+		if _, exists := r.categories[id]; !exists {
+			continue
+		}
+		if err != nil {
+			// Keep the categories that could not be deleted in the state.
+			ids[handle] = id
+			continue
+		}
+		removed = append(removed, id)
+	}
+	if err != nil {
+		return ids, err
+	}
+
+	sort.Slice(removed, func(i, j int) bool {
+		di, dj := r.depth(removed[i]), r.depth(removed[j])
+		if di != dj {
+			return di > dj
+		}
+		return removed[i] < removed[j]
+	})
+	for i, id := range removed {
+		if err := api.deleteCategory(ctx, id); err != nil {
+			for _, id := range removed[i:] {
+				ids[r.categories[id].Handle] = id
+			}
+			return ids, err
+		}
+		r.remove(id)
+	}
+
+	return ids, nil
+}
+
+// rootCategories returns the ids by handle of the root categories with the
+// given handles and of all the categories below them. It fails for a handle
+// of no root category.
+func (r *categoryTreeRemote) rootCategories(handles []string) (map[string]string, error) {
+	roots := map[string]string{}
+	for _, id := range r.children[""] {
+		roots[r.categories[id].Handle] = id
+	}
+
+	ids := map[string]string{}
+	var collect func(id string)
+	collect = func(id string) {
+		ids[r.categories[id].Handle] = id
+		for _, child := range r.children[id] {
+			collect(child)
+		}
+	}
+	for _, handle := range handles {
+		id, ok := roots[handle]
+		if !ok {
+			return nil, fmt.Errorf("no root category has the handle %q", handle)
+		}
+		collect(id)
+	}
+	return ids, nil
+}
+
+// tree returns the categories in managed below root as nodes of at most
+// depth levels, with their ids by handle. Categories that were moved out of
+// the tree in Medusa are added to the top level, so the plan moves them back.
+// With a nil managed, all categories below root are returned, as on import.
+// The handles of the categories below the deepest level are returned apart,
+// and are left out of the nodes and ids, so they are never reconciled.
+func (r *categoryTreeRemote) tree(root string, managed map[string]string, depth int) ([]categoryTreeNode, map[string]string, []string) {
+	ids := map[string]string{}
+	var tooDeep []string
+
+	inTree := map[string]bool{}
+	if managed == nil {
+		var collect func(parent string)
+		collect = func(parent string) {
+			for _, id := range r.children[parent] {
+				inTree[id] = true
+				collect(id)
+			}
+		}
+		collect(root)
+	} else {
+		for _, id := range managed {
+			if _, exists := r.categories[id]; exists {
+				inTree[id] = true
+			}
+		}
+	}
+
+	var build func(parent string, level int) []categoryTreeNode
+	build = func(parent string, level int) []categoryTreeNode {
+		var nodes []categoryTreeNode
+		for _, id := range r.children[parent] {
+			if !inTree[id] {
+				continue
+			}
+			c := r.categories[id]
+			if level > depth {
+				tooDeep = append(tooDeep, c.Handle)
+				continue
+			}
+			ids[c.Handle] = id
+			nodes = append(nodes, categoryTreeNode{
+				Name:       types.StringValue(c.Name),
+				Handle:     types.StringValue(c.Handle),
+				IsActive:   types.BoolValue(c.IsActive),
+				IsInternal: types.BoolValue(c.IsInternal),
+				Children:   build(id, level+1),
+			})
+		}
+		return nodes
+	}
+
+	nodes := build(root, 1)
+
+	// Categories whose parent is not in the tree were moved out of it.
+	var moved []string
+	for id := range inTree {
+		c := r.categories[id]
+		if _, found := ids[c.Handle]; !found && !inTree[c.Parent] {
+			moved = append(moved, id)
+		}
+	}
+	sort.Strings(moved)
+	for _, id := range moved {
+		c := r.categories[id]
+		ids[c.Handle] = id
+		nodes = append(nodes, categoryTreeNode{
+			Name:       types.StringValue(c.Name),
+			Handle:     types.StringValue(c.Handle),
+			IsActive:   types.BoolValue(c.IsActive),
+			IsInternal: types.BoolValue(c.IsInternal),
+			Children:   build(id, 2),
+		})
+	}
+	sort.Strings(tooDeep)
+
+	return nodes, ids, tooDeep
+}
@@ -0,0 +1,324 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+)
+
+// countingCategoryTreeAPI records the requests made to the API it wraps.
+type countingCategoryTreeAPI struct {
+	api   categoryTreeAPI
+	calls []string
+}
+
+func (a *countingCategoryTreeAPI) createCategory(ctx context.Context, input categoryTreeInput) (string, error) {
+	a.calls = append(a.calls, "create "+*input.Handle)
+	return a.api.createCategory(ctx, input)
+}
+
+func (a *countingCategoryTreeAPI) updateCategory(ctx context.Context, id string, input categoryTreeInput) error {
+	a.calls = append(a.calls, "update "+id)
+	return a.api.updateCategory(ctx, id, input)
+}
+
+func (a *countingCategoryTreeAPI) deleteCategory(ctx context.Context, id string) error {
+	a.calls = append(a.calls, "delete "+id)
+	return a.api.deleteCategory(ctx, id)
+}
+
+// testCategoryTree parses a tree written as handles, with the children of a
+// category in parentheses, such as "shoes(boots sneakers) bags". The name of
+// a category is its handle, unless it is given as handle=name.
+func testCategoryTree(t *testing.T, tree string) []categoryTreeNode {
+	t.Helper()
+
+	tokens := strings.Fields(strings.NewReplacer("(", " ( ", ")", " ) ").Replace(tree))
+	var parse func() []categoryTreeNode
+	parse = func() []categoryTreeNode {
+		var nodes []categoryTreeNode
+		for len(tokens) > 0 {
+			token := tokens[0]
+			tokens = tokens[1:]
+			switch token {
+			case "(":
+				if len(nodes) == 0 {
+					t.Fatalf("children without a parent in %q", tree)
+				}
+				nodes[len(nodes)-1].Children = parse()
+			case ")":
+				return nodes
+			default:
+				handle, name, found := strings.Cut(token, "=")
+				if !found {
+					name = handle
+				}
+				nodes = append(nodes, categoryTreeNode{
+					Name:       types.StringValue(name),
+					Handle:     types.StringValue(handle),
+					IsActive:   types.BoolValue(false),
+					IsInternal: types.BoolValue(false),
+				})
+			}
+		}
+		return nodes
+	}
+	return parse()
+}
+
+// formatCategoryTree formats nodes the way testCategoryTree parses them.
+func formatCategoryTree(nodes []categoryTreeNode) string {
+	var parts []string
+	for _, node := range nodes {
+		part := node.Handle.ValueString()
+		if node.Name.ValueString() != part {
+			part += "=" + node.Name.ValueString()
+		}
+		if len(node.Children) > 0 {
+			part += "(" + formatCategoryTree(node.Children) + ")"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestCategoryTreeReconcile(t *testing.T) {
+	tests := []struct {
+		name      string
+		prior     string
+		plan      string
+		wantCalls []string
+	}{
+		{
+			name:      "create",
+			plan:      "shoes(boots sneakers) bags",
+			wantCalls: []string{"create shoes", "create boots", "create sneakers", "create bags"},
+		},
+		{
+			name:  "unchanged",
+			prior: "shoes(boots sneakers) bags",
+			plan:  "shoes(boots sneakers) bags",
+		},
+		{
+			name:      "renamed",
+			prior:     "shoes(boots sneakers) bags",
+			plan:      "shoes(boots=Winter-boots sneakers) bags",
+			wantCalls: []string{"update boots"},
+		},
+		{
+			name:      "reranked",
+			prior:     "shoes(boots sneakers sandals) bags",
+			plan:      "shoes(sandals boots sneakers) bags",
+			wantCalls: []string{"update sandals"},
+		},
+		{
+			name:      "moved",
+			prior:     "shoes(boots sneakers) bags",
+			plan:      "shoes(boots) bags(sneakers)",
+			wantCalls: []string{"update sneakers"},
+		},
+		{
+			name:      "moved to the root",
+			prior:     "shoes(boots sneakers) bags",
+			plan:      "shoes(boots) sneakers bags",
+			wantCalls: []string{"update sneakers"},
+		},
+		{
+			name:      "parent and child swapped",
+			prior:     "shoes(boots)",
+			plan:      "boots(shoes)",
+			wantCalls: []string{"update boots", "update shoes"},
+		},
+		{
+			name:      "added before existing siblings",
+			prior:     "shoes(boots)",
+			plan:      "shoes(sandals boots)",
+			wantCalls: []string{"create sandals", "update sandals"},
+		},
+		{
+			name:      "added and deleted",
+			prior:     "shoes(boots sneakers)",
+			plan:      "shoes(sandals sneakers)",
+			wantCalls: []string{"create sandals", "update sandals", "delete boots"},
+		},
+		{
+			name:      "deleted with children",
+			prior:     "shoes(boots(hiking) sneakers) bags",
+			plan:      "shoes(sneakers) bags",
+			wantCalls: []string{"delete hiking", "delete boots"},
+		},
+		{
+			name:      "child kept from a deleted category",
+			prior:     "shoes(boots(hiking)) bags",
+			plan:      "shoes bags(hiking)",
+			wantCalls: []string{"update hiking", "delete boots"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &productCategoryTreeResource{client: utils.NewClient(newFakeTestAccHarness(t).client(t), "", nil)}
+
+			// The tree is below catalog, next to a category that must be
+			// left alone.
+			root, err := r.createCategory(ctx, categoryTreeInput{Name: ptr("catalog"), Handle: ptr("catalog")})
+			if err != nil {
+				t.Fatal(err)
+			}
+			outside, err := r.createCategory(ctx, categoryTreeInput{Name: ptr("outside"), Handle: ptr("outside")})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			remote, err := r.listCategories(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			managed, err := remote.reconcile(ctx, r, root, testCategoryTree(t, tt.prior), map[string]string{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Requests show the id of a category as its handle.
+			handles := map[string]string{}
+			for handle, id := range managed {
+				handles[id] = handle
+			}
+
+			api := &countingCategoryTreeAPI{api: r}
+			remote, err = r.listCategories(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids, err := remote.reconcile(ctx, api, root, testCategoryTree(t, tt.plan), managed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for handle, id := range ids {
+				handles[id] = handle
+			}
+
+			var calls []string
+			for _, call := range api.calls {
+				verb, id, _ := strings.Cut(call, " ")
+				if handle, ok := handles[id]; ok {
+					id = handle
+				}
+				calls = append(calls, verb+" "+id)
+			}
+			if fmt.Sprint(calls) != fmt.Sprint(tt.wantCalls) {
+				t.Errorf("expected the requests %v, got %v", tt.wantCalls, calls)
+			}
+
+			remote, err = r.listCategories(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			nodes, read, _ := remote.tree(root, ids, categoryTreeDepth)
+			if got := formatCategoryTree(nodes); got != tt.plan {
+				t.Errorf("expected the tree %q, got %q", tt.plan, got)
+			}
+			if !reflect.DeepEqual(read, ids) {
+				t.Errorf("expected the ids %v, got %v", ids, read)
+			}
+			if c, ok := remote.categories[outside]; !ok || c.Parent != "" {
+				t.Errorf("expected the category outside of the tree to be kept, got %+v", c)
+			}
+		})
+	}
+}
+
+func TestCategoryTreeReadMovedOut(t *testing.T) {
+	ctx := context.Background()
+	r := &productCategoryTreeResource{client: utils.NewClient(newFakeTestAccHarness(t).client(t), "", nil)}
+
+	remote, err := r.listCategories(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err := remote.reconcile(ctx, r, "", testCategoryTree(t, "shoes(boots) bags"), map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Move boots below a category that is not part of the tree.
+	outside, err := r.createCategory(ctx, categoryTreeInput{Name: ptr("outside"), Handle: ptr("outside")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parent := categoryParent(outside)
+	if err := r.updateCategory(ctx, ids["boots"], categoryTreeInput{ParentCategoryID: &parent}); err != nil {
+		t.Fatal(err)
+	}
+
+	remote, err = r.listCategories(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodes, read, _ := remote.tree("", ids, categoryTreeDepth)
+	if got := formatCategoryTree(nodes); got != "shoes bags boots" {
+		t.Errorf("expected the moved category at the top level, got %q", got)
+	}
+	if !reflect.DeepEqual(read, ids) {
+		t.Errorf("expected the ids %v, got %v", ids, read)
+	}
+
+	// Without managed ids, as on import, all root categories are read.
+	nodes, _, _ = remote.tree("", nil, categoryTreeDepth)
+	if got := formatCategoryTree(nodes); got != "shoes bags outside(boots)" {
+		t.Errorf("expected all categories, got %q", got)
+	}
+}
+
+// A tree deeper than the schema cannot be held in the state. The categories
+// below the deepest level are left out of the ids, and Read fails instead of
+// planning to delete them.
+func TestCategoryTreeReadTooDeep(t *testing.T) {
+	ctx := context.Background()
+	r := &productCategoryTreeResource{client: utils.NewClient(newFakeTestAccHarness(t).client(t), "", nil)}
+
+	remote, err := r.listCategories(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err := remote.reconcile(ctx, r, "", testCategoryTree(t, "l1(l2(l3(l4(l5(l6)))))"), map[string]string{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	remote, err = r.listCategories(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, managed := range []map[string]string{ids, nil} {
+		nodes, read, tooDeep := remote.tree("", managed, categoryTreeDepth)
+		if got := formatCategoryTree(nodes); got != "l1(l2(l3(l4(l5))))" {
+			t.Errorf("expected the tree down to the deepest level, got %q", got)
+		}
+		if _, found := read["l6"]; found || len(read) != categoryTreeDepth {
+			t.Errorf("expected the ids without l6, got %v", read)
+		}
+		if fmt.Sprint(tooDeep) != "[l6]" {
+			t.Errorf("expected l6 to be too deep, got %v", tooDeep)
+		}
+	}
+
+	s := testResourceSchema(t, r)
+	state := testState(t, s, nil)
+	if diags := state.SetAttribute(ctx, path.Root("id"), categoryTreeRootID); diags.HasError() {
+		t.Fatal(diags)
+	}
+	resp := fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics[0].Detail(), "l6") {
+		t.Errorf("expected an error naming l6, got %v", resp.Diagnostics)
+	}
+}
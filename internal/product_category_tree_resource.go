@@ -0,0 +1,566 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+	"github.com/ikhvost/terraform-provider-medusa/internal/validators"
+)
+
+// categoryTreeDepth is the number of levels of categories a tree can hold.
+// Terraform schemas cannot be recursive, so every level is a nested block.
+const categoryTreeDepth = 5
+
+// categoryTreeRootID is the id of a tree at the root of the categories.
+const categoryTreeRootID = "root"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &productCategoryTreeResource{}
+	_ resource.ResourceWithConfigure      = &productCategoryTreeResource{}
+	_ resource.ResourceWithImportState    = &productCategoryTreeResource{}
+	_ resource.ResourceWithModifyPlan     = &productCategoryTreeResource{}
+	_ resource.ResourceWithValidateConfig = &productCategoryTreeResource{}
+)
+
+// NewProductCategoryTreeResource is a helper function to simplify the provider implementation.
+func NewProductCategoryTreeResource() resource.Resource {
+	return &productCategoryTreeResource{}
+}
+
+// productCategoryTreeResource is the resource implementation.
+type productCategoryTreeResource struct {
+	client utils.Client
+}
+
+// Metadata returns the data source type name.
+func (r *productCategoryTreeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product_category_tree"
+}
+
+// Schema defines the schema for the data source.
+func (r *productCategoryTreeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A tree of product categories, managed as a whole. Categories are identified by their handle, " +
+			"so changing the place of a block moves the category, and the order of the blocks is the rank of the categories among their siblings. " +
+			"Categories that are not in the tree are left untouched, and are ranked after the categories of the tree. " +
+			fmt.Sprintf("The tree can be %d levels deep, and reading or importing a deeper tree fails.", categoryTreeDepth),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: fmt.Sprintf("The id of the parent category of the tree, or %q for a tree at the root.", categoryTreeRootID),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent_category_id": schema.StringAttribute{
+				Description: "The id of the category the tree is placed under. When not set, the categories of the top level are root categories.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ids": schema.MapAttribute{
+				Description: "The ids of the categories of the tree by handle.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"category": categoryTreeBlock(categoryTreeDepth),
+		},
+	}
+}
+
+// categoryTreeBlock returns the block of a level of categories, with the
+// levels below it.
+func categoryTreeBlock(depth int) schema.ListNestedBlock {
+	block := schema.ListNestedBlock{
+		Description: "A category of the tree. The order of the blocks is the rank of the categories.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "The name of the product category.",
+					Required:    true,
+				},
+				"handle": schema.StringAttribute{
+					Description: "The handle of the product category, which identifies it in the tree. " +
+						"It must be unique, and a new handle must consist of lower case letters and digits, in words separated by single hyphens.",
+					Required: true,
+				},
+				"is_active": schema.BoolAttribute{
+					Description: "If set to false, the product category will not be available in the storefront. Defaults to false.",
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+				},
+				"is_internal": schema.BoolAttribute{
+					Description: "If set to true, the product category will only be available to admins. Defaults to false.",
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+				},
+			},
+		},
+	}
+	if depth > 1 {
+		child := categoryTreeBlock(depth - 1)
+		child.Description = "A child category. The order of the blocks is the rank of the categories."
+		block.NestedObject.Blocks = map[string]schema.Block{
+			"children": child,
+		}
+	}
+	return block
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *productCategoryTreeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, d := utils.GetClient(req.ProviderData)
+	if d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *productCategoryTreeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan productCategoryTreeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(categoryTreeID(plan.ParentCategoryID))
+	r.reconcile(ctx, plan, map[string]string{}, &resp.State, &resp.Diagnostics)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *productCategoryTreeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state productCategoryTreeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A tree imported by the id of its parent manages all categories below
+	// it.
+	var managed map[string]string
+	if !state.IDs.IsNull() {
+		managed = map[string]string{}
+		resp.Diagnostics.Append(state.IDs.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	remote, err := r.listCategories(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Product Category Tree",
+			"Could not read the categories of the tree "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	root := state.ParentCategoryID.ValueString()
+	if _, exists := remote.categories[root]; root != "" && !exists {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	nodes, ids, tooDeep := remote.tree(root, managed, categoryTreeDepth)
+	if len(tooDeep) > 0 {
+		resp.Diagnostics.AddError(
+			"Error reading Product Category Tree",
+			fmt.Sprintf("The categories of the tree %s are more than %d levels deep, which the tree cannot hold. "+
+				"Move these categories up in Medusa, or manage them with medusa_product_category instead: %s",
+				state.ID.ValueString(), categoryTreeDepth, strings.Join(tooDeep, ", ")),
+		)
+		return
+	}
+	resp.Diagnostics.Append(state.setTree(ctx, nodes, ids)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *productCategoryTreeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan productCategoryTreeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from state, to know the categories of the tree
+	var state productCategoryTreeResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := map[string]string{}
+	resp.Diagnostics.Append(state.IDs.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, plan, managed, &resp.State, &resp.Diagnostics)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *productCategoryTreeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state productCategoryTreeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := map[string]string{}
+	resp.Diagnostics.Append(state.IDs.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remote, err := r.listCategories(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting product_category_tree",
+			"Could not list the categories of the tree, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ids, err := remote.reconcile(ctx, r, state.ParentCategoryID.ValueString(), nil, managed)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting product_category_tree",
+			"Could not delete the categories of the tree, unexpected error: "+err.Error(),
+		)
+
+		// Keep the categories that could not be deleted in the state.
+		nodes, ids, _ := remote.tree(state.ParentCategoryID.ValueString(), ids, categoryTreeDepth)
+		resp.Diagnostics.Append(state.setTree(ctx, nodes, ids)...)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	}
+}
+
+// ImportState imports the categories below a category by its id. All the
+// categories below it become part of the tree. The root categories are
+// shared by the whole store, so a tree at the root is imported as
+// root:<handle>,<handle>, and only holds the root categories with these
+// handles and the categories below them.
+func (r *productCategoryTreeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	list, isRoot := strings.CutPrefix(req.ID, categoryTreeRootID)
+	if !isRoot || (list != "" && !strings.HasPrefix(list, ":")) {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_category_id"), req.ID)...)
+		return
+	}
+
+	var handles []string
+	for _, handle := range strings.Split(strings.TrimPrefix(list, ":"), ",") {
+		if handle = strings.TrimSpace(handle); handle != "" {
+			handles = append(handles, handle)
+		}
+	}
+	if len(handles) == 0 {
+		resp.Diagnostics.AddError(
+			"Invalid import id",
+			fmt.Sprintf("A tree at the root is imported with the handles of the root categories it holds, as %s:<handle>,<handle>. "+
+				"Destroying the tree deletes its categories, so it does not take over all the root categories of the store.", categoryTreeRootID),
+		)
+		return
+	}
+
+	remote, err := r.listCategories(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing product_category_tree",
+			"Could not list the categories, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	ids, err := remote.rootCategories(handles)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing product_category_tree",
+			"Could not find the categories of the tree: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), categoryTreeRootID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ids"), ids)...)
+}
+
+// ValidateConfig checks that the handles of the tree are unique.
+func (r *productCategoryTreeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var categories types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("category"), &categories)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, handle := range categoryTreeHandles(categoryTreeNodes(categories)) {
+		if handle.IsNull() || handle.IsUnknown() {
+			continue
+		}
+		if seen[handle.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("category"),
+				"Duplicate handle",
+				fmt.Sprintf("The handle %q is used by more than one category of the tree. Handles must be unique.", handle.ValueString()),
+			)
+		}
+		seen[handle.ValueString()] = true
+	}
+}
+
+// ModifyPlan keeps the ids of the categories when the tree keeps the same
+// handles, and checks that the handles of new categories are URL safe and
+// not used by categories outside of the tree.
+func (r *productCategoryTreeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var categories types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("category"), &categories)...)
+	if resp.Diagnostics.HasError() || categories.IsUnknown() {
+		return
+	}
+
+	var handles []string
+	for _, handle := range categoryTreeHandles(categoryTreeNodes(categories)) {
+		if handle.IsUnknown() {
+			return
+		}
+		handles = append(handles, handle.ValueString())
+	}
+
+	managed := map[string]string{}
+	if !req.State.Raw.IsNull() {
+		var ids types.Map
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ids"), &ids)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(ids.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var added []string
+	for _, handle := range handles {
+		if _, ok := managed[handle]; !ok {
+			added = append(added, handle)
+		}
+	}
+
+	if len(added) == 0 && len(handles) == len(managed) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ids"), managed)...)
+		return
+	}
+
+	sort.Strings(added)
+	for _, handle := range added {
+		if !validators.IsHandle(handle) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("category"),
+				"Invalid handle",
+				fmt.Sprintf("The handle %q must be %s.", handle, validators.HandleFormat),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The client is not configured yet when the provider configuration is
+	// unknown.
+	if r.client == nil {
+		return
+	}
+
+	for _, handle := range added {
+		candidates, err := findCategoriesByHandle(ctx, r.client, handle)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error planning product category tree",
+				fmt.Sprintf("Could not look up the product category with handle %q, unexpected error: %s", handle, err.Error()),
+			)
+			return
+		}
+		for _, c := range candidates {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("category"),
+				"Handle already in use",
+				fmt.Sprintf("The handle %q is already used by the product category %s (%s), which is not part of the tree. "+
+					"Handles must be unique, so choose another handle, or remove the category from Medusa or from its other resource first.",
+					handle, c.Label, c.ID),
+			)
+		}
+	}
+}
+
+// reconcile makes the categories of Medusa match the plan, and sets the
+// state. When a request fails, the state holds the categories as far as they
+// were reconciled.
+func (r *productCategoryTreeResource) reconcile(ctx context.Context, plan productCategoryTreeResourceModel, managed map[string]string, state *tfsdk.State, diags *diag.Diagnostics) {
+	remote, err := r.listCategories(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error updating product_category_tree",
+			"Could not list the categories of the tree, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	root := plan.ParentCategoryID.ValueString()
+	ids, err := remote.reconcile(ctx, r, root, categoryTreeNodes(plan.Category), managed)
+	if err != nil {
+		diags.AddError(
+			"Error updating product_category_tree",
+			"Could not update the categories of the tree, unexpected error: "+err.Error(),
+		)
+
+		nodes, ids, _ := remote.tree(root, ids, categoryTreeDepth)
+		diags.Append(plan.setTree(ctx, nodes, ids)...)
+		diags.Append(state.Set(ctx, &plan)...)
+		return
+	}
+
+	idMap, d := types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	plan.IDs = idMap
+	diags.Append(state.Set(ctx, &plan)...)
+}
+
+// setTree sets the categories and their ids. The type of the categories,
+// which depends on the depth of the schema, is taken from the current value.
+func (m *productCategoryTreeResourceModel) setTree(ctx context.Context, nodes []categoryTreeNode, ids map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var d diag.Diagnostics
+	m.Category, d = categoryTreeList(types.ListType{ElemType: m.Category.ElementType(ctx)}, nodes)
+	diags.Append(d...)
+	m.IDs, d = types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	return diags
+}
+
+func categoryTreeID(parent types.String) string {
+	if parent.IsNull() || parent.ValueString() == "" {
+		return categoryTreeRootID
+	}
+	return parent.ValueString()
+}
+
+// listCategories returns all categories of Medusa.
+func (r *productCategoryTreeResource) listCategories(ctx context.Context) (*categoryTreeRemote, error) {
+	var categories []medusa.ProductCategory
+	for offset := 0; ; offset += importPageSize {
+		limit := importPageSize
+		content, err := r.client.GetProductCategoriesWithResponse(ctx, &medusa.GetProductCategoriesParams{Offset: &offset, Limit: &limit})
+		if err != nil {
+			return nil, err
+		}
+		if content.JSON200 == nil {
+			return nil, listError(content.StatusCode(), content.Body)
+		}
+
+		categories = append(categories, content.JSON200.ProductCategories...)
+		if offset+importPageSize >= content.JSON200.Count {
+			return newCategoryTreeRemote(categories), nil
+		}
+	}
+}
+
+func (r *productCategoryTreeResource) createCategory(ctx context.Context, input categoryTreeInput) (string, error) {
+	body, err := utils.JSONBody(input)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := r.client.PostProductCategoriesWithBodyWithResponse(ctx, nil, "application/json", body)
+	if err != nil {
+		return "", err
+	}
+	if content.JSON200 == nil {
+		return "", fmt.Errorf("could not create category %s, status code: %d (%s)", *input.Handle, content.StatusCode(), content.Body)
+	}
+
+	tflog.Debug(ctx, "Created product category", map[string]any{"id": content.JSON200.ProductCategory.Id, "handle": *input.Handle})
+	return content.JSON200.ProductCategory.Id, nil
+}
+
+func (r *productCategoryTreeResource) updateCategory(ctx context.Context, id string, input categoryTreeInput) error {
+	body, err := utils.JSONBody(input)
+	if err != nil {
+		return err
+	}
+
+	content, err := r.client.PostProductCategoriesCategoryWithBodyWithResponse(ctx, id, nil, "application/json", body)
+	if err != nil {
+		return err
+	}
+	if content.JSON200 == nil {
+		return fmt.Errorf("could not update category %s, status code: %d (%s)", id, content.StatusCode(), content.Body)
+	}
+
+	tflog.Debug(ctx, "Updated product category", map[string]any{"id": id})
+	return nil
+}
+
+func (r *productCategoryTreeResource) deleteCategory(ctx context.Context, id string) error {
+	content, err := r.client.DeleteProductCategoriesCategoryWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	if content.StatusCode() != http.StatusOK && content.StatusCode() != http.StatusNotFound {
+		return fmt.Errorf("could not delete category %s, status code: %d (%s)", id, content.StatusCode(), content.Body)
+	}
+
+	tflog.Debug(ctx, "Deleted product category", map[string]any{"id": id})
+	return nil
+}
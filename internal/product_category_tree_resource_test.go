@@ -0,0 +1,169 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// The tree is placed below a category of the test, so that importing it
+// does not adopt the other categories of the store.
+func TestAccProductCategoryTreeResource(t *testing.T) {
+	h := newTestAccHarness(t)
+
+	config := func(tree string) string {
+		return h.providerConfig() + `
+resource "medusa_product_category" "parent" {
+  name   = "tf-acc-category-tree"
+  handle = "tf-acc-category-tree"
+}
+
+resource "medusa_product_category_tree" "test" {
+  parent_category_id = medusa_product_category.parent.id
+` + tree + `}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		CheckDestroy:             testAccCheckCategoryTreeDestroyed(t, h),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+  category {
+    name   = "tf-acc-tree-shoes"
+    handle = "tf-acc-tree-shoes"
+
+    children {
+      name      = "tf-acc-tree-boots"
+      handle    = "tf-acc-tree-boots"
+      is_active = true
+    }
+
+    children {
+      name   = "tf-acc-tree-sneakers"
+      handle = "tf-acc-tree-sneakers"
+    }
+  }
+
+  category {
+    name   = "tf-acc-tree-bags"
+    handle = "tf-acc-tree-bags"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"medusa_product_category_tree.test", "id",
+						"medusa_product_category.parent", "id",
+					),
+					resource.TestCheckResourceAttr("medusa_product_category_tree.test", "ids.%", "4"),
+					resource.TestCheckResourceAttr("medusa_product_category_tree.test", "category.0.children.0.handle", "tf-acc-tree-boots"),
+					resource.TestCheckResourceAttr("medusa_product_category_tree.test", "category.0.children.0.is_active", "true"),
+					resource.TestCheckResourceAttr("medusa_product_category_tree.test", "category.0.children.1.is_internal", "false"),
+					resource.TestCheckResourceAttr("medusa_product_category_tree.test", "category.1.handle", "tf-acc-tree-bags"),
+				),
+			},
+			{
+				ResourceName:      "medusa_product_category_tree.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: config(`
+  category {
+    name   = "tf-acc-tree-bags"
+    handle = "tf-acc-tree-bags"
+
+    children {
+      name   = "tf-acc-tree-sneakers"
+      handle = "tf-acc-tree-sneakers"
+    }
+  }
+
+  category {
+    name   = "tf-acc-tree-footwear"
+    handle = "tf-acc-tree-shoes"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("medusa_product_category_tree.test", "ids.%", "3"),
+					resource.TestCheckNoResourceAttr("medusa_product_category_tree.test", "ids.tf-acc-tree-boots"),
+					resource.TestCheckResourceAttr("medusa_product_category_tree.test", "category.0.handle", "tf-acc-tree-bags"),
+					resource.TestCheckResourceAttr("medusa_product_category_tree.test", "category.0.children.0.handle", "tf-acc-tree-sneakers"),
+					resource.TestCheckResourceAttr("medusa_product_category_tree.test", "category.1.name", "tf-acc-tree-footwear"),
+				),
+			},
+			{
+				Config: config(`
+  category {
+    name   = "tf-acc-tree-bags"
+    handle = "tf-acc-tree-bags"
+  }
+
+  category {
+    name   = "tf-acc-tree-sneakers"
+    handle = "tf-acc-tree-bags"
+  }
+`),
+				ExpectError: regexp.MustCompile(`Duplicate handle`),
+			},
+			{
+				Config: config(`
+  category {
+    name   = "tf-acc-tree-bags"
+    handle = "tf-acc-tree-bags"
+  }
+
+  category {
+    name   = "tf-acc-tree-totes"
+    handle = "tf_acc_tree_Totes"
+  }
+`),
+				ExpectError: regexp.MustCompile(`must be a handle of lower case letters`),
+			},
+			{
+				Config: config(`
+  category {
+    name   = "tf-acc-tree-bags"
+    handle = "tf-acc-tree-bags"
+  }
+`),
+				Check: resource.TestCheckResourceAttr("medusa_product_category_tree.test", "ids.%", "1"),
+			},
+		},
+	})
+}
+
+// testAccCheckCategoryTreeDestroyed verifies that the categories of every
+// tree are gone.
+func testAccCheckCategoryTreeDestroyed(t *testing.T, h *testAccHarness) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := h.client(t)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "medusa_product_category_tree" {
+				continue
+			}
+
+			for key, id := range rs.Primary.Attributes {
+				if !regexp.MustCompile(`^ids\.[^%]`).MatchString(key) {
+					continue
+				}
+				res, err := client.GetProductCategoriesCategoryWithResponse(context.Background(), id, nil)
+				if err != nil {
+					return err
+				}
+				if res.StatusCode() != http.StatusNotFound {
+					return fmt.Errorf("category %s of the tree %s still exists (status %d)", id, rs.Primary.ID, res.StatusCode())
+				}
+			}
+		}
+
+		return nil
+	}
+}
@@ -5,26 +5,30 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
 )
 
 // productCollectionResourceModel maps the resource schema data.
 type productCollectionResourceModel struct {
-	ID     types.String `tfsdk:"id"`
-	Title  types.String `tfsdk:"title"`
-	Handle types.String `tfsdk:"handle"`
+	ID       types.String            `tfsdk:"id"`
+	Title    types.String            `tfsdk:"title"`
+	Handle   types.String            `tfsdk:"handle"`
+	Metadata map[string]types.String `tfsdk:"metadata"`
 }
 
 func (m *productCollectionResourceModel) toCreateInput() medusa.AdminPostCollectionsReq {
 	return medusa.AdminPostCollectionsReq{
-		Title:  m.Title.ValueString(),
-		Handle: m.Handle.ValueStringPointer(),
+		Title:    m.Title.ValueString(),
+		Handle:   utils.ConvertToPointerString(m.Handle),
+		Metadata: utils.ConvertToMetadata(m.Metadata),
 	}
 }
 
-func (m *productCollectionResourceModel) toUpdateInput() medusa.AdminPostCollectionsCollectionReq {
+func (m *productCollectionResourceModel) toUpdateInput(prior *productCollectionResourceModel) medusa.AdminPostCollectionsCollectionReq {
 	return medusa.AdminPostCollectionsCollectionReq{
-		Title:  m.Title.ValueStringPointer(),
-		Handle: m.Handle.ValueStringPointer(),
+		Title:    utils.ChangedString(m.Title, prior.Title),
+		Handle:   utils.ChangedString(m.Handle, prior.Handle),
+		Metadata: utils.ConvertToMetadataUpdate(m.Metadata, prior.Metadata),
 	}
 }
 
@@ -36,6 +40,7 @@ func (m *productCollectionResourceModel) fromRemote(c *medusa.AdminCollectionsRe
 	m.ID = types.StringValue(c.Collection.Id)
 	m.Title = types.StringValue(c.Collection.Title)
 	m.Handle = types.StringPointerValue(c.Collection.Handle)
+	m.Metadata = utils.ConvertToTerraformMetadata(m.Metadata, c.Collection.Metadata)
 
 	return nil
 }
@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProductCollectionModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	tests := []struct {
+		name   string
+		create productCollectionResourceModel
+		update productCollectionResourceModel
+	}{
+		{
+			name:   "title and handle",
+			create: productCollectionResourceModel{Title: types.StringValue("Summer"), Handle: types.StringValue("summer")},
+			update: productCollectionResourceModel{Title: types.StringValue("Summer Sale"), Handle: types.StringValue("summer-sale")},
+		},
+		{
+			name:   "metadata",
+			create: productCollectionResourceModel{Title: types.StringValue("Winter"), Handle: types.StringValue("winter"), Metadata: testMetadata("erp_id", "42", "channel", "web")},
+			update: productCollectionResourceModel{Title: types.StringValue("Winter Sale"), Handle: types.StringValue("winter-sale"), Metadata: testMetadata("erp_id", "43")},
+		},
+		{
+			name:   "title only change",
+			create: productCollectionResourceModel{Title: types.StringValue("Winter"), Handle: types.StringValue("winter-2024")},
+			update: productCollectionResourceModel{Title: types.StringValue("Winter 2024"), Handle: types.StringValue("winter-2024")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created, err := client.PostCollectionsWithResponse(ctx, tt.create.toCreateInput())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			state := productCollectionResourceModel{Metadata: tt.create.Metadata}
+			if err := state.fromRemote(created.JSON200); err != nil {
+				t.Fatalf("%s: %s", err, created.Body)
+			}
+			tt.create.ID = state.ID
+			checkModel(t, tt.create, state)
+
+			prior := state
+			updated, err := client.PostCollectionsCollectionWithResponse(ctx, state.ID.ValueString(), tt.update.toUpdateInput(&prior))
+			if err != nil {
+				t.Fatal(err)
+			}
+			state = productCollectionResourceModel{Metadata: tt.update.Metadata}
+			if err := state.fromRemote(updated.JSON200); err != nil {
+				t.Fatalf("%s: %s", err, updated.Body)
+			}
+			tt.update.ID = state.ID
+			checkModel(t, tt.update, state)
+		})
+	}
+}
+
+func TestProductCollectionModelProperties(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	checkModelProperty(t, func(title, handle string) bool {
+		plan := productCollectionResourceModel{Title: quickString(title), Handle: quickString(handle)}
+
+		created, err := client.PostCollectionsWithResponse(ctx, plan.toCreateInput())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if created.JSON200 == nil {
+			// Generated handles can collide with an earlier one.
+			return true
+		}
+
+		var state productCollectionResourceModel
+		if err := state.fromRemote(created.JSON200); err != nil {
+			t.Fatal(err)
+		}
+		plan.ID = state.ID
+
+		diff := modelDiff(plan, state)
+		for _, d := range diff {
+			t.Log(d)
+		}
+		return len(diff) == 0
+	})
+}
@@ -2,17 +2,17 @@ package internal
 
 import (
 	"context"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
-
 	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                = &productCollectionResource{}
 	_ resource.ResourceWithConfigure   = &productCollectionResource{}
 	_ resource.ResourceWithImportState = &productCollectionResource{}
+	_ resource.ResourceWithModifyPlan  = &productCollectionResource{}
 )
 
 // NewProductCollectionResource is a helper function to simplify the provider implementation.
@@ -29,7 +30,7 @@ func NewProductCollectionResource() resource.Resource {
 
 // productCollectionResource is the resource implementation.
 type productCollectionResource struct {
-	client medusa.ClientWithResponsesInterface
+	client utils.Client
 }
 
 // Metadata returns the data source type name.
@@ -53,22 +54,25 @@ func (r *productCollectionResource) Schema(_ context.Context, _ resource.SchemaR
 				Description: "The title of the product collection.",
 				Required:    true,
 			},
-			"handle": schema.StringAttribute{
-				Description: "The handle of the product collection.",
-				Optional:    true,
-				Computed:    true,
-			},
+			"handle":   handleAttribute("product collection", "title"),
+			"metadata": metadataAttribute("product collection"),
 		},
 	}
 }
 
 // Configure adds the provider configured client to the data source.
-func (r *productCollectionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (r *productCollectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	r.client = utils.GetClient(req.ProviderData)
+	client, d := utils.GetClient(req.ProviderData)
+	if d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	r.client = client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -108,6 +112,8 @@ func (r *productCollectionResource) Create(ctx context.Context, req resource.Cre
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.Collection.UpdatedAt)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -121,7 +127,7 @@ func (r *productCollectionResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get refreshed value
-	content, err := r.client.GetCollectionsCollectionWithResponse(ctx, state.ID.ValueString(), nil)
+	content, err := r.client.GetCollectionsCollectionWithResponse(ctx, state.ID.ValueString())
 	if d := utils.CheckGetError("product_collection", state.ID.ValueString(), content, err); d != nil {
 		resp.Diagnostics.Append(d)
 		return
@@ -144,6 +150,8 @@ func (r *productCollectionResource) Read(ctx context.Context, req resource.ReadR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.Collection.UpdatedAt)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -156,8 +164,28 @@ func (r *productCollectionResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
+	// Retrieve values from state, to know the metadata keys to remove
+	var state productCollectionResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check that the product collection did not change since Terraform last read it
+	checkConcurrentChange(ctx, r.client, req.Private, "product collection", plan.ID.ValueString(), func() (time.Time, *diag.ErrorDiagnostic) {
+		content, err := r.client.GetCollectionsCollectionWithResponse(ctx, plan.ID.ValueString())
+		if d := utils.CheckGetError("product_collection", plan.ID.ValueString(), content, err); d != nil {
+			return time.Time{}, d
+		}
+		return content.JSON200.Collection.UpdatedAt, nil
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Generate API request body from plan
-	input := plan.toUpdateInput()
+	input := plan.toUpdateInput(&state)
 
 	content, err := r.client.PostCollectionsCollectionWithResponse(ctx, plan.ID.ValueString(), input)
 	if d := utils.CheckUpdateError("product_collection", content, err); d != nil {
@@ -182,6 +210,8 @@ func (r *productCollectionResource) Update(ctx context.Context, req resource.Upd
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.Collection.UpdatedAt)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -201,7 +231,42 @@ func (r *productCollectionResource) Delete(ctx context.Context, req resource.Del
 	}
 }
 
+// ModifyPlan checks that the planned handle is not used by another
+// product collection.
+func (r *productCollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The client is not configured yet when the provider configuration is
+	// unknown.
+	if r.client == nil {
+		return
+	}
+	checkHandle(ctx, req, resp, "product collection", r.findByHandle)
+}
+
+// ImportState imports a product collection by id, or by its handle as
+// handle:<handle>.
 func (r *productCollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importState(ctx, req, resp, "product collection", map[string]importLookup{
+		"handle": r.findByHandle,
+	})
+}
+
+func (r *productCollectionResource) findByHandle(ctx context.Context, handle string) ([]importCandidate, error) {
+	return findAll(func(offset int) ([]importCandidate, int, error) {
+		limit := importPageSize
+		content, err := r.client.GetCollectionsWithResponse(ctx, &medusa.GetCollectionsParams{Handle: &handle, Offset: &offset, Limit: &limit})
+		if err != nil {
+			return nil, 0, err
+		}
+		if content.JSON200 == nil {
+			return nil, 0, listError(content.StatusCode(), content.Body)
+		}
+
+		var matches []importCandidate
+		for _, collection := range content.JSON200.Collections {
+			if collection.Handle != nil && *collection.Handle == handle {
+				matches = append(matches, importCandidate{ID: collection.Id, Label: collection.Title})
+			}
+		}
+		return matches, content.JSON200.Count, nil
+	})
 }
@@ -0,0 +1,209 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+)
+
+func init() {
+	resource.AddTestSweepers("medusa_product_collection", &resource.Sweeper{
+		Name: "medusa_product_collection",
+		F: sweep("medusa_product_collection", listProductCollections, func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error) {
+			res, err := client.DeleteCollectionsCollectionWithResponse(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return res.StatusCode(), nil
+		}),
+	})
+}
+
+func listProductCollections(ctx context.Context, client medusa.ClientWithResponsesInterface, offset int) ([]sweepable, int, error) {
+	res, err := client.GetCollectionsWithResponse(ctx, &medusa.GetCollectionsParams{
+		Q:      ptr(testAccNamePrefix),
+		Offset: &offset,
+		Limit:  ptr(importPageSize),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if res.JSON200 == nil {
+		return nil, 0, listError(res.StatusCode(), res.Body)
+	}
+
+	var objects []sweepable
+	for _, collection := range res.JSON200.Collections {
+		objects = append(objects, sweepable{ID: collection.Id, Name: collection.Title})
+	}
+	return objects, res.JSON200.Count, nil
+}
+
+func TestAccProductCollectionResource(t *testing.T) {
+	h := newTestAccHarness(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		CheckDestroy: h.checkDestroy(t, "medusa_product_collection", func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error) {
+			content, err := client.GetCollectionsCollectionWithResponse(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return content.StatusCode(), nil
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: h.providerConfig() + `
+resource "medusa_product_collection" "test" {
+  title  = "tf-acc-collection"
+  handle = "tf-acc-collection"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("medusa_product_collection.test", "id"),
+					resource.TestCheckResourceAttr("medusa_product_collection.test", "title", "tf-acc-collection"),
+					resource.TestCheckResourceAttr("medusa_product_collection.test", "handle", "tf-acc-collection"),
+				),
+			},
+			{
+				ResourceName:      "medusa_product_collection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "medusa_product_collection.test",
+				ImportState:       true,
+				ImportStateId:     "handle:tf-acc-collection",
+				ImportStateVerify: true,
+			},
+			{
+				Config: h.providerConfig() + `
+resource "medusa_product_collection" "test" {
+  title  = "tf-acc-collection-updated"
+  handle = "tf-acc-collection-updated"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("medusa_product_collection.test", "title", "tf-acc-collection-updated"),
+					resource.TestCheckResourceAttr("medusa_product_collection.test", "handle", "tf-acc-collection-updated"),
+				),
+			},
+		},
+	})
+}
+
+// A handle left out of the configuration is generated by Medusa and kept
+// when the title changes.
+func TestAccProductCollectionResourceGeneratedHandle(t *testing.T) {
+	h := newTestAccHarness(t)
+
+	config := func(title string) string {
+		return h.providerConfig() + fmt.Sprintf(`
+resource "medusa_product_collection" "test" {
+  title = %q
+}
+`, title)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("tf-acc-collection generated"),
+				Check:  resource.TestCheckResourceAttr("medusa_product_collection.test", "handle", "tf-acc-collection-generated"),
+			},
+			{
+				Config: config("tf-acc-collection renamed"),
+				Check:  resource.TestCheckResourceAttr("medusa_product_collection.test", "handle", "tf-acc-collection-generated"),
+			},
+		},
+	})
+}
+
+// A collection created outside of Terraform with a handle that is not URL
+// safe is imported and updated with its handle, which only has to be URL
+// safe once it is changed.
+func TestAccProductCollectionResourceLegacyHandle(t *testing.T) {
+	h := newTestAccHarness(t)
+
+	config := func(title, handle string) string {
+		return h.providerConfig() + fmt.Sprintf(`
+resource "medusa_product_collection" "test" {
+  title  = %q
+  handle = %q
+}
+`, title, handle)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					res, err := h.client(t).PostCollectionsWithResponse(context.Background(), medusa.PostCollectionsJSONRequestBody{
+						Title:  "tf-acc-collection legacy",
+						Handle: ptr("tf_acc_Legacy"),
+					})
+					if err != nil || res.JSON200 == nil {
+						t.Fatalf("unable to create collection: %v", err)
+					}
+				},
+				Config:             config("tf-acc-collection legacy", "tf_acc_Legacy"),
+				ResourceName:       "medusa_product_collection.test",
+				ImportState:        true,
+				ImportStateId:      "handle:tf_acc_Legacy",
+				ImportStatePersist: true,
+			},
+			{
+				Config: config("tf-acc-collection renamed", "tf_acc_Legacy"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("medusa_product_collection.test", "title", "tf-acc-collection renamed"),
+					resource.TestCheckResourceAttr("medusa_product_collection.test", "handle", "tf_acc_Legacy"),
+				),
+			},
+			{
+				Config:      config("tf-acc-collection renamed", "tf_acc_Renamed"),
+				ExpectError: regexp.MustCompile(`must be a handle of lower case letters`),
+			},
+		},
+	})
+}
+
+// Read refreshes the collection with the values in Medusa.
+func TestProductCollectionResourceRead(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+	r := &productCollectionResource{client: utils.NewClient(client, "", nil)}
+	s := testResourceSchema(t, r)
+
+	created, err := client.PostCollectionsWithResponse(ctx, medusa.PostCollectionsJSONRequestBody{Title: "Summer"})
+	if err != nil || created.JSON200 == nil {
+		t.Fatalf("unable to create collection: %v", err)
+	}
+	id := created.JSON200.Collection.Id
+
+	state := productCollectionResourceModel{ID: types.StringValue(id), Title: types.StringValue("Winter")}
+	resp := fwresource.ReadResponse{State: testState(t, s, state)}
+	resp.Private = testPrivate(resp.Private)
+	r.Read(ctx, fwresource.ReadRequest{State: resp.State}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	var read productCollectionResourceModel
+	if diags := resp.State.Get(ctx, &read); diags.HasError() {
+		t.Fatal(diags)
+	}
+	checkModel(t, productCollectionResourceModel{
+		ID:     types.StringValue(id),
+		Title:  types.StringValue("Summer"),
+		Handle: types.StringPointerValue(created.JSON200.Collection.Handle),
+	}, read)
+}
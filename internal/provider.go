@@ -6,22 +6,22 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"strconv"
+	"time"
 
-	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
-	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/ikhvost/medusajs-go-sdk/medusa"
 	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 	"gopkg.in/dnaeon/go-vcr.v3/recorder"
 
 	types "github.com/hashicorp/terraform-plugin-framework/types"
-	basetypes "github.com/oapi-codegen/runtime/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces
@@ -31,18 +31,24 @@ var (
 
 type OptionFunc func(p *medusaProvider)
 
+// WithRetryableClient sets the number of retries used when the provider
+// configuration does not set max_retries.
 func WithRetryableClient(retries int) OptionFunc {
-	retryClient := retryablehttp.NewClient()
-	retryClient.RetryMax = retries
-
 	return func(p *medusaProvider) {
-		p.httpClient = retryClient.StandardClient()
+		p.retry.MaxRetries = retries
 	}
 }
 
+// WithDebugClient logs the HTTP traffic when debug_http is enabled in the
+// provider configuration or through the MEDUSA_DEBUG_HTTP environment variable.
 func WithDebugClient() OptionFunc {
 	return func(p *medusaProvider) {
-		p.httpClient.Transport = NewDebugTransport(p.httpClient.Transport)
+		p.transports = append(p.transports, func(innerTransport http.RoundTripper, cfg transportConfig) http.RoundTripper {
+			if !cfg.DebugHTTP {
+				return innerTransport
+			}
+			return NewDebugTransport(innerTransport, cfg.DebugBodySize)
+		})
 	}
 }
 
@@ -59,26 +65,33 @@ func WithRecorderClient(file string, mode recorder.Mode) (OptionFunc, func() err
 	//Strip all fields we are not interested in
 	hook := func(i *cassette.Interaction) error {
 		i.Response.Headers = utils.CleanHeaders(i.Response.Headers, "Content-Type")
-		i.Request.Headers = utils.CleanHeaders(i.Request.Headers)
+		i.Request.Headers = utils.CleanHeaders(i.Request.Headers, "Content-Type")
 		return nil
 	}
 	r.AddHook(hook, recorder.AfterCaptureHook)
+	// Credentials are scrubbed only when saving, as the captured response
+	// is also returned to the client.
+	r.AddHook(redactInteraction, recorder.BeforeSaveHook)
+	r.SetMatcher(matchCassetteRequest)
 
 	stop := func() error {
 		return r.Stop()
 	}
 
 	return func(p *medusaProvider) {
-		p.httpClient = r.GetDefaultClient()
+		// While recording, requests are sent through the transport built
+		// from the provider configuration.
+		p.transports = append(p.transports, func(innerTransport http.RoundTripper, _ transportConfig) http.RoundTripper {
+			r.SetRealTransport(innerTransport)
+			return r
+		})
 	}, stop
 }
 
 // New is a helper function to simplify provider server and testing implementation.
 func New(opts ...OptionFunc) provider.Provider {
-	tp := http.DefaultTransport
-
 	var p = &medusaProvider{
-		httpClient: &http.Client{Transport: tp},
+		retry: defaultRetryPolicy(),
 	}
 
 	for _, opt := range opts {
@@ -90,14 +103,168 @@ func New(opts ...OptionFunc) provider.Provider {
 
 // medusaProvider is the provider implementation.
 type medusaProvider struct {
-	httpClient *http.Client
+	// transports wrap the network transport, in the order the options
+	// were given.
+	transports []func(http.RoundTripper, transportConfig) http.RoundTripper
+	retry      retryPolicy
 }
 
 // medusaProviderModel maps provider schema data to a Go type.
 type medusaProviderModel struct {
-	URL      types.String `tfsdk:"url"`
-	Email    types.String `tfsdk:"email"`
-	Password types.String `tfsdk:"password"`
+	URL           types.String  `tfsdk:"url"`
+	Email         types.String  `tfsdk:"email"`
+	Password      types.String  `tfsdk:"password"`
+	MaxRetries    types.Int64   `tfsdk:"max_retries"`
+	RetryWaitMin  types.String  `tfsdk:"retry_wait_min"`
+	RetryWaitMax  types.String  `tfsdk:"retry_wait_max"`
+	RetryOnStatus []types.Int64 `tfsdk:"retry_on_status"`
+
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+
+	CACertPEM          types.String            `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String            `tfsdk:"ca_cert_file"`
+	ClientCert         types.String            `tfsdk:"client_cert"`
+	ClientKey          types.String            `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool              `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String            `tfsdk:"proxy_url"`
+	RequestTimeout     types.String            `tfsdk:"request_timeout"`
+	Headers            map[string]types.String `tfsdk:"headers"`
+
+	DebugHTTP            types.Bool  `tfsdk:"debug_http"`
+	DebugHTTPMaxBodySize types.Int64 `tfsdk:"debug_http_max_body_size"`
+
+	OnConcurrentChange types.String `tfsdk:"on_concurrent_change"`
+}
+
+// transportConfig returns the connection settings of the configuration.
+func (m *medusaProviderModel) transportConfig() (transportConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	cfg := transportConfig{
+		CACertPEM:          m.CACertPEM.ValueString(),
+		CACertFile:         m.CACertFile.ValueString(),
+		ClientCert:         m.ClientCert.ValueString(),
+		ClientKey:          m.ClientKey.ValueString(),
+		InsecureSkipVerify: m.InsecureSkipVerify.ValueBool(),
+		ProxyURL:           m.ProxyURL.ValueString(),
+		DebugBodySize:      defaultDebugBodySize,
+	}
+
+	if !m.DebugHTTP.IsNull() {
+		cfg.DebugHTTP = m.DebugHTTP.ValueBool()
+	} else if value := os.Getenv("MEDUSA_DEBUG_HTTP"); value != "" {
+		debug, err := strconv.ParseBool(value)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("debug_http"),
+				"Invalid Debug Configuration",
+				fmt.Sprintf("The MEDUSA_DEBUG_HTTP environment variable must be true or false, got %q.", value),
+			)
+		}
+		cfg.DebugHTTP = debug
+	}
+
+	if !m.DebugHTTPMaxBodySize.IsNull() {
+		if m.DebugHTTPMaxBodySize.ValueInt64() < 0 {
+			diags.AddAttributeError(
+				path.Root("debug_http_max_body_size"),
+				"Invalid Debug Configuration",
+				"debug_http_max_body_size must not be negative.",
+			)
+		}
+		cfg.DebugBodySize = int(m.DebugHTTPMaxBodySize.ValueInt64())
+	}
+
+	if (cfg.ClientCert == "") != (cfg.ClientKey == "") {
+		diags.AddAttributeError(
+			path.Root("client_cert"),
+			"Invalid TLS Configuration",
+			"client_cert and client_key must be set together.",
+		)
+	}
+
+	if !m.RequestTimeout.IsNull() {
+		d, err := time.ParseDuration(m.RequestTimeout.ValueString())
+		if err != nil || d <= 0 {
+			diags.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				fmt.Sprintf("request_timeout must be a positive duration such as \"30s\", got %q.",
+					m.RequestTimeout.ValueString()),
+			)
+		}
+		cfg.RequestTimeout = d
+	}
+
+	if m.Headers != nil {
+		cfg.Headers = make(map[string]string, len(m.Headers))
+		for key, value := range m.Headers {
+			cfg.Headers[key] = value.ValueString()
+		}
+	}
+
+	return cfg, diags
+}
+
+// retryPolicy returns the retry settings, starting from the defaults given
+// to the provider and overriding them with configured values.
+func (m *medusaProviderModel) retryPolicy(defaults retryPolicy) (retryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	policy := defaults
+
+	if !m.MaxRetries.IsNull() {
+		if m.MaxRetries.ValueInt64() < 0 {
+			diags.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Retry Configuration",
+				"max_retries must not be negative.",
+			)
+		}
+		policy.MaxRetries = int(m.MaxRetries.ValueInt64())
+	}
+
+	for _, wait := range []struct {
+		attr   string
+		config types.String
+		target *time.Duration
+	}{
+		{"retry_wait_min", m.RetryWaitMin, &policy.WaitMin},
+		{"retry_wait_max", m.RetryWaitMax, &policy.WaitMax},
+	} {
+		if wait.config.IsNull() {
+			continue
+		}
+		d, err := time.ParseDuration(wait.config.ValueString())
+		if err != nil || d < 0 {
+			diags.AddAttributeError(
+				path.Root(wait.attr),
+				"Invalid Retry Configuration",
+				fmt.Sprintf("%s must be a non-negative duration such as \"500ms\" or \"2s\", got %q.",
+					wait.attr, wait.config.ValueString()),
+			)
+			continue
+		}
+		*wait.target = d
+	}
+
+	if policy.WaitMin > policy.WaitMax {
+		diags.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid Retry Configuration",
+			fmt.Sprintf("retry_wait_min (%s) must not be greater than retry_wait_max (%s).",
+				policy.WaitMin, policy.WaitMax),
+		)
+	}
+
+	if m.RetryOnStatus != nil {
+		policy.RetryOnStatus = make([]int, len(m.RetryOnStatus))
+		for i, code := range m.RetryOnStatus {
+			policy.RetryOnStatus[i] = int(code.ValueInt64())
+		}
+	}
+
+	return policy, diags
 }
 
 // Metadata returns the provider type name.
@@ -124,6 +291,85 @@ func (p *medusaProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 				Required:    true,
 				Sensitive:   true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of retries of a failed request. Requests that create objects " +
+					"are only retried when the connection was refused or the API answered 429. Defaults to 10.",
+				Optional: true,
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Description: "Minimum time to wait between retries, as a duration such as \"500ms\". Defaults to \"1s\".",
+				Optional:    true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Description: "Maximum time to wait between retries, as a duration such as \"30s\". " +
+					"A Retry-After header sent with a 429 or 503 response takes precedence. Defaults to \"30s\".",
+				Optional: true,
+			},
+			"retry_on_status": schema.ListAttribute{
+				Description: "HTTP status codes that are retried. Defaults to 429, 500, 502, 503 and 504.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum number of requests per second sent to the admin API. Unlimited when not set.",
+				Optional:    true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of requests to the admin API in flight at the same time. Unlimited when not set.",
+				Optional:    true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM encoded CA certificates trusted in addition to the system roots.",
+				Optional:    true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a file with PEM encoded CA certificates trusted in addition to the system roots.",
+				Optional:    true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "PEM encoded client certificate for mutual TLS. Requires client_key.",
+				Optional:    true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM encoded private key of the client certificate.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip verification of the server certificate. Only use this for testing.",
+				Optional:    true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the proxy used for requests to the admin API. " +
+					"Defaults to the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.",
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Timeout of a single request to the admin API, as a duration such as \"30s\". No timeout when not set.",
+				Optional:    true,
+			},
+			"debug_http": schema.BoolAttribute{
+				Description: "Log requests to and responses from the admin API at TRACE level, with credentials redacted. " +
+					"Defaults to the MEDUSA_DEBUG_HTTP environment variable, which takes true or false.",
+				Optional: true,
+			},
+			"debug_http_max_body_size": schema.Int64Attribute{
+				Description: "Number of bytes of a request or response body written to the debug log. " +
+					"Zero logs the whole body. Defaults to 4096.",
+				Optional: true,
+			},
+			"on_concurrent_change": schema.StringAttribute{
+				Description: "What to do when an object changed in Medusa after Terraform last read it, " +
+					"as found on update by comparing its updated_at time. One of \"warn\", which applies the " +
+					"changed attributes and adds a warning, or \"fail\", which stops the update. Defaults to \"warn\".",
+				Optional: true,
+			},
+			"headers": schema.MapAttribute{
+				Description: "Additional headers sent with every request, for example to pass an authentication gateway.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
 		},
 	}
 }
@@ -162,6 +408,33 @@ func (p *medusaProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
+	if url == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Missing Medusa API URL",
+			"The provider cannot create the Medusa API client as there is a missing or empty value for the Medusa API URL. "+
+				"Set the url value in the configuration or use the MEDUSA_URL environment variable.",
+		)
+	}
+
+	if email == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Missing Medusa Admin Email",
+			"The provider cannot create the Medusa API client as there is a missing or empty value for the admin email. "+
+				"Set the email value in the configuration or use the MEDUSA_ADMIN_EMAIL environment variable.",
+		)
+	}
+
+	if password == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing Medusa Admin Password",
+			"The provider cannot create the Medusa API client as there is a missing or empty value for the admin password. "+
+				"Set the password value in the configuration or use the MEDUSA_ADMIN_PASSWORD environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -172,56 +445,96 @@ func (p *medusaProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "medusa_email")
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "medusa_password")
 
-	tflog.Debug(ctx, "Creating Medusa client")
+	retry, diags := config.retryPolicy(p.retry)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	client, err := medusa.NewClientWithResponses(url)
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to Create Medusa API Client", err.Error())
+	if config.RequestsPerSecond.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid Rate Limit Configuration",
+			"requests_per_second must not be negative.",
+		)
 	}
 
-	token, err := login(client, medusa.PostTokenJSONRequestBody{
-		Email:    basetypes.Email(email),
-		Password: password,
-	})
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to Login to Medusa API", err.Error())
+	if config.MaxConcurrentRequests.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid Rate Limit Configuration",
+			"max_concurrent_requests must not be negative.",
+		)
 	}
 
-	tokenProvider, err := securityprovider.NewSecurityProviderBearerToken(token)
-	if err != nil {
-		resp.Diagnostics.AddError("Unable to Create Storyblok API Client", err.Error())
+	onConcurrentChange := concurrentChangeWarn
+	if !config.OnConcurrentChange.IsNull() {
+		onConcurrentChange = config.OnConcurrentChange.ValueString()
+	}
+	if onConcurrentChange != concurrentChangeWarn && onConcurrentChange != concurrentChangeFail {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("on_concurrent_change"),
+			"Invalid Concurrent Change Configuration",
+			fmt.Sprintf("on_concurrent_change must be \"warn\" or \"fail\", got %q.", onConcurrentChange),
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	client, err = medusa.NewClientWithResponses(url, medusa.WithRequestEditorFn(tokenProvider.Intercept))
+	transportConfig, diags := config.transportConfig()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transport, err := newBaseTransport(transportConfig)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Create Medusa API Client",
-			"An unexpected error occurred when creating the Medusa API client. "+
-				"If the error is not clear, please contact the provider developers.\n\n"+
-				"Medusa Error: "+err.Error(),
-		)
+		resp.Diagnostics.AddError("Unable to Create Medusa API Client", err.Error())
 		return
 	}
 
-	// Make the Medusa client available during DataSource and Resource
-	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	for _, wrap := range p.transports {
+		transport = wrap(transport, transportConfig)
+	}
 
-	tflog.Info(ctx, "Configured Medusa client", map[string]any{"success": true})
-}
+	// Requests pass the retry client first, so that every attempt is
+	// throttled and then logged by the transports set up through the options.
+	transport = NewThrottleTransport(
+		transport,
+		config.RequestsPerSecond.ValueFloat64(),
+		int(config.MaxConcurrentRequests.ValueInt64()),
+	)
+	if retry.MaxRetries > 0 {
+		transport = NewRetryTransport(transport, retry)
+	}
+	httpClient := &http.Client{Transport: transport}
 
-func login(client *medusa.ClientWithResponses, credentials medusa.PostTokenJSONRequestBody) (string, error) {
-	resp, err := client.PostTokenWithResponse(context.Background(), credentials)
-	if err != nil {
-		return "", err
+	tflog.Debug(ctx, "Creating Medusa client")
+
+	factory := &clientFactory{httpClient: httpClient}
+	client, diags := factory.New(ctx, clientCredentials{
+		URL:      url,
+		Email:    email,
+		Password: password,
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if resp.StatusCode() != http.StatusOK {
-		return "", fmt.Errorf("login failed: %s", resp.Status())
+	// Make the Medusa client available during DataSource and Resource
+	// type Configure methods.
+	providerData := &providerClient{
+		Client:             client,
+		onConcurrentChange: onConcurrentChange,
+		regionPlans:        newRegionPlans(),
 	}
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 
-	return *resp.JSON200.AccessToken, nil
+	tflog.Info(ctx, "Configured Medusa client", map[string]any{"success": true})
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -238,6 +551,7 @@ func (p *medusaProvider) Resources(_ context.Context) []func() resource.Resource
 		NewSalesChannelResource,
 		NewCustomerGroupResource,
 		NewProductCategoryResource,
+		NewProductCategoryTreeResource,
 		NewProductCollectionResource,
 	}
 }
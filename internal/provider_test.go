@@ -0,0 +1,341 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/medusafake"
+	"gopkg.in/dnaeon/go-vcr.v3/recorder"
+)
+
+// Acceptance tests replay the cassettes in testdata/cassettes and do not
+// need a Medusa server. Tests without a cassette run against the in-memory
+// fake from the medusafake package. Run them with MEDUSA_RECORD=1 against a
+// live admin API, configured through MEDUSA_URL, MEDUSA_ADMIN_EMAIL and
+// MEDUSA_ADMIN_PASSWORD, to record the cassettes again.
+const (
+	testAccDefaultURL = "http://localhost:9000"
+	testAccNamePrefix = "tf-acc-"
+)
+
+// TestMain runs the sweepers when -sweep is set, and the tests otherwise.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+// testAccHarness connects a test case to its cassette or to a fake server.
+type testAccHarness struct {
+	provider  func() (tfprotov6.ProviderServer, error)
+	transport http.RoundTripper
+	creds     clientCredentials
+
+	// fake is the server of a test that runs against medusafake.
+	fake *medusafake.Server
+}
+
+func newTestAccHarness(t *testing.T) *testAccHarness {
+	t.Helper()
+
+	mode := recorder.ModeReplayOnly
+	if os.Getenv("MEDUSA_RECORD") == "1" {
+		mode = recorder.ModeRecordOnly
+	}
+
+	// A test without a cassette fails instead of running against another
+	// server than the one its cassette would come from.
+	cassette := filepath.Join("testdata", "cassettes", t.Name())
+	if mode == recorder.ModeReplayOnly {
+		if _, err := os.Stat(cassette + ".yaml"); errors.Is(err, os.ErrNotExist) {
+			t.Fatalf("no cassette %s.yaml to replay: capture it with MEDUSA_RECORD=1 as described in the README, "+
+				"or use newFakeTestAccHarness for a test that runs against medusafake", cassette)
+		}
+	}
+
+	option, stop := WithRecorderClient(cassette, mode)
+	t.Cleanup(func() {
+		if err := stop(); err != nil {
+			t.Errorf("unable to save cassette: %s", err)
+			return
+		}
+		if mode == recorder.ModeRecordOnly {
+			checkCassetteSecrets(t, cassette+".yaml")
+		}
+	})
+
+	// The checks talk to the API through the same cassette as the provider.
+	p := &medusaProvider{}
+	option(p)
+	transport := http.DefaultTransport
+	for _, wrap := range p.transports {
+		transport = wrap(transport, transportConfig{})
+	}
+
+	return &testAccHarness{
+		provider:  providerserver.NewProtocol6WithError(New(option)),
+		transport: transport,
+		creds:     testAccCredentials(),
+	}
+}
+
+// newFakeTestAccHarness runs the test against a fresh medusafake server.
+func newFakeTestAccHarness(t *testing.T) *testAccHarness {
+	t.Helper()
+
+	fake := medusafake.NewServer()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	return &testAccHarness{
+		provider:  providerserver.NewProtocol6WithError(New()),
+		transport: http.DefaultTransport,
+		creds: clientCredentials{
+			URL:      server.URL,
+			Email:    medusafake.Email,
+			Password: medusafake.Password,
+		},
+		fake: fake,
+	}
+}
+
+func (h *testAccHarness) providerFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"medusa": h.provider,
+	}
+}
+
+// client returns a logged in client that shares the cassette of the test.
+func (h *testAccHarness) client(t *testing.T) medusa.ClientWithResponsesInterface {
+	t.Helper()
+
+	factory := &clientFactory{httpClient: &http.Client{Transport: h.transport}}
+	client, diags := factory.New(context.Background(), h.creds)
+	if diags.HasError() {
+		t.Fatalf("unable to create client: %v", diags)
+	}
+	return client
+}
+
+// checkDestroy verifies that every resource of the given type is gone, using
+// get to fetch it by id and return the status code.
+func (h *testAccHarness) checkDestroy(t *testing.T, resourceType string, get func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := h.client(t)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			status, err := get(context.Background(), client, rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			if status != http.StatusNotFound {
+				return fmt.Errorf("%s %s still exists (status %d)", resourceType, rs.Primary.ID, status)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCredentials() clientCredentials {
+	creds := clientCredentials{
+		URL:      os.Getenv("MEDUSA_URL"),
+		Email:    os.Getenv("MEDUSA_ADMIN_EMAIL"),
+		Password: os.Getenv("MEDUSA_ADMIN_PASSWORD"),
+	}
+	if creds.URL == "" {
+		creds.URL = testAccDefaultURL
+	}
+	if creds.Email == "" {
+		creds.Email = "admin@medusa-test.com"
+	}
+	if creds.Password == "" {
+		creds.Password = "supersecret"
+	}
+	return creds
+}
+
+// providerConfig returns the provider block for the test configurations.
+func (h *testAccHarness) providerConfig() string {
+	creds := h.creds
+	return fmt.Sprintf(`
+provider "medusa" {
+  url      = %q
+  email    = %q
+  password = %q
+}
+`, creds.URL, creds.Email, creds.Password)
+}
+
+// testResourceSchema returns the schema of a resource, for the plan and
+// state fixtures of unit tests.
+func testResourceSchema(t *testing.T, r fwresource.Resource) schema.Schema {
+	t.Helper()
+
+	var resp fwresource.SchemaResponse
+	r.Schema(context.Background(), fwresource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("invalid schema: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+// testPlan returns a plan holding the model.
+func testPlan(t *testing.T, s schema.Schema, model any) tfsdk.Plan {
+	t.Helper()
+
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+	if diags := plan.Set(context.Background(), model); diags.HasError() {
+		t.Fatalf("invalid plan: %v", diags)
+	}
+	return plan
+}
+
+// testState returns a state holding the model, or an empty state for nil.
+func testState(t *testing.T, s schema.Schema, model any) tfsdk.State {
+	t.Helper()
+
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)}
+	if model == nil {
+		return state
+	}
+	if diags := state.Set(context.Background(), model); diags.HasError() {
+		t.Fatalf("invalid state: %v", diags)
+	}
+	return state
+}
+
+// testPrivate returns empty private state for a resource response. Its type
+// is internal to the framework, so it is taken from the response field:
+//
+//	resp.Private = testPrivate(resp.Private)
+func testPrivate[T any](*T) *T {
+	return new(T)
+}
+
+// testResponse returns an HTTP response with the status code, for the
+// responses returned by clientfake stubs.
+func testResponse(status int) *http.Response {
+	return &http.Response{StatusCode: status, Status: http.StatusText(status)}
+}
+
+// testUpgradeState upgrades the raw JSON state of a resource, written with
+// the given schema version, through the provider server and returns it
+// decoded with the current schema.
+func testUpgradeState(t *testing.T, typeName string, version int64, state []byte) tftypes.Value {
+	t.Helper()
+
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New())()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, ok := schemas.ResourceSchemas[typeName]
+	if !ok {
+		t.Fatalf("unknown resource type %s", typeName)
+	}
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  version,
+		RawState: &tfprotov6.RawState{JSON: state},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s: %s", d.Severity, d.Summary, d.Detail)
+	}
+	if resp.UpgradedState == nil {
+		t.FailNow()
+	}
+
+	upgraded, err := resp.UpgradedState.Unmarshal(s.ValueType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return upgraded
+}
+
+func TestProviderModelTransportConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    types.Int64
+		want    int
+		wantErr bool
+	}{
+		{name: "default", size: types.Int64Null(), want: defaultDebugBodySize},
+		{name: "whole body", size: types.Int64Value(0), want: 0},
+		{name: "configured", size: types.Int64Value(128), want: 128},
+		{name: "negative", size: types.Int64Value(-1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := medusaProviderModel{DebugHTTPMaxBodySize: tt.size}
+			cfg, diags := m.transportConfig()
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, diags)
+			}
+			if !tt.wantErr && cfg.DebugBodySize != tt.want {
+				t.Errorf("expected a body size of %d, got %d", tt.want, cfg.DebugBodySize)
+			}
+		})
+	}
+}
+
+func TestProviderModelDebugHTTP(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     string
+		config  types.Bool
+		want    bool
+		wantErr bool
+	}{
+		{name: "not set", config: types.BoolNull()},
+		{name: "environment true", env: "true", config: types.BoolNull(), want: true},
+		{name: "environment 1", env: "1", config: types.BoolNull(), want: true},
+		{name: "environment false", env: "false", config: types.BoolNull()},
+		{name: "environment 0", env: "0", config: types.BoolNull()},
+		{name: "environment invalid", env: "yes please", config: types.BoolNull(), wantErr: true},
+		{name: "configuration over environment", env: "true", config: types.BoolValue(false)},
+		{name: "configuration over invalid environment", env: "yes please", config: types.BoolValue(true), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("MEDUSA_DEBUG_HTTP", tt.env)
+			m := medusaProviderModel{DebugHTTP: tt.config, DebugHTTPMaxBodySize: types.Int64Null()}
+			cfg, diags := m.transportConfig()
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, diags)
+			}
+			if !tt.wantErr && cfg.DebugHTTP != tt.want {
+				t.Errorf("expected debug_http %v, got %v", tt.want, cfg.DebugHTTP)
+			}
+		})
+	}
+}
@@ -0,0 +1,427 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+// Medusa allows a country in a single region. Moving a country between two
+// regions of the configuration therefore only succeeds when the region that
+// gives it up is updated first, while Terraform applies both in parallel.
+// The plan of a region waits until the regions holding the countries it
+// takes are planned too, and fails when one of them keeps a country or is
+// not managed. The apply removes countries before it adds new ones, waiting
+// for the regions planned to give up the countries it takes.
+
+// regionPlanSettle is how long the plan of a region waits for the regions
+// holding the countries it takes, after the last region was planned.
+// Terraform plans the regions of a configuration in parallel, so a region
+// still missing by then is not managed in this run.
+var regionPlanSettle = 10 * time.Second
+
+// regionReleaseTimeout bounds the wait for a region to give up its
+// countries. It is only reached when Terraform does not apply a region it
+// planned, such as when one of its dependencies failed.
+var regionReleaseTimeout = 5 * time.Minute
+
+// regionPlans records the countries planned for each managed region during
+// one run of the provider, so the plan of a region can tell whether the
+// region holding a country it takes gives it up, and its apply can wait
+// until it did. The provider makes a new one every time it is configured,
+// which Terraform does once per plan and per apply. A nil *regionPlans
+// coordinates nothing.
+type regionPlans struct {
+	mu sync.Mutex
+	// planned holds the planned countries by region id. A nil set means
+	// the countries are not known until apply.
+	planned map[string]map[string]bool
+	// releasing holds the ids of the regions that give up countries, until
+	// they did.
+	releasing map[string]bool
+	// changed is closed and replaced whenever a region is planned or gives
+	// up its countries.
+	changed chan struct{}
+}
+
+func newRegionPlans() *regionPlans {
+	return &regionPlans{
+		planned:   map[string]map[string]bool{},
+		releasing: map[string]bool{},
+		changed:   make(chan struct{}),
+	}
+}
+
+// record stores the planned countries of a region, nil when unknown, and
+// whether the region gives up some of the countries it holds.
+func (p *regionPlans) record(id string, countries map[string]bool, releasing bool) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.planned[id] = countries
+	if releasing {
+		p.releasing[id] = true
+	}
+	p.notify()
+}
+
+// released records that the region gave up the countries it no longer
+// holds, or failed to.
+func (p *regionPlans) released(id string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.releasing[id] {
+		delete(p.releasing, id)
+		p.notify()
+	}
+}
+
+func (p *regionPlans) notify() {
+	close(p.changed)
+	p.changed = make(chan struct{})
+}
+
+// lookup returns the plans of the regions with the given ids that were
+// planned so far.
+func (p *regionPlans) lookup(ids []string) map[string]map[string]bool {
+	found := map[string]map[string]bool{}
+	if p == nil {
+		return found
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, id := range ids {
+		if countries, ok := p.planned[id]; ok {
+			found[id] = countries
+		}
+	}
+	return found
+}
+
+// pending reports whether some region still gives up countries.
+func (p *regionPlans) pending() bool {
+	if p == nil {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.releasing) > 0
+}
+
+// waitPlanned waits until the regions with the given ids are planned, and
+// returns the plans of those that are. It stops waiting once no region was
+// planned for regionPlanSettle.
+func (p *regionPlans) waitPlanned(ctx context.Context, ids []string) map[string]map[string]bool {
+	if p == nil {
+		return map[string]map[string]bool{}
+	}
+	wanted := map[string]bool{}
+	for _, id := range ids {
+		wanted[id] = true
+	}
+
+	for {
+		p.mu.Lock()
+		changed := p.changed
+		p.mu.Unlock()
+
+		found := p.lookup(ids)
+		if len(found) == len(wanted) {
+			return found
+		}
+
+		select {
+		case <-changed:
+		case <-time.After(regionPlanSettle):
+			return found
+		case <-ctx.Done():
+			return found
+		}
+	}
+}
+
+// waitRelease waits until the region owner gave up the countries it does
+// not keep, when it was planned to in this run.
+func (p *regionPlans) waitRelease(ctx context.Context, owner string) error {
+	if p == nil {
+		return nil
+	}
+	timeout := time.After(regionReleaseTimeout)
+	for {
+		p.mu.Lock()
+		releasing := p.releasing[owner]
+		changed := p.changed
+		p.mu.Unlock()
+
+		if !releasing {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-timeout:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// ModifyPlan warns when a protected region would be destroyed, records the
+// planned countries of the region and checks that the countries it takes
+// from other regions are given up in the same run.
+func (r *regionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnDeletionProtection(ctx, req, resp, "region")
+
+	// The client is not configured yet when the provider configuration is
+	// unknown.
+	if r.client == nil {
+		return
+	}
+
+	var id types.String
+	var prior types.Set
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("countries"), &prior)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// A destroyed region gives up all of its countries.
+	if req.Plan.Raw.IsNull() {
+		r.plans.record(id.ValueString(), map[string]bool{}, true)
+		return
+	}
+
+	var countries types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("countries"), &countries)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := countrySet(countries)
+	held := countrySet(prior)
+	if !id.IsNull() {
+		releasing := planned == nil
+		for country := range held {
+			if !planned[country] {
+				releasing = true
+			}
+		}
+		r.plans.record(id.ValueString(), planned, releasing)
+	}
+	if planned == nil {
+		return
+	}
+
+	// Only the countries the region does not hold yet can be held by
+	// another region.
+	var added []string
+	for country := range planned {
+		if !held[country] {
+			added = append(added, country)
+		}
+	}
+	if len(added) == 0 {
+		return
+	}
+	sort.Strings(added)
+
+	owners, err := r.countryOwners(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error planning region",
+			"Could not list the regions to check the countries of the region, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var conflicts []string
+	var ownerIDs []string
+	for _, country := range added {
+		owner, ok := owners[country]
+		if !ok || owner.Id == id.ValueString() {
+			continue
+		}
+		conflicts = append(conflicts, country)
+		ownerIDs = append(ownerIDs, owner.Id)
+	}
+	if len(conflicts) == 0 {
+		return
+	}
+
+	// Terraform plans the regions in parallel, so a region that is not
+	// planned yet may still give the country up in this run.
+	managed := r.plans.waitPlanned(ctx, ownerIDs)
+	for _, country := range conflicts {
+		owner := owners[country]
+		ownerPlan, ok := managed[owner.Id]
+		switch {
+		case !ok:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("countries"),
+				"Country already in another region",
+				fmt.Sprintf("The country %s is in the region %s (%s), which is not managed by this configuration. "+
+					"Medusa allows a country in a single region, so remove the country from that region first.",
+					country, owner.Name, owner.Id),
+			)
+		case ownerPlan != nil && ownerPlan[country]:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("countries"),
+				"Country already in another region",
+				fmt.Sprintf("The country %s is also configured for the region %s (%s). "+
+					"Medusa allows a country in a single region.",
+					country, owner.Name, owner.Id),
+			)
+		}
+	}
+}
+
+// countryOwners returns the region of each country that is in a region.
+func (r *regionResource) countryOwners(ctx context.Context) (map[string]medusa.Region, error) {
+	owners := map[string]medusa.Region{}
+
+	for offset := 0; ; offset += importPageSize {
+		limit := importPageSize
+		content, err := r.client.GetRegionsWithResponse(ctx, &medusa.GetRegionsParams{Offset: &offset, Limit: &limit})
+		if err != nil {
+			return nil, err
+		}
+		if content.JSON200 == nil {
+			return nil, listError(content.StatusCode(), content.Body)
+		}
+
+		for _, region := range content.JSON200.Regions {
+			if region.Countries == nil {
+				continue
+			}
+			for _, country := range *region.Countries {
+				owners[strings.ToLower(country.Iso2)] = region
+			}
+		}
+
+		if offset+importPageSize >= content.JSON200.Count {
+			return owners, nil
+		}
+	}
+}
+
+// countrySet returns the lower case countries of a set attribute, or nil
+// when they are not known.
+func countrySet(countries types.Set) map[string]bool {
+	if countries.IsUnknown() {
+		return nil
+	}
+
+	set := map[string]bool{}
+	for _, element := range countries.Elements() {
+		country, ok := element.(types.String)
+		if !ok || country.IsUnknown() {
+			return nil
+		}
+		set[strings.ToLower(country.ValueString())] = true
+	}
+	return set
+}
+
+// keptCountries returns the countries of the prior state that stay in the
+// region, when the update also adds countries. Updating the region to them
+// first gives up the removed countries before the new ones are taken, so
+// countries can be swapped between regions. It returns nil when the update
+// can be made at once.
+func keptCountries(plan, prior []types.String) []string {
+	planned := map[string]bool{}
+	for _, c := range plan {
+		planned[strings.ToLower(c.ValueString())] = true
+	}
+	held := map[string]bool{}
+	for _, c := range prior {
+		held[strings.ToLower(c.ValueString())] = true
+	}
+
+	var kept []string
+	removed, added := false, false
+	for country := range held {
+		if planned[country] {
+			kept = append(kept, country)
+		} else {
+			removed = true
+		}
+	}
+	for country := range planned {
+		if !held[country] {
+			added = true
+		}
+	}
+
+	if !removed || !added {
+		return nil
+	}
+	if kept == nil {
+		kept = []string{}
+	}
+	return kept
+}
+
+// addedCountries returns the countries of the plan that the prior state
+// does not hold.
+func addedCountries(plan, prior []types.String) []string {
+	held := map[string]bool{}
+	for _, c := range prior {
+		held[strings.ToLower(c.ValueString())] = true
+	}
+
+	var added []string
+	for _, c := range plan {
+		if !held[strings.ToLower(c.ValueString())] {
+			added = append(added, c.ValueString())
+		}
+	}
+	return added
+}
+
+// waitCountries waits until the regions planned to give up the countries a
+// region takes did, so taking them does not fail while Terraform applies
+// both regions in parallel. id is the id of the region, or empty when it is
+// created.
+func (r *regionResource) waitCountries(ctx context.Context, id string, countries []string) error {
+	if len(countries) == 0 || !r.plans.pending() {
+		return nil
+	}
+
+	owners, err := r.countryOwners(ctx)
+	if err != nil {
+		return err
+	}
+	for _, country := range countries {
+		owner, ok := owners[strings.ToLower(country)]
+		if !ok || owner.Id == id {
+			continue
+		}
+		tflog.Debug(ctx, "Waiting for a country to be removed from another region", map[string]any{"country": country, "region": owner.Id})
+		if err := r.plans.waitRelease(ctx, owner.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
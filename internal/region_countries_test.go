@@ -0,0 +1,555 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+)
+
+func TestKeptCountries(t *testing.T) {
+	tests := []struct {
+		name  string
+		plan  []string
+		prior []string
+		want  []string
+	}{
+		{"unchanged", []string{"nl"}, []string{"nl"}, nil},
+		{"only added", []string{"nl", "be"}, []string{"nl"}, nil},
+		{"only removed", []string{"nl"}, []string{"nl", "be"}, nil},
+		{"swapped", []string{"be"}, []string{"nl"}, []string{}},
+		{"moved", []string{"nl", "lu"}, []string{"nl", "be"}, []string{"nl"}},
+		{"different case", []string{"NL", "lu"}, []string{"nl", "be"}, []string{"nl"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := keptCountries(utils.ConvertToTerraformStringSlice(tt.plan), utils.ConvertToTerraformStringSlice(tt.prior))
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("keptCountries() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddedCountries(t *testing.T) {
+	tests := []struct {
+		name  string
+		plan  []string
+		prior []string
+		want  []string
+	}{
+		{"unchanged", []string{"nl"}, []string{"nl"}, nil},
+		{"created", []string{"nl", "be"}, nil, []string{"be", "nl"}},
+		{"moved", []string{"nl", "lu"}, []string{"nl", "be"}, []string{"lu"}},
+		{"different case", []string{"NL", "lu"}, []string{"nl"}, []string{"lu"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := addedCountries(utils.ConvertToTerraformStringSlice(tt.plan), utils.ConvertToTerraformStringSlice(tt.prior))
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("addedCountries() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegionPlansWait(t *testing.T) {
+	defer func(settle time.Duration) { regionPlanSettle = settle }(regionPlanSettle)
+	regionPlanSettle = 100 * time.Millisecond
+	ctx := context.Background()
+
+	t.Run("planned later", func(t *testing.T) {
+		plans := newRegionPlans()
+		done := make(chan map[string]map[string]bool)
+		go func() { done <- plans.waitPlanned(ctx, []string{"reg_01", "reg_01"}) }()
+		plans.record("reg_02", map[string]bool{"be": true}, false)
+		plans.record("reg_01", map[string]bool{"nl": true}, false)
+		if got := <-done; !reflect.DeepEqual(got, map[string]map[string]bool{"reg_01": {"nl": true}}) {
+			t.Errorf("expected the plan of reg_01, got %v", got)
+		}
+	})
+
+	t.Run("not managed", func(t *testing.T) {
+		plans := newRegionPlans()
+		plans.record("reg_02", map[string]bool{"be": true}, false)
+		start := time.Now()
+		if got := plans.waitPlanned(ctx, []string{"reg_01"}); len(got) != 0 {
+			t.Errorf("expected no plans, got %v", got)
+		}
+		if elapsed := time.Since(start); elapsed < regionPlanSettle {
+			t.Errorf("expected to wait for the region to be planned, waited %s", elapsed)
+		}
+	})
+
+	t.Run("released", func(t *testing.T) {
+		plans := newRegionPlans()
+		plans.record("reg_01", map[string]bool{}, true)
+		if !plans.pending() {
+			t.Error("expected a region giving up countries")
+		}
+
+		done := make(chan error)
+		go func() { done <- plans.waitRelease(ctx, "reg_01") }()
+		select {
+		case err := <-done:
+			t.Fatalf("expected to wait for the region, got %v", err)
+		case <-time.After(50 * time.Millisecond):
+		}
+		plans.released("reg_01")
+		if err := <-done; err != nil {
+			t.Error(err)
+		}
+		if plans.pending() {
+			t.Error("expected no region giving up countries")
+		}
+	})
+
+	t.Run("kept", func(t *testing.T) {
+		plans := newRegionPlans()
+		plans.record("reg_01", map[string]bool{"nl": true}, false)
+		if err := plans.waitRelease(ctx, "reg_01"); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		plans := newRegionPlans()
+		plans.record("reg_01", map[string]bool{}, true)
+
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+		if err := plans.waitRelease(ctx, "reg_01"); err != context.Canceled {
+			t.Errorf("expected the cancellation, got %v", err)
+		}
+	})
+}
+
+func TestRegionResourceModifyPlan(t *testing.T) {
+	defer func(settle time.Duration) { regionPlanSettle = settle }(regionPlanSettle)
+	regionPlanSettle = 100 * time.Millisecond
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	ids := map[string]string{}
+	for name, country := range map[string]string{"Netherlands": "nl", "Belgium": "be", "Luxembourg": "lu"} {
+		res, err := client.PostRegionsWithResponse(ctx, medusa.PostRegionsJSONRequestBody{
+			Name:                 name,
+			CurrencyCode:         "eur",
+			PaymentProviders:     []string{"manual"},
+			FulfillmentProviders: []string{"manual"},
+			Countries:            []string{country},
+		})
+		if err != nil || res.JSON200 == nil {
+			t.Fatalf("could not create region %s: %v", name, err)
+		}
+		ids[country] = res.JSON200.Region.Id
+	}
+
+	// region returns the model of a managed region holding the countries,
+	// or of a new region without id.
+	region := func(id string, countries ...string) *regionResourceModel {
+		model := &regionResourceModel{
+			ID:                   types.StringValue(id),
+			Name:                 types.StringValue(id),
+			CurrencyCode:         types.StringValue("eur"),
+			TaxRate:              testNumber(t, "0"),
+			PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+			FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+			Countries:            utils.ConvertToTerraformStringSlice(countries),
+			AutomaticTaxes:       types.BoolValue(true),
+			GiftCardsTaxable:     types.BoolValue(true),
+			TaxProviderID:        types.StringNull(),
+			CreatedAt:            types.StringValue("2024-03-01T12:00:00Z"),
+			UpdatedAt:            types.StringValue("2024-03-01T12:00:00Z"),
+		}
+		if id == "" {
+			model.ID = types.StringUnknown()
+		}
+		return model
+	}
+
+	type change struct {
+		state, plan *regionResourceModel
+	}
+
+	tests := []struct {
+		name    string
+		changes []change
+		wantErr map[int]string
+	}{
+		{
+			name: "new country",
+			changes: []change{
+				{region(ids["nl"], "nl"), region(ids["nl"], "nl", "de")},
+			},
+		},
+		{
+			name: "swapped between managed regions",
+			changes: []change{
+				{region(ids["nl"], "nl"), region(ids["nl"], "be")},
+				{region(ids["be"], "be"), region(ids["be"], "nl")},
+			},
+		},
+		{
+			name: "taken from a destroyed region",
+			changes: []change{
+				{region(ids["be"], "be"), nil},
+				{nil, region("", "be")},
+			},
+		},
+		{
+			name: "taken from an unmanaged region",
+			changes: []change{
+				{region(ids["nl"], "nl"), region(ids["nl"], "nl", "lu")},
+			},
+			wantErr: map[int]string{0: "not managed by this configuration"},
+		},
+		{
+			name: "kept by a managed region",
+			changes: []change{
+				{region(ids["be"], "be"), region(ids["be"], "be")},
+				{region(ids["nl"], "nl"), region(ids["nl"], "nl", "be")},
+			},
+			wantErr: map[int]string{1: "also configured for the region"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Every case is a separate run with its own plans, and the
+			// regions are planned in parallel, as Terraform does.
+			r := &regionResource{client: utils.NewClient(client, "", nil), plans: newRegionPlans()}
+			s := testResourceSchema(t, r)
+
+			errs := make([]string, len(tt.changes))
+			var wg sync.WaitGroup
+			for i, c := range tt.changes {
+				req := fwresource.ModifyPlanRequest{
+					State: testState(t, s, nil),
+					Plan:  tfsdk.Plan{Schema: s, Raw: testState(t, s, nil).Raw},
+				}
+				if c.state != nil {
+					req.State = testState(t, s, c.state)
+				}
+				if c.plan != nil {
+					req.Plan = testPlan(t, s, c.plan)
+				}
+
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+					r.ModifyPlan(ctx, req, &resp)
+
+					var details []string
+					for _, d := range resp.Diagnostics.Errors() {
+						details = append(details, d.Detail())
+					}
+					errs[i] = strings.Join(details, "\n")
+				}()
+			}
+			wg.Wait()
+
+			for i, err := range errs {
+				want := tt.wantErr[i]
+				if (want == "") != (err == "") || !strings.Contains(err, want) {
+					t.Errorf("change %d: expected error %q, got %q", i, want, err)
+				}
+			}
+		})
+	}
+}
+
+// testCreateRegion creates a region with the given countries.
+func testCreateRegion(t *testing.T, r *regionResource, s schema.Schema, countries ...string) regionResourceModel {
+	t.Helper()
+	ctx := context.Background()
+
+	plan := regionResourceModel{
+		ID:                   types.StringUnknown(),
+		Name:                 types.StringValue("Region " + strings.Join(countries, " ")),
+		CurrencyCode:         types.StringValue("eur"),
+		TaxRate:              testNumber(t, "0"),
+		PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		Countries:            utils.ConvertToTerraformStringSlice(countries),
+		AutomaticTaxes:       types.BoolUnknown(),
+		GiftCardsTaxable:     types.BoolUnknown(),
+		TaxProviderID:        types.StringUnknown(),
+		CreatedAt:            types.StringUnknown(),
+		UpdatedAt:            types.StringUnknown(),
+	}
+	resp := fwresource.CreateResponse{State: testState(t, s, nil)}
+	resp.Private = testPrivate(resp.Private)
+	r.Create(ctx, fwresource.CreateRequest{Plan: testPlan(t, s, plan)}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatal(resp.Diagnostics)
+	}
+
+	var state regionResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatal(diags)
+	}
+	return state
+}
+
+// testPlanRegion plans the region to the given countries, and returns its
+// update request.
+func testPlanRegion(t *testing.T, r *regionResource, s schema.Schema, state regionResourceModel, countries []string) (fwresource.UpdateRequest, error) {
+	plan := state
+	plan.Countries = utils.ConvertToTerraformStringSlice(countries)
+	plan.UpdatedAt = types.StringUnknown()
+
+	req := fwresource.ModifyPlanRequest{Plan: testPlan(t, s, plan), State: testState(t, s, state)}
+	resp := fwresource.ModifyPlanResponse{Plan: req.Plan}
+	r.ModifyPlan(context.Background(), req, &resp)
+	if resp.Diagnostics.HasError() {
+		return fwresource.UpdateRequest{}, fmt.Errorf("%v", resp.Diagnostics)
+	}
+	return fwresource.UpdateRequest{Plan: resp.Plan, State: req.State}, nil
+}
+
+// testUpdateRegions plans the regions to the given countries in parallel,
+// and returns the update request of each.
+func testUpdateRegions(t *testing.T, r *regionResource, s schema.Schema, states []regionResourceModel, countries [][]string) []fwresource.UpdateRequest {
+	t.Helper()
+
+	reqs := make([]fwresource.UpdateRequest, len(states))
+	errs := make([]error, len(states))
+	var wg sync.WaitGroup
+	for i, state := range states {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reqs[i], errs[i] = testPlanRegion(t, r, s, state, countries[i])
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return reqs
+}
+
+// testRegionCountries returns the sorted countries of a region.
+func testRegionCountries(t *testing.T, client utils.Client, id string) []string {
+	t.Helper()
+
+	read, err := client.GetRegionsRegionWithResponse(context.Background(), id)
+	if err != nil || read.JSON200 == nil {
+		t.Fatalf("unable to read region %s: %v", id, err)
+	}
+	var countries []string
+	for _, country := range *read.JSON200.Region.Countries {
+		countries = append(countries, country.Iso2)
+	}
+	sort.Strings(countries)
+	return countries
+}
+
+func TestRegionResourceUpdateSwap(t *testing.T) {
+	ctx := context.Background()
+	client := utils.NewClient(newFakeTestAccHarness(t).client(t), "", nil)
+	r := &regionResource{client: client, plans: newRegionPlans()}
+	s := testResourceSchema(t, r)
+
+	states := []regionResourceModel{testCreateRegion(t, r, s, "nl"), testCreateRegion(t, r, s, "be")}
+	reqs := testUpdateRegions(t, r, s, states, [][]string{{"be"}, {"nl"}})
+
+	// Swap the countries, updating both regions at the same time.
+	var wg sync.WaitGroup
+	for _, req := range reqs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			resp := fwresource.UpdateResponse{State: req.State}
+			resp.Private = testPrivate(resp.Private)
+			r.Update(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Error(resp.Diagnostics)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, state := range states {
+		want := []string{[]string{"be", "nl"}[i]}
+		if got := testRegionCountries(t, client, state.ID.ValueString()); !reflect.DeepEqual(got, want) {
+			t.Errorf("region %s: expected the countries %v, got %v", state.ID, want, got)
+		}
+	}
+}
+
+// Terraform plans each region again right before it applies it, so one of
+// two regions swapping their countries can be planned and applied before the
+// other is planned at all. Its plan waits for the other region, and its
+// update for the country the other region gives up.
+func TestRegionResourceApplySwap(t *testing.T) {
+	ctx := context.Background()
+	client := utils.NewClient(newFakeTestAccHarness(t).client(t), "", nil)
+	r := &regionResource{client: client, plans: newRegionPlans()}
+	s := testResourceSchema(t, r)
+
+	states := []regionResourceModel{testCreateRegion(t, r, s, "nl"), testCreateRegion(t, r, s, "be")}
+
+	apply := func(state regionResourceModel, countries []string) <-chan error {
+		done := make(chan error, 1)
+		go func() {
+			req, err := testPlanRegion(t, r, s, state, countries)
+			if err != nil {
+				done <- err
+				return
+			}
+			resp := fwresource.UpdateResponse{State: req.State}
+			resp.Private = testPrivate(resp.Private)
+			r.Update(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				err = fmt.Errorf("%v", resp.Diagnostics)
+			}
+			done <- err
+		}()
+		return done
+	}
+
+	doneA := apply(states[0], []string{"be"})
+	time.Sleep(50 * time.Millisecond)
+	doneB := apply(states[1], []string{"nl"})
+	for _, done := range []<-chan error{doneA, doneB} {
+		if err := <-done; err != nil {
+			t.Error(err)
+		}
+	}
+
+	for i, state := range states {
+		want := []string{[]string{"be", "nl"}[i]}
+		if got := testRegionCountries(t, client, state.ID.ValueString()); !reflect.DeepEqual(got, want) {
+			t.Errorf("region %s: expected the countries %v, got %v", state.ID, want, got)
+		}
+	}
+}
+
+// conflictClient records the countries of the region updates, and the
+// updates that fail because a country is in another region.
+type conflictClient struct {
+	utils.Client
+
+	mu        sync.Mutex
+	updates   map[string][][]string
+	conflicts []string
+}
+
+func (c *conflictClient) PostRegionsRegionWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...medusa.RequestEditorFn) (*medusa.PostRegionsRegionResponse, error) {
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	var input struct {
+		Countries []string `json:"countries"`
+	}
+	if err := json.Unmarshal(b, &input); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.updates[id] = append(c.updates[id], input.Countries)
+	c.mu.Unlock()
+
+	content, err := c.Client.PostRegionsRegionWithBodyWithResponse(ctx, id, contentType, bytes.NewReader(b), reqEditors...)
+	if err == nil && content.StatusCode() == http.StatusUnprocessableEntity {
+		c.mu.Lock()
+		c.conflicts = append(c.conflicts, id)
+		c.mu.Unlock()
+	}
+	return content, err
+}
+
+// Moving a country from one region to another succeeds when the region
+// taking it is updated first: it waits until the other region gave the
+// country up, which that region does before it takes its own new country,
+// without sending an update Medusa rejects.
+func TestRegionResourceUpdateMove(t *testing.T) {
+	ctx := context.Background()
+	client := &conflictClient{
+		Client:  utils.NewClient(newFakeTestAccHarness(t).client(t), "", nil),
+		updates: map[string][][]string{},
+	}
+	r := &regionResource{client: client, plans: newRegionPlans()}
+	s := testResourceSchema(t, r)
+
+	a := testCreateRegion(t, r, s, "nl", "be")
+	b := testCreateRegion(t, r, s, "de")
+	reqs := testUpdateRegions(t, r, s, []regionResourceModel{a, b}, [][]string{{"nl", "fr"}, {"de", "be"}})
+
+	update := func(req fwresource.UpdateRequest) <-chan fwresource.UpdateResponse {
+		done := make(chan fwresource.UpdateResponse, 1)
+		go func() {
+			resp := fwresource.UpdateResponse{State: req.State}
+			resp.Private = testPrivate(resp.Private)
+			r.Update(ctx, req, &resp)
+			done <- resp
+		}()
+		return done
+	}
+
+	// The region taking the country waits for the other region.
+	doneB := update(reqs[1])
+	select {
+	case resp := <-doneB:
+		t.Fatalf("expected the update to wait for the country, got %v", resp.Diagnostics)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	doneA := update(reqs[0])
+	for _, done := range []<-chan fwresource.UpdateResponse{doneA, doneB} {
+		if resp := <-done; resp.Diagnostics.HasError() {
+			t.Error(resp.Diagnostics)
+		}
+	}
+
+	// The region giving the country up first updates to the countries it
+	// keeps, and the region taking it updates once.
+	if len(client.conflicts) > 0 {
+		t.Errorf("expected no update to conflict, got %v", client.conflicts)
+	}
+	wantUpdates := map[string][][]string{
+		a.ID.ValueString(): {{"nl"}, {"fr", "nl"}},
+		b.ID.ValueString(): {{"be", "de"}},
+	}
+	for id, updates := range client.updates {
+		for _, countries := range updates {
+			sort.Strings(countries)
+		}
+		if !reflect.DeepEqual(updates, wantUpdates[id]) {
+			t.Errorf("region %s: expected the updates %v, got %v", id, wantUpdates[id], updates)
+		}
+	}
+
+	for id, want := range map[string][]string{a.ID.ValueString(): {"fr", "nl"}, b.ID.ValueString(): {"be", "de"}} {
+		if got := testRegionCountries(t, client, id); !reflect.DeepEqual(got, want) {
+			t.Errorf("region %s: expected the countries %v, got %v", id, want, got)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -10,47 +11,112 @@ import (
 
 // regionResourceModel maps the resource schema data.
 type regionResourceModel struct {
-	ID                   types.String   `tfsdk:"id"`
-	Name                 types.String   `tfsdk:"name"`
-	CurrencyCode         types.String   `tfsdk:"currency_code"`
-	TaxRate              types.Number   `tfsdk:"tax_rate"`
-	PaymentProviders     []types.String `tfsdk:"payment_providers"`
-	FulfillmentProviders []types.String `tfsdk:"fulfillment_providers"`
-	Countries            []types.String `tfsdk:"countries"`
-	TaxCode              types.String   `tfsdk:"tax_code"`
-	IncludesTax          types.Bool     `tfsdk:"includes_tax"`
+	ID                   types.String            `tfsdk:"id"`
+	Name                 types.String            `tfsdk:"name"`
+	CurrencyCode         types.String            `tfsdk:"currency_code"`
+	TaxRate              types.Number            `tfsdk:"tax_rate"`
+	PaymentProviders     []types.String          `tfsdk:"payment_providers"`
+	FulfillmentProviders []types.String          `tfsdk:"fulfillment_providers"`
+	Countries            []types.String          `tfsdk:"countries"`
+	TaxCode              types.String            `tfsdk:"tax_code"`
+	IncludesTax          types.Bool              `tfsdk:"includes_tax"`
+	Metadata             map[string]types.String `tfsdk:"metadata"`
+	AutomaticTaxes       types.Bool              `tfsdk:"automatic_taxes"`
+	GiftCardsTaxable     types.Bool              `tfsdk:"gift_cards_taxable"`
+	TaxProviderID        types.String            `tfsdk:"tax_provider_id"`
+	CreatedAt            types.String            `tfsdk:"created_at"`
+	UpdatedAt            types.String            `tfsdk:"updated_at"`
+	DeletionProtection   types.Bool              `tfsdk:"deletion_protection"`
 }
 
-func (m *regionResourceModel) toCreateInput() medusa.AdminPostRegionsReq {
-	return medusa.AdminPostRegionsReq{
-		Name:                 m.Name.ValueString(),
-		CurrencyCode:         m.CurrencyCode.ValueString(),
-		TaxRate:              utils.ConvertToFloat32(m.TaxRate),
-		PaymentProviders:     utils.ConvertToStringSlice(m.PaymentProviders),
-		FulfillmentProviders: utils.ConvertToStringSlice(m.FulfillmentProviders),
-		Countries:            utils.ConvertToStringSlice(m.Countries),
-		TaxCode:              m.TaxCode.ValueStringPointer(),
-		IncludesTax:          m.IncludesTax.ValueBoolPointer(),
+// regionCreateInput adds the metadata and tax settings, which Medusa accepts
+// for a region but the SDK request type does not model. The tax rate is sent
+// as the configured decimal instead of the float32 of the SDK.
+type regionCreateInput struct {
+	medusa.AdminPostRegionsReq
+	TaxRate          json.Number     `json:"tax_rate"`
+	Metadata         *map[string]any `json:"metadata,omitempty"`
+	AutomaticTaxes   *bool           `json:"automatic_taxes,omitempty"`
+	GiftCardsTaxable *bool           `json:"gift_cards_taxable,omitempty"`
+	TaxProviderID    *string         `json:"tax_provider_id,omitempty"`
+}
+
+// regionUpdateInput adds the metadata to the SDK update request, which
+// already models the tax settings, and sends the tax rate as a decimal.
+type regionUpdateInput struct {
+	medusa.AdminPostRegionsRegionReq
+	TaxRate  *json.Number    `json:"tax_rate,omitempty"`
+	Metadata *map[string]any `json:"metadata,omitempty"`
+}
+
+// regionTaxRate reads the tax rate of a region response as the decimal
+// Medusa returned, which the SDK response type rounds to a float32.
+func regionTaxRate(body []byte) (json.Number, error) {
+	var res struct {
+		Region struct {
+			TaxRate json.Number `json:"tax_rate"`
+		} `json:"region"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return "", fmt.Errorf("unable to read the tax rate: %w", err)
+	}
+	return res.Region.TaxRate, nil
+}
+
+func (m *regionResourceModel) toCreateInput() regionCreateInput {
+	return regionCreateInput{
+		AdminPostRegionsReq: medusa.AdminPostRegionsReq{
+			Name:                 m.Name.ValueString(),
+			CurrencyCode:         utils.ConvertToCode(m.CurrencyCode),
+			PaymentProviders:     utils.ConvertToStringSlice(m.PaymentProviders),
+			FulfillmentProviders: utils.ConvertToStringSlice(m.FulfillmentProviders),
+			Countries:            utils.ConvertToCodes(m.Countries),
+			TaxCode:              m.TaxCode.ValueStringPointer(),
+			IncludesTax:          m.IncludesTax.ValueBoolPointer(),
+		},
+		TaxRate:          utils.ConvertToJSONNumber(m.TaxRate),
+		Metadata:         utils.ConvertToMetadata(m.Metadata),
+		AutomaticTaxes:   utils.ConvertToPointerBool(m.AutomaticTaxes),
+		GiftCardsTaxable: utils.ConvertToPointerBool(m.GiftCardsTaxable),
+		TaxProviderID:    utils.ConvertToPointerString(m.TaxProviderID),
 	}
 }
-func (m *regionResourceModel) toUpdateInput() medusa.AdminPostRegionsRegionReq {
-	return medusa.AdminPostRegionsRegionReq{
-		Name:                 m.Name.ValueStringPointer(),
-		CurrencyCode:         m.CurrencyCode.ValueStringPointer(),
-		TaxRate:              utils.ConvertToPointerFloat32(m.TaxRate),
-		PaymentProviders:     utils.ConvertToPointerStringSlice(m.PaymentProviders),
-		FulfillmentProviders: utils.ConvertToPointerStringSlice(m.FulfillmentProviders),
-		Countries:            utils.ConvertToPointerStringSlice(m.Countries),
-		TaxCode:              m.TaxCode.ValueStringPointer(),
-		IncludesTax:          m.IncludesTax.ValueBoolPointer(),
+
+func (m *regionResourceModel) toUpdateInput(prior *regionResourceModel) regionUpdateInput {
+	return regionUpdateInput{
+		AdminPostRegionsRegionReq: medusa.AdminPostRegionsRegionReq{
+			Name:                 utils.ChangedString(m.Name, prior.Name),
+			CurrencyCode:         utils.ChangedCode(m.CurrencyCode, prior.CurrencyCode),
+			PaymentProviders:     utils.ChangedStringSlice(m.PaymentProviders, prior.PaymentProviders),
+			FulfillmentProviders: utils.ChangedStringSlice(m.FulfillmentProviders, prior.FulfillmentProviders),
+			Countries:            utils.ChangedCodes(m.Countries, prior.Countries),
+			TaxCode:              utils.ChangedString(m.TaxCode, prior.TaxCode),
+			IncludesTax:          utils.ChangedBool(m.IncludesTax, prior.IncludesTax),
+			AutomaticTaxes:       utils.ChangedBool(m.AutomaticTaxes, prior.AutomaticTaxes),
+			GiftCardsTaxable:     utils.ChangedBool(m.GiftCardsTaxable, prior.GiftCardsTaxable),
+			TaxProviderId:        utils.ChangedString(m.TaxProviderID, prior.TaxProviderID),
+		},
+		TaxRate:  utils.ChangedNumber(m.TaxRate, prior.TaxRate),
+		Metadata: utils.ConvertToMetadataUpdate(m.Metadata, prior.Metadata),
 	}
 }
 
-func (m *regionResourceModel) fromRemote(c *medusa.AdminRegionsRes) error {
+// fromRemote maps a region response. The tax rate is read from the body of
+// the response.
+func (m *regionResourceModel) fromRemote(c *medusa.AdminRegionsRes, body []byte) error {
 	if c == nil {
 		return fmt.Errorf("region is nil")
 	}
 
+	taxRate, err := regionTaxRate(body)
+	if err != nil {
+		return err
+	}
+	m.TaxRate, err = utils.ConvertToTerraformNumber(m.TaxRate, taxRate)
+	if err != nil {
+		return err
+	}
+
 	fulfillmentIDs := utils.ExtractIDs(
 		c.Region.FulfillmentProviders,
 		func(fp medusa.FulfillmentProvider) string {
@@ -72,13 +138,18 @@ func (m *regionResourceModel) fromRemote(c *medusa.AdminRegionsRes) error {
 
 	m.ID = types.StringValue(c.Region.Id)
 	m.Name = types.StringValue(c.Region.Name)
-	m.CurrencyCode = types.StringValue(c.Region.CurrencyCode)
+	m.CurrencyCode = utils.ConvertToTerraformCode(m.CurrencyCode, c.Region.CurrencyCode)
 	m.FulfillmentProviders = utils.ConvertToTerraformStringSlice(fulfillmentIDs)
 	m.PaymentProviders = utils.ConvertToTerraformStringSlice(paymentIDs)
-	m.Countries = utils.ConvertToTerraformStringSlice(countryIDs)
-	m.TaxRate = utils.ConvertToTerraformNumber(c.Region.TaxRate)
-	m.TaxCode = types.StringPointerValue(c.Region.TaxCode)
+	m.Countries = utils.ConvertToTerraformCodes(m.Countries, countryIDs)
+	m.TaxCode = utils.ConvertToTerraformOptionalString(m.TaxCode, c.Region.TaxCode)
 	m.IncludesTax = types.BoolPointerValue(c.Region.IncludesTax)
+	m.Metadata = utils.ConvertToTerraformMetadata(m.Metadata, c.Region.Metadata)
+	m.AutomaticTaxes = types.BoolValue(c.Region.AutomaticTaxes)
+	m.GiftCardsTaxable = types.BoolValue(c.Region.GiftCardsTaxable)
+	m.TaxProviderID = types.StringPointerValue(c.Region.TaxProviderId)
+	m.CreatedAt = utils.ConvertToTerraformTime(c.Region.CreatedAt)
+	m.UpdatedAt = utils.ConvertToTerraformTime(c.Region.UpdatedAt)
 
 	return nil
 }
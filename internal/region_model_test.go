@@ -0,0 +1,469 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+)
+
+func TestRegionModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	tests := []struct {
+		name   string
+		create regionResourceModel
+		update regionResourceModel
+	}{
+		{
+			name: "required attributes",
+			create: regionResourceModel{
+				Name:                 types.StringValue("Europe"),
+				CurrencyCode:         types.StringValue("eur"),
+				TaxRate:              testNumber(t, "0"),
+				PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				Countries:            utils.ConvertToTerraformStringSlice([]string{"nl", "be"}),
+				TaxCode:              types.StringNull(),
+				IncludesTax:          types.BoolNull(),
+				AutomaticTaxes:       types.BoolValue(true),
+				GiftCardsTaxable:     types.BoolValue(true),
+				TaxProviderID:        types.StringNull(),
+			},
+			update: regionResourceModel{
+				Name:                 types.StringValue("Benelux"),
+				CurrencyCode:         types.StringValue("eur"),
+				TaxRate:              testNumber(t, "21"),
+				PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				Countries:            utils.ConvertToTerraformStringSlice([]string{"nl", "be", "lu"}),
+				TaxCode:              types.StringNull(),
+				IncludesTax:          types.BoolNull(),
+				AutomaticTaxes:       types.BoolValue(true),
+				GiftCardsTaxable:     types.BoolValue(true),
+				TaxProviderID:        types.StringNull(),
+			},
+		},
+		{
+			name: "metadata",
+			create: regionResourceModel{
+				Name:                 types.StringValue("Nordics"),
+				CurrencyCode:         types.StringValue("eur"),
+				TaxRate:              testNumber(t, "0"),
+				PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				Countries:            utils.ConvertToTerraformStringSlice([]string{"se", "no"}),
+				TaxCode:              types.StringNull(),
+				IncludesTax:          types.BoolNull(),
+				Metadata:             testMetadata("erp_id", "42", "channel", "web"),
+				AutomaticTaxes:       types.BoolValue(true),
+				GiftCardsTaxable:     types.BoolValue(true),
+				TaxProviderID:        types.StringNull(),
+			},
+			update: regionResourceModel{
+				Name:                 types.StringValue("Scandinavia"),
+				CurrencyCode:         types.StringValue("eur"),
+				TaxRate:              testNumber(t, "21"),
+				PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				Countries:            utils.ConvertToTerraformStringSlice([]string{"se", "no", "dk"}),
+				TaxCode:              types.StringNull(),
+				IncludesTax:          types.BoolNull(),
+				Metadata:             testMetadata("erp_id", "43"),
+				AutomaticTaxes:       types.BoolValue(true),
+				GiftCardsTaxable:     types.BoolValue(true),
+				TaxProviderID:        types.StringNull(),
+			},
+		},
+		{
+			name: "decimal tax rate",
+			create: regionResourceModel{
+				Name:                 types.StringValue("North America"),
+				CurrencyCode:         types.StringValue("usd"),
+				TaxRate:              testNumber(t, "12.345"),
+				PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				Countries:            utils.ConvertToTerraformStringSlice([]string{"us"}),
+				TaxCode:              types.StringValue("US-STD"),
+				IncludesTax:          types.BoolValue(true),
+				AutomaticTaxes:       types.BoolValue(true),
+				GiftCardsTaxable:     types.BoolValue(true),
+				TaxProviderID:        types.StringNull(),
+			},
+			update: regionResourceModel{
+				Name:                 types.StringValue("North America"),
+				CurrencyCode:         types.StringValue("usd"),
+				TaxRate:              testNumber(t, "7.25"),
+				PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				Countries:            utils.ConvertToTerraformStringSlice([]string{"us", "ca"}),
+				TaxCode:              types.StringValue("US-REDUCED"),
+				IncludesTax:          types.BoolValue(false),
+				AutomaticTaxes:       types.BoolValue(true),
+				GiftCardsTaxable:     types.BoolValue(true),
+				TaxProviderID:        types.StringNull(),
+			},
+		},
+		{
+			name: "tax settings",
+			create: regionResourceModel{
+				Name:                 types.StringValue("Oceania"),
+				CurrencyCode:         types.StringValue("aud"),
+				TaxRate:              testNumber(t, "10"),
+				PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				Countries:            utils.ConvertToTerraformStringSlice([]string{"au"}),
+				TaxCode:              types.StringNull(),
+				IncludesTax:          types.BoolNull(),
+				AutomaticTaxes:       types.BoolValue(false),
+				GiftCardsTaxable:     types.BoolValue(false),
+				TaxProviderID:        types.StringValue("avalara"),
+			},
+			update: regionResourceModel{
+				Name:                 types.StringValue("Oceania"),
+				CurrencyCode:         types.StringValue("aud"),
+				TaxRate:              testNumber(t, "10"),
+				PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+				Countries:            utils.ConvertToTerraformStringSlice([]string{"au", "nz"}),
+				TaxCode:              types.StringNull(),
+				IncludesTax:          types.BoolNull(),
+				AutomaticTaxes:       types.BoolValue(true),
+				GiftCardsTaxable:     types.BoolValue(false),
+				TaxProviderID:        types.StringValue("taxjar"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created, err := client.PostRegionsWithBodyWithResponse(ctx, "application/json", testJSONBody(t, tt.create.toCreateInput()))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			state := regionResourceModel{Metadata: tt.create.Metadata}
+			if err := state.fromRemote(created.JSON200, created.Body); err != nil {
+				t.Fatalf("%s: %s", err, created.Body)
+			}
+			tt.create.ID = state.ID
+			tt.create.CreatedAt, tt.create.UpdatedAt = state.CreatedAt, state.UpdatedAt
+			checkModel(t, tt.create, state)
+
+			prior := state
+			updated, err := client.PostRegionsRegionWithBodyWithResponse(ctx, state.ID.ValueString(), "application/json", testJSONBody(t, tt.update.toUpdateInput(&prior)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			state = regionResourceModel{Metadata: tt.update.Metadata}
+			if err := state.fromRemote(updated.JSON200, updated.Body); err != nil {
+				t.Fatalf("%s: %s", err, updated.Body)
+			}
+			tt.update.ID = state.ID
+			tt.update.CreatedAt, tt.update.UpdatedAt = state.CreatedAt, state.UpdatedAt
+			checkModel(t, tt.update, state)
+		})
+	}
+}
+
+func TestRegionModelProperties(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	plan := regionResourceModel{
+		Name:                 types.StringValue("Europe"),
+		CurrencyCode:         types.StringValue("eur"),
+		TaxRate:              testNumber(t, "0"),
+		PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		Countries:            utils.ConvertToTerraformStringSlice([]string{"nl"}),
+	}
+	created, err := client.PostRegionsWithBodyWithResponse(ctx, "application/json", testJSONBody(t, plan.toCreateInput()))
+	if err != nil || created.JSON200 == nil {
+		t.Fatalf("unable to create region: %v", err)
+	}
+	id := created.JSON200.Region.Id
+
+	checkModelProperty(t, func(name string, thousandths uint32, taxCode *string, includesTax, automaticTaxes, giftCardsTaxable bool, taxProvider *string) bool {
+		plan.Name = quickString(name)
+		plan.TaxRate = testNumber(t, strconv.FormatFloat(float64(thousandths%100001)/1000, 'f', -1, 64))
+		plan.TaxCode = quickOptionalString(taxCode)
+		plan.IncludesTax = types.BoolValue(includesTax)
+		plan.AutomaticTaxes = types.BoolValue(automaticTaxes)
+		plan.GiftCardsTaxable = types.BoolValue(giftCardsTaxable)
+		plan.TaxProviderID = quickOptionalString(taxProvider)
+
+		updated, err := client.PostRegionsRegionWithBodyWithResponse(ctx, id, "application/json", testJSONBody(t, plan.toUpdateInput(&regionResourceModel{})))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var state regionResourceModel
+		if err := state.fromRemote(updated.JSON200, updated.Body); err != nil {
+			t.Fatalf("%s: %s", err, updated.Body)
+		}
+		plan.ID = state.ID
+		plan.CreatedAt, plan.UpdatedAt = state.CreatedAt, state.UpdatedAt
+
+		// An unset tax code or provider keeps the previous value, as
+		// optional attributes are only sent when set.
+		if plan.TaxCode.IsNull() {
+			plan.TaxCode = state.TaxCode
+		}
+		if plan.TaxProviderID.IsNull() {
+			plan.TaxProviderID = state.TaxProviderID
+		}
+
+		diff := modelDiff(plan, state)
+		for _, d := range diff {
+			t.Log(d)
+		}
+		return len(diff) == 0
+	})
+}
+
+func TestRegionModelMetadata(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	plan := regionResourceModel{
+		Name:                 types.StringValue("Europe"),
+		CurrencyCode:         types.StringValue("eur"),
+		TaxRate:              testNumber(t, "0"),
+		PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		Countries:            utils.ConvertToTerraformStringSlice([]string{"nl"}),
+		Metadata:             testMetadata("erp_id", "42", "channel", "web"),
+	}
+	created, err := client.PostRegionsWithBodyWithResponse(ctx, "application/json", testJSONBody(t, plan.toCreateInput()))
+	if err != nil || created.JSON200 == nil {
+		t.Fatalf("unable to create region: %v", err)
+	}
+	state := plan
+	if err := state.fromRemote(created.JSON200, created.Body); err != nil {
+		t.Fatal(err)
+	}
+	id := state.ID.ValueString()
+
+	// Another integration adds its own key.
+	foreign := map[string]any{"metadata": map[string]any{"sync": "on"}}
+	if _, err := client.PostRegionsRegionWithBodyWithResponse(ctx, id, "application/json", testJSONBody(t, foreign)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Dropping a key from the plan removes it, and leaves the foreign key.
+	prior := state
+	plan.Metadata = testMetadata("erp_id", "43")
+	updated, err := client.PostRegionsRegionWithBodyWithResponse(ctx, id, "application/json", testJSONBody(t, plan.toUpdateInput(&prior)))
+	if err != nil || updated.JSON200 == nil {
+		t.Fatalf("unable to update region: %v", err)
+	}
+	want := map[string]any{"erp_id": "43", "sync": "on"}
+	if got := updated.JSON200.Region.Metadata; got == nil || !reflect.DeepEqual(*got, want) {
+		t.Errorf("remote metadata = %v, want %v", got, want)
+	}
+	state = plan
+	if err := state.fromRemote(updated.JSON200, updated.Body); err != nil {
+		t.Fatal(err)
+	}
+	plan.ID = state.ID
+	plan.AutomaticTaxes, plan.GiftCardsTaxable, plan.TaxProviderID = state.AutomaticTaxes, state.GiftCardsTaxable, state.TaxProviderID
+	plan.CreatedAt, plan.UpdatedAt = state.CreatedAt, state.UpdatedAt
+	checkModel(t, plan, state)
+
+	// A managed key changed outside of Terraform shows up as drift.
+	drift := map[string]any{"metadata": map[string]any{"erp_id": "99"}}
+	if _, err := client.PostRegionsRegionWithBodyWithResponse(ctx, id, "application/json", testJSONBody(t, drift)); err != nil {
+		t.Fatal(err)
+	}
+	read, err := client.GetRegionsRegionWithResponse(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := state.fromRemote(read.JSON200, read.Body); err != nil {
+		t.Fatal(err)
+	}
+	if got := state.Metadata["erp_id"].ValueString(); got != "99" {
+		t.Errorf("erp_id = %q, want the changed value 99", got)
+	}
+	if _, ok := state.Metadata["sync"]; ok {
+		t.Error("unmanaged key sync should not be tracked")
+	}
+}
+
+// An update only sends the attributes changed in the plan, so changes made
+// to the others outside of Terraform are kept.
+func TestRegionModelUpdateChanges(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	plan := regionResourceModel{
+		Name:                 types.StringValue("Europe"),
+		CurrencyCode:         types.StringValue("eur"),
+		TaxRate:              testNumber(t, "0"),
+		PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		Countries:            utils.ConvertToTerraformStringSlice([]string{"nl"}),
+		Metadata:             testMetadata("erp_id", "42"),
+	}
+	created, err := client.PostRegionsWithBodyWithResponse(ctx, "application/json", testJSONBody(t, plan.toCreateInput()))
+	if err != nil || created.JSON200 == nil {
+		t.Fatalf("unable to create region: %v", err)
+	}
+	state := plan
+	if err := state.fromRemote(created.JSON200, created.Body); err != nil {
+		t.Fatal(err)
+	}
+	id := state.ID.ValueString()
+
+	// Someone renames the region.
+	rename := map[string]any{"name": "Western Europe"}
+	if _, err := client.PostRegionsRegionWithBodyWithResponse(ctx, id, "application/json", testJSONBody(t, rename)); err != nil {
+		t.Fatal(err)
+	}
+
+	prior := state
+	plan = state
+	plan.TaxRate = testNumber(t, "21")
+	body, err := json.Marshal(plan.toUpdateInput(&prior))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(body), `{"tax_rate":21}`; got != want {
+		t.Errorf("request body = %s, want %s", got, want)
+	}
+
+	updated, err := client.PostRegionsRegionWithBodyWithResponse(ctx, id, "application/json", bytes.NewReader(body))
+	if err != nil || updated.JSON200 == nil {
+		t.Fatalf("unable to update region: %v", err)
+	}
+	if got := updated.JSON200.Region.Name; got != "Western Europe" {
+		t.Errorf("name = %q, want the concurrent change kept", got)
+	}
+	if got := updated.JSON200.Region.TaxRate; got != 21 {
+		t.Errorf("tax_rate = %v, want 21", got)
+	}
+}
+
+// Tax settings left out of the configuration are unknown in the plan, and
+// get the defaults of the server instead of being sent as false.
+func TestRegionModelServerDefaults(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	plan := regionResourceModel{
+		Name:                 types.StringValue("Europe"),
+		CurrencyCode:         types.StringValue("eur"),
+		TaxRate:              testNumber(t, "0"),
+		PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		Countries:            utils.ConvertToTerraformStringSlice([]string{"nl"}),
+		AutomaticTaxes:       types.BoolUnknown(),
+		GiftCardsTaxable:     types.BoolUnknown(),
+		TaxProviderID:        types.StringUnknown(),
+	}
+	created, err := client.PostRegionsWithBodyWithResponse(ctx, "application/json", testJSONBody(t, plan.toCreateInput()))
+	if err != nil || created.JSON200 == nil {
+		t.Fatalf("unable to create region: %v", err)
+	}
+
+	var state regionResourceModel
+	if err := state.fromRemote(created.JSON200, created.Body); err != nil {
+		t.Fatal(err)
+	}
+	if !state.AutomaticTaxes.ValueBool() || !state.GiftCardsTaxable.ValueBool() || !state.TaxProviderID.IsNull() {
+		t.Errorf("expected the server defaults, got automatic_taxes %s, gift_cards_taxable %s, tax_provider_id %s",
+			state.AutomaticTaxes, state.GiftCardsTaxable, state.TaxProviderID)
+	}
+	if state.CreatedAt.ValueString() == "" || state.UpdatedAt.ValueString() == "" {
+		t.Error("expected the timestamps of the region")
+	}
+}
+
+// Codes are sent in lower case, and the case of the configuration is kept
+// when Medusa returns the same codes.
+func TestRegionModelCodeCase(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	plan := regionResourceModel{
+		Name:                 types.StringValue("Europe"),
+		CurrencyCode:         types.StringValue("EUR"),
+		TaxRate:              testNumber(t, "0"),
+		PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		Countries:            utils.ConvertToTerraformStringSlice([]string{"NL", "be"}),
+		AutomaticTaxes:       types.BoolUnknown(),
+		GiftCardsTaxable:     types.BoolUnknown(),
+		TaxProviderID:        types.StringUnknown(),
+	}
+	created, err := client.PostRegionsWithBodyWithResponse(ctx, "application/json", testJSONBody(t, plan.toCreateInput()))
+	if err != nil || created.JSON200 == nil {
+		t.Fatalf("unable to create region: %v %s", err, created.Body)
+	}
+
+	state := plan
+	if err := state.fromRemote(created.JSON200, created.Body); err != nil {
+		t.Fatal(err)
+	}
+	if state.CurrencyCode.ValueString() != "EUR" {
+		t.Errorf("expected the configured currency EUR, got %s", state.CurrencyCode)
+	}
+	if countries := utils.ConvertToStringSlice(state.Countries); !reflect.DeepEqual(countries, []string{"be", "NL"}) {
+		t.Errorf("expected the configured countries, got %v", countries)
+	}
+}
+
+// A tax rate with more digits than a float32 holds is sent and kept as
+// configured, as Medusa returns it rounded.
+func TestRegionModelTaxRatePrecision(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	plan := regionResourceModel{
+		Name:                 types.StringValue("Europe"),
+		CurrencyCode:         types.StringValue("eur"),
+		TaxRate:              testNumber(t, "12.3456789"),
+		PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		Countries:            utils.ConvertToTerraformStringSlice([]string{"nl"}),
+		AutomaticTaxes:       types.BoolUnknown(),
+		GiftCardsTaxable:     types.BoolUnknown(),
+		TaxProviderID:        types.StringUnknown(),
+	}
+	body, err := json.Marshal(plan.toCreateInput())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(body, []byte(`"tax_rate":12.3456789`)) {
+		t.Errorf("expected the configured tax rate in %s", body)
+	}
+
+	created, err := client.PostRegionsWithBodyWithResponse(ctx, "application/json", bytes.NewReader(body))
+	if err != nil || created.JSON200 == nil {
+		t.Fatalf("unable to create region: %v", err)
+	}
+
+	state := plan
+	if err := state.fromRemote(created.JSON200, created.Body); err != nil {
+		t.Fatal(err)
+	}
+	if !state.TaxRate.Equal(plan.TaxRate) {
+		t.Errorf("expected the configured tax rate %s, got %s", plan.TaxRate, state.TaxRate)
+	}
+
+	// Imported regions have no configured tax rate to keep.
+	var imported regionResourceModel
+	if err := imported.fromRemote(created.JSON200, created.Body); err != nil {
+		t.Fatal(err)
+	}
+	if want := testNumber(t, "12.345679"); !imported.TaxRate.Equal(want) {
+		t.Errorf("expected the tax rate %s, got %s", want, imported.TaxRate)
+	}
+}
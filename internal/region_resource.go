@@ -2,25 +2,30 @@ package internal
 
 import (
 	"context"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
-
 	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+	"github.com/ikhvost/terraform-provider-medusa/internal/validators"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &regionResource{}
-	_ resource.ResourceWithConfigure   = &regionResource{}
-	_ resource.ResourceWithImportState = &regionResource{}
+	_ resource.Resource                 = &regionResource{}
+	_ resource.ResourceWithConfigure    = &regionResource{}
+	_ resource.ResourceWithImportState  = &regionResource{}
+	_ resource.ResourceWithModifyPlan   = &regionResource{}
+	_ resource.ResourceWithUpgradeState = &regionResource{}
 )
 
 // NewRegionResource is a helper function to simplify the provider implementation.
@@ -30,7 +35,8 @@ func NewRegionResource() resource.Resource {
 
 // regionResource is the resource implementation.
 type regionResource struct {
-	client medusa.ClientWithResponsesInterface
+	client utils.Client
+	plans  *regionPlans
 }
 
 // Metadata returns the data source type name.
@@ -41,6 +47,7 @@ func (r *regionResource) Metadata(_ context.Context, req resource.MetadataReques
 // Schema defines the schema for the data source.
 func (r *regionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Regions are different countries or geographical regions that the commerce store serves customers in.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -55,27 +62,39 @@ func (r *regionResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Required:    true,
 			},
 			"currency_code": schema.StringAttribute{
-				Description: "The 3 character ISO currency code to use in the region.",
+				Description: "The 3 character ISO 4217 currency code to use in the region. The code is not case sensitive.",
 				Required:    true,
+				Validators: []validator.String{
+					validators.CurrencyCode(),
+				},
 			},
 			"tax_rate": schema.NumberAttribute{
-				Description: "The tax rate to use in the region.",
-				Required:    true,
+				Description: "The tax rate to use in the region, as a percentage between 0 and 100. " +
+					"It is sent as written, and Medusa stores it with about seven significant digits.",
+				Required: true,
+				Validators: []validator.Number{
+					validators.NumberBetween(0, 100),
+				},
 			},
-			"payment_providers": schema.ListAttribute{
+			"payment_providers": schema.SetAttribute{
 				Description: "A list of payment provider ids that can be used in the region.",
 				Required:    true,
 				ElementType: types.StringType,
 			},
-			"fulfillment_providers": schema.ListAttribute{
+			"fulfillment_providers": schema.SetAttribute{
 				Description: "A list of fulfillment provider ids that can be used in the region.",
 				Required:    true,
 				ElementType: types.StringType,
 			},
-			"countries": schema.ListAttribute{
-				Description: "A list of countries' 2 ISO characters that should be included in the region.",
+			"countries": schema.SetAttribute{
+				Description: "A list of ISO 3166-1 alpha-2 country codes of the countries that should be included in the region. The codes are not case sensitive. " +
+					"A country can only be in one region, but it can be moved between regions of the configuration in a single apply. " +
+					"The plan fails when a country is in a region the configuration does not manage.",
 				Required:    true,
 				ElementType: types.StringType,
+				Validators: []validator.Set{
+					validators.CountryCodeSet(),
+				},
 			},
 			"tax_code": schema.StringAttribute{
 				Description: "The tax code of the region.",
@@ -85,17 +104,64 @@ func (r *regionResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description: "Whether taxes are included in the prices of the region.",
 				Optional:    true,
 			},
+			"metadata":            metadataAttribute("region"),
+			"deletion_protection": deletionProtectionAttribute("region"),
+			"automatic_taxes": schema.BoolAttribute{
+				Description: "Whether taxes are calculated automatically during checkout. Defaults to the server default, true.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"gift_cards_taxable": schema.BoolAttribute{
+				Description: "Whether taxes are applied to gift cards bought in the region. Defaults to the server default, true.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tax_provider_id": schema.StringAttribute{
+				Description: "The id of the tax provider used in the region. When not set, Medusa uses its system tax provider.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "The date with timezone at which the region was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "The date with timezone at which the region was last updated.",
+				Computed:    true,
+			},
 		},
 	}
 }
 
 // Configure adds the provider configured client to the data source.
-func (r *regionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (r *regionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	r.client = utils.GetClient(req.ProviderData)
+	client, d := utils.GetClient(req.ProviderData)
+	if d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	r.client = client
+	r.plans = newRegionPlans()
+	if c, ok := client.(*providerClient); ok {
+		r.plans = c.regionPlans
+	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -110,8 +176,25 @@ func (r *regionResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Generate API request body from plan
 	input := plan.toCreateInput()
+	body, err := utils.JSONBody(input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating region",
+			"Could not encode region request: "+err.Error(),
+		)
+		return
+	}
+
+	// Wait for the regions that give up the countries this one takes
+	if err := r.waitCountries(ctx, "", input.Countries); err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating region",
+			"Could not wait for the countries of the region to be given up: "+err.Error(),
+		)
+		return
+	}
 
-	content, err := r.client.PostRegionsWithResponse(ctx, input)
+	content, err := r.client.PostRegionsWithBodyWithResponse(ctx, "application/json", body)
 	if d := utils.CheckCreateError("region", content, err); d != nil {
 		resp.Diagnostics.Append(d)
 		return
@@ -121,7 +204,7 @@ func (r *regionResource) Create(ctx context.Context, req resource.CreateRequest,
 	tflog.Debug(ctx, spew.Sdump(resource))
 
 	// Map response body to schema
-	if err := plan.fromRemote(resource); err != nil {
+	if err := plan.fromRemote(resource, content.Body); err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating region",
 			"Could not create region, unexpected error: "+err.Error(),
@@ -135,6 +218,8 @@ func (r *regionResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.Region.UpdatedAt)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -157,13 +242,14 @@ func (r *regionResource) Read(ctx context.Context, req resource.ReadRequest, res
 	resource := content.JSON200
 
 	// Overwrite items with refreshed state
-	if err := state.fromRemote(resource); err != nil {
+	if err := state.fromRemote(resource, content.Body); err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading Region",
 			"Could not read Region "+state.ID.ValueString()+": "+err.Error(),
 		)
 		return
 	}
+	state.DeletionProtection = stateFlag(state.DeletionProtection)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -171,6 +257,8 @@ func (r *regionResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.Region.UpdatedAt)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -183,10 +271,83 @@ func (r *regionResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Generate API request body from plan
-	input := plan.toUpdateInput()
+	// The regions waiting for the countries this one gives up are told once
+	// they are given up, or the update failed.
+	defer r.plans.released(plan.ID.ValueString())
+
+	// Retrieve values from state, to know the metadata keys to remove
+	var state regionResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only attributes kept in the Terraform state changed, so there is
+	// nothing to send to Medusa
+	input := plan.toUpdateInput(&state)
+	if utils.NoChanges(input) {
+		state.DeletionProtection = plan.DeletionProtection
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
+	}
+
+	// Check that the region did not change since Terraform last read it
+	checkConcurrentChange(ctx, r.client, req.Private, "region", plan.ID.ValueString(), func() (time.Time, *diag.ErrorDiagnostic) {
+		content, err := r.client.GetRegionsRegionWithResponse(ctx, plan.ID.ValueString())
+		if d := utils.CheckGetError("region", plan.ID.ValueString(), content, err); d != nil {
+			return time.Time{}, d
+		}
+		return content.JSON200.Region.UpdatedAt, nil
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Give up the removed countries first, so they can be taken by other
+	// regions while this one takes new ones. A region that only takes
+	// countries gives up nothing, and does not wait for itself.
+	kept := keptCountries(plan.Countries, state.Countries)
+	if kept == nil && len(plan.Countries) > len(state.Countries) {
+		r.plans.released(plan.ID.ValueString())
+	}
+	if kept != nil {
+		body, err := utils.JSONBody(medusa.AdminPostRegionsRegionReq{Countries: &kept})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating region",
+				"Could not encode region request: "+err.Error(),
+			)
+			return
+		}
 
-	content, err := r.client.PostRegionsRegionWithResponse(ctx, plan.ID.ValueString(), input)
+		content, err := r.client.PostRegionsRegionWithBodyWithResponse(ctx, plan.ID.ValueString(), "application/json", body)
+		if d := utils.CheckUpdateError("region", content, err); d != nil {
+			resp.Diagnostics.Append(d)
+			return
+		}
+		r.plans.released(plan.ID.ValueString())
+	}
+
+	body, err := utils.JSONBody(input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating region",
+			"Could not encode region request: "+err.Error(),
+		)
+		return
+	}
+
+	// Wait for the regions that give up the countries this one takes
+	if err := r.waitCountries(ctx, plan.ID.ValueString(), addedCountries(plan.Countries, state.Countries)); err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating region",
+			"Could not wait for the countries of the region to be given up: "+err.Error(),
+		)
+		return
+	}
+
+	content, err := r.client.PostRegionsRegionWithBodyWithResponse(ctx, plan.ID.ValueString(), "application/json", body)
 	if d := utils.CheckUpdateError("region", content, err); d != nil {
 		resp.Diagnostics.Append(d)
 		return
@@ -196,7 +357,7 @@ func (r *regionResource) Update(ctx context.Context, req resource.UpdateRequest,
 	tflog.Debug(ctx, spew.Sdump(resource))
 
 	// Map response body to schema
-	if err := plan.fromRemote(resource); err != nil {
+	if err := plan.fromRemote(resource, content.Body); err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating region",
 			"Could not create region, unexpected error: "+err.Error(),
@@ -209,6 +370,8 @@ func (r *regionResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.Region.UpdatedAt)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -221,6 +384,14 @@ func (r *regionResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	// The regions waiting for its countries are told once it is deleted,
+	// or the delete failed.
+	defer r.plans.released(state.ID.ValueString())
+
+	if checkDeletionProtection(ctx, req.State, &resp.Diagnostics, "region") {
+		return
+	}
+
 	content, err := r.client.DeleteRegionsRegionWithResponse(ctx, state.ID.ValueString())
 	if d := utils.CheckDeleteError("region", content, err); d != nil {
 		resp.Diagnostics.Append(d)
@@ -228,7 +399,31 @@ func (r *regionResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// ImportState imports a region by id, or by its name as name:<name>.
 func (r *regionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importState(ctx, req, resp, "region", map[string]importLookup{
+		"name": r.findByName,
+	})
+}
+
+func (r *regionResource) findByName(ctx context.Context, name string) ([]importCandidate, error) {
+	return findAll(func(offset int) ([]importCandidate, int, error) {
+		limit := importPageSize
+		content, err := r.client.GetRegionsWithResponse(ctx, &medusa.GetRegionsParams{Q: &name, Offset: &offset, Limit: &limit})
+		if err != nil {
+			return nil, 0, err
+		}
+		if content.JSON200 == nil {
+			return nil, 0, listError(content.StatusCode(), content.Body)
+		}
+
+		// q also matches partial names and other fields.
+		var matches []importCandidate
+		for _, region := range content.JSON200.Regions {
+			if region.Name == name {
+				matches = append(matches, importCandidate{ID: region.Id, Label: region.CurrencyCode})
+			}
+		}
+		return matches, content.JSON200.Count, nil
+	})
 }
@@ -0,0 +1,446 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/clientfake"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+)
+
+func init() {
+	resource.AddTestSweepers("medusa_region", &resource.Sweeper{
+		Name:         "medusa_region",
+		Dependencies: []string{"medusa_shipping_option"},
+		F: sweep("medusa_region", listRegions, func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error) {
+			res, err := client.DeleteRegionsRegionWithResponse(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return res.StatusCode(), nil
+		}),
+	})
+}
+
+func listRegions(ctx context.Context, client medusa.ClientWithResponsesInterface, offset int) ([]sweepable, int, error) {
+	res, err := client.GetRegionsWithResponse(ctx, &medusa.GetRegionsParams{
+		Q:      ptr(testAccNamePrefix),
+		Offset: &offset,
+		Limit:  ptr(importPageSize),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if res.JSON200 == nil {
+		return nil, 0, listError(res.StatusCode(), res.Body)
+	}
+
+	var objects []sweepable
+	for _, region := range res.JSON200.Regions {
+		objects = append(objects, sweepable{ID: region.Id, Name: region.Name})
+	}
+	return objects, res.JSON200.Count, nil
+}
+
+func TestAccRegionResource(t *testing.T) {
+	h := newTestAccHarness(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		CheckDestroy: h.checkDestroy(t, "medusa_region", func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error) {
+			content, err := client.GetRegionsRegionWithResponse(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return content.StatusCode(), nil
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: h.providerConfig() + `
+resource "medusa_region" "test" {
+  name                  = "tf-acc-region"
+  currency_code         = "eur"
+  tax_rate              = 0
+  payment_providers     = ["manual"]
+  fulfillment_providers = ["manual"]
+  countries             = ["nl"]
+
+  metadata = {
+    erp_id  = "42"
+    channel = "web"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("medusa_region.test", "id"),
+					resource.TestCheckResourceAttr("medusa_region.test", "name", "tf-acc-region"),
+					resource.TestCheckResourceAttr("medusa_region.test", "currency_code", "eur"),
+					resource.TestCheckResourceAttr("medusa_region.test", "tax_rate", "0"),
+					resource.TestCheckResourceAttr("medusa_region.test", "countries.#", "1"),
+					resource.TestCheckTypeSetElemAttr("medusa_region.test", "countries.*", "nl"),
+					resource.TestCheckResourceAttr("medusa_region.test", "metadata.%", "2"),
+					resource.TestCheckResourceAttr("medusa_region.test", "metadata.erp_id", "42"),
+					resource.TestCheckResourceAttr("medusa_region.test", "automatic_taxes", "true"),
+					resource.TestCheckResourceAttr("medusa_region.test", "gift_cards_taxable", "true"),
+					resource.TestCheckNoResourceAttr("medusa_region.test", "tax_provider_id"),
+					resource.TestCheckResourceAttrSet("medusa_region.test", "created_at"),
+					resource.TestCheckResourceAttrSet("medusa_region.test", "updated_at"),
+				),
+			},
+			{
+				ResourceName:      "medusa_region.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported resources do not manage any metadata keys yet.
+				ImportStateVerifyIgnore: []string{"metadata"},
+			},
+			{
+				ResourceName:      "medusa_region.test",
+				ImportState:       true,
+				ImportStateId:     "name:tf-acc-region",
+				ImportStateVerify: true,
+				// Imported resources do not manage any metadata keys yet.
+				ImportStateVerifyIgnore: []string{"metadata"},
+			},
+			{
+				Config: h.providerConfig() + `
+resource "medusa_region" "test" {
+  name                  = "tf-acc-region-updated"
+  currency_code         = "eur"
+  tax_rate              = 12.345
+  payment_providers     = ["manual"]
+  fulfillment_providers = ["manual"]
+  countries             = ["nl", "be"]
+  tax_code              = "tf-acc"
+  includes_tax          = true
+  gift_cards_taxable    = false
+
+  metadata = {
+    erp_id = "43"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("medusa_region.test", "name", "tf-acc-region-updated"),
+					resource.TestCheckResourceAttr("medusa_region.test", "tax_rate", "12.345"),
+					resource.TestCheckResourceAttr("medusa_region.test", "countries.#", "2"),
+					resource.TestCheckTypeSetElemAttr("medusa_region.test", "countries.*", "be"),
+					resource.TestCheckResourceAttr("medusa_region.test", "tax_code", "tf-acc"),
+					resource.TestCheckResourceAttr("medusa_region.test", "includes_tax", "true"),
+					resource.TestCheckResourceAttr("medusa_region.test", "automatic_taxes", "true"),
+					resource.TestCheckResourceAttr("medusa_region.test", "gift_cards_taxable", "false"),
+					resource.TestCheckResourceAttr("medusa_region.test", "metadata.%", "1"),
+					resource.TestCheckResourceAttr("medusa_region.test", "metadata.erp_id", "43"),
+				),
+			},
+		},
+	})
+}
+
+// Invalid codes and tax rates are reported on plan, and codes that only
+// differ in case from the codes Medusa returns do not show a change.
+func TestAccRegionResourceCodes(t *testing.T) {
+	h := newTestAccHarness(t)
+
+	config := func(currency, country, taxRate string) string {
+		return h.providerConfig() + fmt.Sprintf(`
+resource "medusa_region" "test" {
+  name                  = "tf-acc-region-codes"
+  currency_code         = %q
+  tax_rate              = %s
+  payment_providers     = ["manual"]
+  fulfillment_providers = ["manual"]
+  countries             = [%q]
+}
+`, currency, taxRate, country)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      config("eur ", "nl", "0"),
+				ExpectError: regexp.MustCompile(`leading or trailing whitespace`),
+			},
+			{
+				Config:      config("eur", "UK", "0"),
+				ExpectError: regexp.MustCompile(`got "UK"`),
+			},
+			{
+				Config:      config("eur", "nl", "101"),
+				ExpectError: regexp.MustCompile(`value must be between 0 and 100`),
+			},
+			{
+				Config: config("EUR", "NL", "21"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("medusa_region.test", "currency_code", "EUR"),
+					resource.TestCheckTypeSetElemAttr("medusa_region.test", "countries.*", "NL"),
+				),
+			},
+		},
+	})
+}
+
+// Swapping countries between two regions of the configuration succeeds in a
+// single apply, while taking a country from a region that is not managed
+// fails on apply.
+func TestAccRegionResourceCountries(t *testing.T) {
+	h := newTestAccHarness(t)
+
+	config := func(a, b string) string {
+		return h.providerConfig() + fmt.Sprintf(`
+resource "medusa_region" "a" {
+  name                  = "tf-acc-region-a"
+  currency_code         = "eur"
+  tax_rate              = 0
+  payment_providers     = ["manual"]
+  fulfillment_providers = ["manual"]
+  countries             = [%q]
+}
+
+resource "medusa_region" "b" {
+  name                  = "tf-acc-region-b"
+  currency_code         = "eur"
+  tax_rate              = 0
+  payment_providers     = ["manual"]
+  fulfillment_providers = ["manual"]
+  countries             = [%q]
+}
+`, a, b)
+	}
+
+	// The plan waits a shorter time for the unmanaged region to be planned.
+	defer func(settle time.Duration) { regionPlanSettle = settle }(regionPlanSettle)
+	regionPlanSettle = 2 * time.Second
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("nl", "be"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("medusa_region.a", "countries.*", "nl"),
+					resource.TestCheckTypeSetElemAttr("medusa_region.b", "countries.*", "be"),
+				),
+			},
+			{
+				Config: config("be", "nl"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("medusa_region.a", "countries.*", "be"),
+					resource.TestCheckTypeSetElemAttr("medusa_region.b", "countries.*", "nl"),
+				),
+			},
+			{
+				PreConfig: func() {
+					res, err := h.client(t).PostRegionsWithResponse(context.Background(), medusa.PostRegionsJSONRequestBody{
+						Name:                 "tf-acc-region-unmanaged",
+						CurrencyCode:         "eur",
+						PaymentProviders:     []string{"manual"},
+						FulfillmentProviders: []string{"manual"},
+						Countries:            []string{"lu"},
+					})
+					if err != nil || res.JSON200 == nil {
+						t.Fatalf("could not create the unmanaged region: %v", err)
+					}
+					t.Cleanup(func() {
+						_, _ = h.client(t).DeleteRegionsRegionWithResponse(context.Background(), res.JSON200.Region.Id)
+					})
+				},
+				Config:      config("lu", "nl"),
+				ExpectError: regexp.MustCompile(`not managed by this\s+configuration`),
+			},
+		},
+	})
+}
+
+// A protected region is not destroyed until the protection is lifted. The
+// destroy of the test runs with the configuration of the last step, so it
+// ends with an unprotected region.
+func TestAccRegionResourceDeletionProtection(t *testing.T) {
+	h := newTestAccHarness(t)
+
+	config := func(protected bool) string {
+		return h.providerConfig() + fmt.Sprintf(`
+resource "medusa_region" "test" {
+  name                  = "tf-acc-region-protected"
+  currency_code         = "eur"
+  tax_rate              = 0
+  payment_providers     = ["manual"]
+  fulfillment_providers = ["manual"]
+  countries             = ["nl"]
+  deletion_protection   = %t
+}
+`, protected)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(true),
+				Check:  resource.TestCheckResourceAttr("medusa_region.test", "deletion_protection", "true"),
+			},
+			{
+				Config:      h.providerConfig(),
+				ExpectError: regexp.MustCompile(`deletion_protection set`),
+			},
+			{
+				Config: config(false),
+				Check:  resource.TestCheckResourceAttr("medusa_region.test", "deletion_protection", "false"),
+			},
+		},
+	})
+}
+
+func TestRegionResourceConfigure(t *testing.T) {
+	r := &regionResource{}
+
+	var resp fwresource.ConfigureResponse
+	r.Configure(context.Background(), fwresource.ConfigureRequest{ProviderData: "not a client"}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected a diagnostic for unexpected provider data")
+	}
+}
+
+func TestRegionResourceCreate(t *testing.T) {
+	ctx := context.Background()
+
+	plan := regionResourceModel{
+		ID:                   types.StringUnknown(),
+		Name:                 types.StringValue("Europe"),
+		CurrencyCode:         types.StringValue("eur"),
+		TaxRate:              testNumber(t, "12.345"),
+		PaymentProviders:     utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		FulfillmentProviders: utils.ConvertToTerraformStringSlice([]string{"manual"}),
+		Countries:            utils.ConvertToTerraformStringSlice([]string{"nl"}),
+		TaxCode:              types.StringNull(),
+		IncludesTax:          types.BoolNull(),
+		Metadata:             testMetadata("erp_id", "42"),
+		AutomaticTaxes:       types.BoolUnknown(),
+		GiftCardsTaxable:     types.BoolUnknown(),
+		TaxProviderID:        types.StringUnknown(),
+		CreatedAt:            types.StringUnknown(),
+		UpdatedAt:            types.StringUnknown(),
+	}
+	createdAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		respond func(body regionCreateInput) (*medusa.PostRegionsResponse, error)
+		wantErr bool
+	}{
+		{
+			name: "created",
+			respond: func(body regionCreateInput) (*medusa.PostRegionsResponse, error) {
+				if body.AutomaticTaxes != nil || body.GiftCardsTaxable != nil || body.TaxProviderID != nil {
+					t.Errorf("expected unknown tax settings to be left to the server, got %+v", body)
+				}
+				if body.TaxRate != "12.345" {
+					t.Errorf("expected the configured tax rate, got %s", body.TaxRate)
+				}
+				taxRate, err := body.TaxRate.Float64()
+				if err != nil {
+					t.Fatal(err)
+				}
+				countries := []medusa.Country{{Iso2: body.Countries[0]}}
+				providers := []medusa.PaymentProvider{{Id: body.PaymentProviders[0]}}
+				fulfillment := []medusa.FulfillmentProvider{{Id: body.FulfillmentProviders[0]}}
+				region := &medusa.AdminRegionsRes{Region: medusa.Region{
+					Id:                   "reg_01",
+					Name:                 body.Name,
+					CurrencyCode:         body.CurrencyCode,
+					TaxRate:              float32(taxRate),
+					Countries:            &countries,
+					PaymentProviders:     &providers,
+					FulfillmentProviders: &fulfillment,
+					Metadata:             body.Metadata,
+					AutomaticTaxes:       true,
+					GiftCardsTaxable:     true,
+					CreatedAt:            createdAt,
+					UpdatedAt:            createdAt,
+				}}
+				raw, err := json.Marshal(region)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return &medusa.PostRegionsResponse{
+					HTTPResponse: testResponse(http.StatusOK),
+					Body:         raw,
+					JSON200:      region,
+				}, nil
+			},
+		},
+		{
+			name: "rejected",
+			respond: func(regionCreateInput) (*medusa.PostRegionsResponse, error) {
+				return &medusa.PostRegionsResponse{
+					HTTPResponse: testResponse(http.StatusUnprocessableEntity),
+					Body:         []byte(`{"type":"duplicate_error","message":"NL already exists in region reg_00"}`),
+				}, nil
+			},
+			wantErr: true,
+		},
+		{
+			name: "transport error",
+			respond: func(regionCreateInput) (*medusa.PostRegionsResponse, error) {
+				return nil, errors.New("connection refused")
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &clientfake.FakeClient{
+				PostRegionsWithBodyWithResponseFunc: func(_ context.Context, contentType string, body io.Reader, _ ...medusa.RequestEditorFn) (*medusa.PostRegionsResponse, error) {
+					var input regionCreateInput
+					if err := json.NewDecoder(body).Decode(&input); err != nil || contentType != "application/json" {
+						t.Fatalf("unexpected %s request body: %v", contentType, err)
+					}
+					return tt.respond(input)
+				},
+			}
+			r := &regionResource{client: fake}
+			s := testResourceSchema(t, r)
+
+			req := fwresource.CreateRequest{Plan: testPlan(t, s, plan)}
+			resp := fwresource.CreateResponse{State: testState(t, s, nil)}
+			resp.Private = testPrivate(resp.Private)
+			r.Create(ctx, req, &resp)
+
+			if calls := fake.Calls(); len(calls) != 1 || calls[0] != "PostRegionsWithBodyWithResponse" {
+				t.Errorf("expected a single create call, got %v", calls)
+			}
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, resp.Diagnostics)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var state regionResourceModel
+			if diags := resp.State.Get(ctx, &state); diags.HasError() {
+				t.Fatal(diags)
+			}
+			want := plan
+			want.ID = types.StringValue("reg_01")
+			want.AutomaticTaxes = types.BoolValue(true)
+			want.GiftCardsTaxable = types.BoolValue(true)
+			want.TaxProviderID = types.StringNull()
+			want.CreatedAt = types.StringValue("2024-03-01T12:00:00Z")
+			want.UpdatedAt = types.StringValue("2024-03-01T12:00:00Z")
+			checkModel(t, want, state)
+		})
+	}
+}
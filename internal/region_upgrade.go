@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpgradeState migrates state written by earlier versions of the schema.
+func (r *regionResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		migrateState(regionSchemaV0(), upgradeRegionStateV0),
+	)
+}
+
+// regionResourceModelV0 is the model of schema version 0, which stored the
+// countries and providers as lists.
+type regionResourceModelV0 struct {
+	ID                   types.String            `tfsdk:"id"`
+	Name                 types.String            `tfsdk:"name"`
+	CurrencyCode         types.String            `tfsdk:"currency_code"`
+	TaxRate              types.Number            `tfsdk:"tax_rate"`
+	PaymentProviders     []types.String          `tfsdk:"payment_providers"`
+	FulfillmentProviders []types.String          `tfsdk:"fulfillment_providers"`
+	Countries            []types.String          `tfsdk:"countries"`
+	TaxCode              types.String            `tfsdk:"tax_code"`
+	IncludesTax          types.Bool              `tfsdk:"includes_tax"`
+	Metadata             map[string]types.String `tfsdk:"metadata"`
+}
+
+// regionSchemaV0 is the schema of version 0, with only what is needed to
+// read the state.
+func regionSchemaV0() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                    schema.StringAttribute{Computed: true},
+			"name":                  schema.StringAttribute{Required: true},
+			"currency_code":         schema.StringAttribute{Required: true},
+			"tax_rate":              schema.NumberAttribute{Required: true},
+			"payment_providers":     schema.ListAttribute{Required: true, ElementType: types.StringType},
+			"fulfillment_providers": schema.ListAttribute{Required: true, ElementType: types.StringType},
+			"countries":             schema.ListAttribute{Required: true, ElementType: types.StringType},
+			"tax_code":              schema.StringAttribute{Optional: true},
+			"includes_tax":          schema.BoolAttribute{Optional: true},
+			"metadata":              schema.MapAttribute{Optional: true, ElementType: types.StringType},
+		},
+	}
+}
+
+// upgradeRegionStateV0 turns the lists of version 0 into sets.
+func upgradeRegionStateV0(_ context.Context, prior regionResourceModelV0) (regionResourceModel, diag.Diagnostics) {
+	return regionResourceModel{
+		ID:                   prior.ID,
+		Name:                 prior.Name,
+		CurrencyCode:         prior.CurrencyCode,
+		TaxRate:              prior.TaxRate,
+		PaymentProviders:     uniqueStrings(prior.PaymentProviders),
+		FulfillmentProviders: uniqueStrings(prior.FulfillmentProviders),
+		Countries:            uniqueStrings(prior.Countries),
+		TaxCode:              prior.TaxCode,
+		IncludesTax:          prior.IncludesTax,
+		Metadata:             prior.Metadata,
+		AutomaticTaxes:       types.BoolNull(),
+		GiftCardsTaxable:     types.BoolNull(),
+		TaxProviderID:        types.StringNull(),
+		CreatedAt:            types.StringNull(),
+		UpdatedAt:            types.StringNull(),
+		DeletionProtection:   types.BoolNull(),
+	}, nil
+}
+
+// uniqueStrings drops repeated values, which a list may hold but a set may
+// not.
+func uniqueStrings(values []types.String) []types.String {
+	if values == nil {
+		return nil
+	}
+
+	seen := map[types.String]bool{}
+	result := make([]types.String, 0, len(values))
+	for _, v := range values {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		result = append(result, v)
+	}
+
+	return result
+}
@@ -0,0 +1,157 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultRetryOnStatus lists the status codes that are retried unless the
+// provider configuration overrides them with retry_on_status.
+var defaultRetryOnStatus = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// createRoutes lists the admin API routes that create a new object, as method
+// and path. Medusa uses POST for updates too, so the method alone does not
+// tell whether a request is safe to repeat.
+var createRoutes = []string{
+	"POST /admin/regions",
+	"POST /admin/sales-channels",
+	"POST /admin/shipping-profiles",
+	"POST /admin/customer-groups",
+	"POST /admin/product-categories",
+	"POST /admin/collections",
+}
+
+// retryPolicy holds the settings of the retrying HTTP client.
+type retryPolicy struct {
+	MaxRetries    int
+	WaitMin       time.Duration
+	WaitMax       time.Duration
+	RetryOnStatus []int
+}
+
+func defaultRetryPolicy() retryPolicy {
+	return retryPolicy{
+		WaitMin:       1 * time.Second,
+		WaitMax:       30 * time.Second,
+		RetryOnStatus: defaultRetryOnStatus,
+	}
+}
+
+// NewRetryTransport wraps the inner transport with retries according to the
+// policy. The wait between attempts follows the Retry-After header on 429 and
+// 503 responses and falls back to exponential backoff otherwise.
+func NewRetryTransport(innerTransport http.RoundTripper, policy retryPolicy) http.RoundTripper {
+	client := retryablehttp.NewClient()
+	client.HTTPClient = &http.Client{Transport: innerTransport}
+	client.Logger = nil
+	client.RetryMax = policy.MaxRetries
+	client.RetryWaitMin = policy.WaitMin
+	client.RetryWaitMax = policy.WaitMax
+	client.CheckRetry = policy.checkRetry
+	client.Backoff = retryablehttp.DefaultBackoff
+	client.RequestLogHook = logRetryAttempt
+
+	// Hand the last response back to the SDK once the retries are exhausted,
+	// so that the resources can report the actual API error.
+	client.ErrorHandler = retryablehttp.PassthroughErrorHandler
+
+	return &RetryTransport{client: client}
+}
+
+// RetryTransport is an http.RoundTripper that retries failed requests.
+type RetryTransport struct {
+	client *retryablehttp.Client
+}
+
+type retryRequestKey struct{}
+
+func (t *RetryTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	// CheckRetry only receives the request context, so the request is stored
+	// there to decide whether it may be repeated.
+	ctx := context.WithValue(request.Context(), retryRequestKey{}, request)
+
+	retryableRequest, err := retryablehttp.FromRequest(request.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return t.client.Do(retryableRequest)
+}
+
+func (p retryPolicy) checkRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	// Do not retry on context.Canceled or context.DeadlineExceeded
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	request, _ := ctx.Value(retryRequestKey{}).(*http.Request)
+
+	if err != nil {
+		// A refused connection never reached the server, so any request may
+		// be sent again.
+		if errors.Is(err, syscall.ECONNREFUSED) {
+			return true, nil
+		}
+
+		// Otherwise the body may have been received and processed. Sending a
+		// create again could produce a duplicate object.
+		if isCreateRequest(request) {
+			return false, nil
+		}
+
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
+
+	// Medusa and the proxies in front of it answer 429 from their rate
+	// limiters, which reject the request before it reaches the route handler.
+	// Nothing was created, so a create is safe to repeat too.
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return slices.Contains(p.RetryOnStatus, resp.StatusCode), nil
+	}
+
+	if isCreateRequest(request) {
+		return false, nil
+	}
+
+	return slices.Contains(p.RetryOnStatus, resp.StatusCode), nil
+}
+
+// isCreateRequest reports whether the request creates a new object. The API
+// may be served below a base path, so the route starts at the last /admin/.
+func isCreateRequest(request *http.Request) bool {
+	if request == nil {
+		return false
+	}
+
+	urlPath := strings.TrimSuffix(request.URL.Path, "/")
+	i := strings.LastIndex(urlPath, "/admin/")
+	if i < 0 {
+		return false
+	}
+	return slices.Contains(createRoutes, request.Method+" "+urlPath[i:])
+}
+
+func logRetryAttempt(_ retryablehttp.Logger, request *http.Request, attempt int) {
+	if attempt == 0 {
+		return
+	}
+	tflog.Debug(request.Context(), "Retrying Medusa API request", map[string]any{
+		"method":  request.Method,
+		"path":    request.URL.Path,
+		"attempt": attempt,
+	})
+}
@@ -0,0 +1,161 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestIsCreateRequest(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   bool
+	}{
+		{method: http.MethodPost, path: "/admin/regions", want: true},
+		{method: http.MethodPost, path: "/admin/regions/", want: true},
+		{method: http.MethodPost, path: "/medusa/admin/collections", want: true},
+		{method: http.MethodGet, path: "/admin/regions"},
+		{method: http.MethodPost, path: "/admin/regions/reg_01"},
+		{method: http.MethodPost, path: "/admin/regions/reg_01/countries"},
+		{method: http.MethodPost, path: "/admin/store/regions"},
+		{method: http.MethodPost, path: "/regions"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			request := &http.Request{Method: tt.method, URL: &url.URL{Path: tt.path}}
+			if got := isCreateRequest(request); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyCheckRetry(t *testing.T) {
+	create := &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "/admin/regions"}}
+	update := &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "/admin/regions/reg_01"}}
+	refused := &url.Error{Op: "Post", URL: "http://localhost:9000/admin/regions", Err: &os.SyscallError{Syscall: "connect", Err: syscall.ECONNREFUSED}}
+	reset := &url.Error{Op: "Post", URL: "http://localhost:9000/admin/regions", Err: &os.SyscallError{Syscall: "read", Err: syscall.ECONNRESET}}
+
+	tests := []struct {
+		name      string
+		request   *http.Request
+		status    int
+		err       error
+		cancelled bool
+		want      bool
+	}{
+		{name: "too many requests on create", request: create, status: http.StatusTooManyRequests, want: true},
+		{name: "too many requests on update", request: update, status: http.StatusTooManyRequests, want: true},
+		{name: "server error on create", request: create, status: http.StatusInternalServerError},
+		{name: "server error on update", request: update, status: http.StatusInternalServerError, want: true},
+		{name: "client error on update", request: update, status: http.StatusBadRequest},
+		{name: "connection refused on create", request: create, err: refused, want: true},
+		{name: "connection reset on create", request: create, err: reset},
+		{name: "connection reset on update", request: update, err: reset, want: true},
+		{name: "cancelled", request: update, status: http.StatusServiceUnavailable, cancelled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.WithValue(context.Background(), retryRequestKey{}, tt.request))
+			defer cancel()
+			if tt.cancelled {
+				cancel()
+			}
+
+			var resp *http.Response
+			if tt.err == nil {
+				resp = &http.Response{StatusCode: tt.status, Request: tt.request}
+			}
+
+			retry, err := defaultRetryPolicy().checkRetry(ctx, resp, tt.err)
+			if retry != tt.want {
+				t.Errorf("expected retry %v, got %v", tt.want, retry)
+			}
+			if tt.cancelled && !errors.Is(err, context.Canceled) {
+				t.Errorf("expected the cancellation, got %v", err)
+			}
+		})
+	}
+}
+
+// The transport waits as long as the Retry-After header asks before it sends
+// a rejected create again.
+func TestRetryTransportRetryAfter(t *testing.T) {
+	var attempts []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts = append(attempts, time.Now())
+		if len(attempts) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	policy := defaultRetryPolicy()
+	policy.MaxRetries = 2
+	policy.WaitMin = time.Millisecond
+	client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, policy)}
+
+	resp, err := client.Post(server.URL+"/admin/regions", "application/json", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || len(attempts) != 2 {
+		t.Fatalf("expected a successful second attempt, got status %d after %d attempts", resp.StatusCode, len(attempts))
+	}
+	if wait := attempts[1].Sub(attempts[0]); wait < time.Second {
+		t.Errorf("expected to wait for the Retry-After header, waited %s", wait)
+	}
+}
+
+// A create that fails on the server is not sent again, and the failed
+// response is handed back once the retries of other requests run out.
+func TestRetryTransportGiveUp(t *testing.T) {
+	tests := []struct {
+		path         string
+		wantAttempts int
+	}{
+		{path: "/admin/regions", wantAttempts: 1},
+		{path: "/admin/regions/reg_01", wantAttempts: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				w.WriteHeader(http.StatusBadGateway)
+			}))
+			defer server.Close()
+
+			policy := defaultRetryPolicy()
+			policy.MaxRetries = 2
+			policy.WaitMin = time.Millisecond
+			policy.WaitMax = time.Millisecond
+			client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, policy)}
+
+			resp, err := client.Post(server.URL+tt.path, "application/json", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != http.StatusBadGateway || attempts != tt.wantAttempts {
+				t.Errorf("expected status %d after %d attempts, got %d after %d", http.StatusBadGateway, tt.wantAttempts, resp.StatusCode, attempts)
+			}
+		})
+	}
+}
@@ -5,29 +5,75 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
 )
 
 // salesChannelResourceModel maps the resource schema data.
 type salesChannelResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	IsDisabled  types.Bool   `tfsdk:"is_disabled"`
+	ID          types.String            `tfsdk:"id"`
+	Name        types.String            `tfsdk:"name"`
+	Description types.String            `tfsdk:"description"`
+	IsDisabled  types.Bool              `tfsdk:"is_disabled"`
+	Metadata    map[string]types.String `tfsdk:"metadata"`
+
+	AdoptExisting      types.Bool `tfsdk:"adopt_existing"`
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
+// The name and description Medusa gives the default sales channel it creates
+// on install.
+const (
+	defaultSalesChannelName        = "Default Sales Channel"
+	defaultSalesChannelDescription = "Created by Medusa"
+)
+
+// salesChannelCreateInput adds the metadata, which Medusa accepts for a sales
+// channel but the SDK request type does not model.
+type salesChannelCreateInput struct {
+	medusa.AdminPostSalesChannelsReq
+	Metadata *map[string]any `json:"metadata,omitempty"`
+}
+
+// salesChannelUpdateInput adds the metadata to the SDK update request.
+type salesChannelUpdateInput struct {
+	medusa.AdminPostSalesChannelsSalesChannelReq
+	Metadata *map[string]any `json:"metadata,omitempty"`
+}
+
+func (m *salesChannelResourceModel) toCreateInput() salesChannelCreateInput {
+	return salesChannelCreateInput{
+		AdminPostSalesChannelsReq: medusa.AdminPostSalesChannelsReq{
+			Name:        m.Name.ValueString(),
+			Description: m.Description.ValueStringPointer(),
+			IsDisabled:  m.IsDisabled.ValueBoolPointer(),
+		},
+		Metadata: utils.ConvertToMetadata(m.Metadata),
+	}
 }
 
-func (m *salesChannelResourceModel) toCreateInput() medusa.AdminPostSalesChannelsReq {
-	return medusa.AdminPostSalesChannelsReq{
-		Name:        m.Name.ValueString(),
-		Description: m.Description.ValueStringPointer(),
-		IsDisabled:  m.IsDisabled.ValueBoolPointer(),
+func (m *salesChannelResourceModel) toUpdateInput(prior *salesChannelResourceModel) salesChannelUpdateInput {
+	return salesChannelUpdateInput{
+		AdminPostSalesChannelsSalesChannelReq: medusa.AdminPostSalesChannelsSalesChannelReq{
+			Name:        utils.ChangedString(m.Name, prior.Name),
+			Description: utils.ChangedString(m.Description, prior.Description),
+			IsDisabled:  utils.ChangedBool(m.IsDisabled, prior.IsDisabled),
+		},
+		Metadata: utils.ConvertToMetadataUpdate(m.Metadata, prior.Metadata),
 	}
 }
 
-func (m *salesChannelResourceModel) toUpdateInput() medusa.AdminPostSalesChannelsSalesChannelReq {
-	return medusa.AdminPostSalesChannelsSalesChannelReq{
-		Name:        m.Name.ValueStringPointer(),
-		Description: m.Description.ValueStringPointer(),
-		IsDisabled:  m.IsDisabled.ValueBoolPointer(),
+// toResetInput returns the request that gives an adopted default sales
+// channel back its name and description, enables it, and removes the
+// metadata keys the resource managed.
+func (m *salesChannelResourceModel) toResetInput() salesChannelUpdateInput {
+	name, description, disabled := defaultSalesChannelName, defaultSalesChannelDescription, false
+	return salesChannelUpdateInput{
+		AdminPostSalesChannelsSalesChannelReq: medusa.AdminPostSalesChannelsSalesChannelReq{
+			Name:        &name,
+			Description: &description,
+			IsDisabled:  &disabled,
+		},
+		Metadata: utils.ConvertToMetadataUpdate(nil, m.Metadata),
 	}
 }
 
@@ -40,6 +86,7 @@ func (m *salesChannelResourceModel) fromRemote(c *medusa.AdminSalesChannelsRes)
 	m.Name = types.StringValue(c.SalesChannel.Name)
 	m.Description = types.StringPointerValue(c.SalesChannel.Description)
 	m.IsDisabled = types.BoolValue(c.SalesChannel.IsDisabled)
+	m.Metadata = utils.ConvertToTerraformMetadata(m.Metadata, c.SalesChannel.Metadata)
 
 	return nil
 }
@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSalesChannelModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	tests := []struct {
+		name   string
+		create salesChannelResourceModel
+		update salesChannelResourceModel
+	}{
+		{
+			name: "name only",
+			create: salesChannelResourceModel{
+				Name:        types.StringValue("Web"),
+				Description: types.StringNull(),
+				IsDisabled:  types.BoolValue(false),
+			},
+			update: salesChannelResourceModel{
+				Name:        types.StringValue("Webshop"),
+				Description: types.StringValue("The webshop"),
+				IsDisabled:  types.BoolValue(true),
+			},
+		},
+		{
+			name: "metadata",
+			create: salesChannelResourceModel{
+				Name:        types.StringValue("Web"),
+				Description: types.StringNull(),
+				IsDisabled:  types.BoolValue(false),
+				Metadata:    testMetadata("erp_id", "42", "channel", "web"),
+			},
+			update: salesChannelResourceModel{
+				Name:        types.StringValue("Webshop"),
+				Description: types.StringValue("The webshop"),
+				IsDisabled:  types.BoolValue(true),
+				Metadata:    testMetadata("erp_id", "43"),
+			},
+		},
+		{
+			name: "empty description",
+			create: salesChannelResourceModel{
+				Name:        types.StringValue("App"),
+				Description: types.StringValue(""),
+				IsDisabled:  types.BoolValue(true),
+			},
+			update: salesChannelResourceModel{
+				Name:        types.StringValue("App"),
+				Description: types.StringValue("Mobile app"),
+				IsDisabled:  types.BoolValue(false),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created, err := client.PostSalesChannelsWithBodyWithResponse(ctx, "application/json", testJSONBody(t, tt.create.toCreateInput()))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			state := salesChannelResourceModel{Metadata: tt.create.Metadata}
+			if err := state.fromRemote(created.JSON200); err != nil {
+				t.Fatalf("%s: %s", err, created.Body)
+			}
+			tt.create.ID = state.ID
+			checkModel(t, tt.create, state)
+
+			prior := state
+			updated, err := client.PostSalesChannelsSalesChannelWithBodyWithResponse(ctx, state.ID.ValueString(), "application/json", testJSONBody(t, tt.update.toUpdateInput(&prior)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			state = salesChannelResourceModel{Metadata: tt.update.Metadata}
+			if err := state.fromRemote(updated.JSON200); err != nil {
+				t.Fatalf("%s: %s", err, updated.Body)
+			}
+			tt.update.ID = state.ID
+			checkModel(t, tt.update, state)
+		})
+	}
+}
+
+func TestSalesChannelModelProperties(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	checkModelProperty(t, func(name string, description *string, disabled bool) bool {
+		plan := salesChannelResourceModel{
+			Name:        quickString(name),
+			Description: quickOptionalString(description),
+			IsDisabled:  types.BoolValue(disabled),
+		}
+
+		created, err := client.PostSalesChannelsWithBodyWithResponse(ctx, "application/json", testJSONBody(t, plan.toCreateInput()))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var state salesChannelResourceModel
+		if err := state.fromRemote(created.JSON200); err != nil {
+			t.Fatalf("%s: %s", err, created.Body)
+		}
+		plan.ID = state.ID
+
+		diff := modelDiff(plan, state)
+		for _, d := range diff {
+			t.Log(d)
+		}
+		return len(diff) == 0
+	})
+}
@@ -2,17 +2,20 @@ package internal
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
-
 	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -20,6 +23,7 @@ var (
 	_ resource.Resource                = &salesChannelResource{}
 	_ resource.ResourceWithConfigure   = &salesChannelResource{}
 	_ resource.ResourceWithImportState = &salesChannelResource{}
+	_ resource.ResourceWithModifyPlan  = &salesChannelResource{}
 )
 
 // NewSalesChannelResource is a helper function to simplify the provider implementation.
@@ -29,7 +33,7 @@ func NewSalesChannelResource() resource.Resource {
 
 // salesChannelResource is the resource implementation.
 type salesChannelResource struct {
-	client medusa.ClientWithResponsesInterface
+	client utils.Client
 }
 
 // Metadata returns the data source type name.
@@ -62,17 +66,29 @@ func (r *salesChannelResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:    true,
 				Optional:    true,
 			},
+			"metadata":            metadataAttribute("sales channel"),
+			"deletion_protection": deletionProtectionAttribute("sales channel"),
+			"adopt_existing": adoptExistingAttribute(
+				"the default sales channel of the store, which Medusa creates on install,",
+				"gives the sales channel its name and description back, enables it and removes the metadata keys set here",
+			),
 		},
 	}
 }
 
 // Configure adds the provider configured client to the data source.
-func (r *salesChannelResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (r *salesChannelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	r.client = utils.GetClient(req.ProviderData)
+	client, d := utils.GetClient(req.ProviderData)
+	if d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	r.client = client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -85,16 +101,43 @@ func (r *salesChannelResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	// Generate API request body from plan
-	input := plan.toCreateInput()
+	var resource *medusa.AdminSalesChannelsRes
+	if plan.AdoptExisting.ValueBool() {
+		// Take over the default sales channel, and set it to the plan.
+		id, err := r.findDefault(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating sales_channel",
+				"Could not adopt the default sales_channel: "+err.Error(),
+			)
+			return
+		}
+		plan.ID = types.StringValue(id)
 
-	content, err := r.client.PostSalesChannelsWithResponse(ctx, input)
-	if d := utils.CheckCreateError("sales_channel", content, err); d != nil {
-		resp.Diagnostics.Append(d)
-		return
-	}
+		resource = r.update(ctx, id, plan.toUpdateInput(&salesChannelResourceModel{}), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		// Generate API request body from plan
+		input := plan.toCreateInput()
 
-	resource := content.JSON200
+		body, err := utils.JSONBody(input)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating sales channel",
+				"Could not encode sales channel request: "+err.Error(),
+			)
+			return
+		}
+
+		content, err := r.client.PostSalesChannelsWithBodyWithResponse(ctx, "application/json", body)
+		if d := utils.CheckCreateError("sales_channel", content, err); d != nil {
+			resp.Diagnostics.Append(d)
+			return
+		}
+		resource = content.JSON200
+	}
 	tflog.Debug(ctx, spew.Sdump(resource))
 
 	// Map response body to schema
@@ -112,6 +155,8 @@ func (r *salesChannelResource) Create(ctx context.Context, req resource.CreateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.SalesChannel.UpdatedAt)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -141,6 +186,8 @@ func (r *salesChannelResource) Read(ctx context.Context, req resource.ReadReques
 		)
 		return
 	}
+	state.AdoptExisting = stateFlag(state.AdoptExisting)
+	state.DeletionProtection = stateFlag(state.DeletionProtection)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -148,6 +195,8 @@ func (r *salesChannelResource) Read(ctx context.Context, req resource.ReadReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.SalesChannel.UpdatedAt)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -160,16 +209,40 @@ func (r *salesChannelResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	// Generate API request body from plan
-	input := plan.toUpdateInput()
+	// Retrieve values from state, to know the metadata keys to remove
+	var state salesChannelResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	content, err := r.client.PostSalesChannelsSalesChannelWithResponse(ctx, plan.ID.ValueString(), input)
-	if d := utils.CheckUpdateError("sales_channel", content, err); d != nil {
-		resp.Diagnostics.Append(d)
+	// Only attributes kept in the Terraform state changed, so there is
+	// nothing to send to Medusa
+	input := plan.toUpdateInput(&state)
+	if utils.NoChanges(input) {
+		state.AdoptExisting = plan.AdoptExisting
+		state.DeletionProtection = plan.DeletionProtection
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 		return
 	}
 
-	resource := content.JSON200
+	// Check that the sales channel did not change since Terraform last read it
+	checkConcurrentChange(ctx, r.client, req.Private, "sales channel", plan.ID.ValueString(), func() (time.Time, *diag.ErrorDiagnostic) {
+		content, err := r.client.GetSalesChannelsSalesChannelWithResponse(ctx, plan.ID.ValueString())
+		if d := utils.CheckGetError("sales_channel", plan.ID.ValueString(), content, err); d != nil {
+			return time.Time{}, d
+		}
+		return content.JSON200.SalesChannel.UpdatedAt, nil
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resource := r.update(ctx, plan.ID.ValueString(), input, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Debug(ctx, spew.Sdump(resource))
 
 	// Map response body to schema
@@ -186,6 +259,8 @@ func (r *salesChannelResource) Update(ctx context.Context, req resource.UpdateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.SalesChannel.UpdatedAt)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -198,6 +273,16 @@ func (r *salesChannelResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	if checkDeletionProtection(ctx, req.State, &resp.Diagnostics, "sales_channel") {
+		return
+	}
+
+	// The default sales channel cannot be deleted, so it is reset instead.
+	if state.AdoptExisting.ValueBool() {
+		r.update(ctx, state.ID.ValueString(), state.toResetInput(), &resp.Diagnostics)
+		return
+	}
+
 	content, err := r.client.DeleteSalesChannelsSalesChannelWithResponse(ctx, state.ID.ValueString())
 	if d := utils.CheckDeleteError("sales_channel", content, err); d != nil {
 		resp.Diagnostics.Append(d)
@@ -205,7 +290,75 @@ func (r *salesChannelResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 }
 
+// update sends input to the sales channel with the given id, and returns the
+// updated sales channel.
+func (r *salesChannelResource) update(ctx context.Context, id string, input salesChannelUpdateInput, diags *diag.Diagnostics) *medusa.AdminSalesChannelsRes {
+	body, err := utils.JSONBody(input)
+	if err != nil {
+		diags.AddError(
+			"Error updating sales channel",
+			"Could not encode sales channel request: "+err.Error(),
+		)
+		return nil
+	}
+
+	content, err := r.client.PostSalesChannelsSalesChannelWithBodyWithResponse(ctx, id, "application/json", body)
+	if d := utils.CheckUpdateError("sales_channel", content, err); d != nil {
+		diags.Append(d)
+		return nil
+	}
+	return content.JSON200
+}
+
+// findDefault returns the id of the default sales channel of the store.
+func (r *salesChannelResource) findDefault(ctx context.Context) (string, error) {
+	content, err := r.client.GetStoreWithResponse(ctx)
+	if err != nil {
+		return "", err
+	}
+	if content.JSON200 == nil {
+		return "", listError(content.StatusCode(), content.Body)
+	}
+	if content.JSON200.Store.DefaultSalesChannelId == nil {
+		return "", fmt.Errorf("the store has no default sales channel")
+	}
+	return *content.JSON200.Store.DefaultSalesChannelId, nil
+}
+
+// ModifyPlan warns when a protected sales channel would be destroyed, or
+// replaced by a change of adopt_existing.
+func (r *salesChannelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnDeletionProtection(ctx, req, resp, "sales channel", path.Root("adopt_existing"))
+}
+
+// ImportState imports a sales channel by id, or by its name as name:<name>.
 func (r *salesChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importState(ctx, req, resp, "sales channel", map[string]importLookup{
+		"name": r.findByName,
+	})
+}
+
+func (r *salesChannelResource) findByName(ctx context.Context, name string) ([]importCandidate, error) {
+	return findAll(func(offset int) ([]importCandidate, int, error) {
+		limit := importPageSize
+		content, err := r.client.GetSalesChannelsWithResponse(ctx, &medusa.GetSalesChannelsParams{Name: &name, Offset: &offset, Limit: &limit})
+		if err != nil {
+			return nil, 0, err
+		}
+		if content.JSON200 == nil {
+			return nil, 0, listError(content.StatusCode(), content.Body)
+		}
+
+		var matches []importCandidate
+		for _, channel := range content.JSON200.SalesChannels {
+			if channel.Name == name {
+				label := channel.Name
+				if channel.Description != nil {
+					label = *channel.Description
+				}
+				matches = append(matches, importCandidate{ID: channel.Id, Label: label})
+			}
+		}
+		return matches, content.JSON200.Count, nil
+	})
 }
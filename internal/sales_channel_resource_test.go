@@ -0,0 +1,171 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+func init() {
+	resource.AddTestSweepers("medusa_sales_channel", &resource.Sweeper{
+		Name: "medusa_sales_channel",
+		F: sweep("medusa_sales_channel", listSalesChannels, func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error) {
+			res, err := client.DeleteSalesChannelsSalesChannelWithResponse(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return res.StatusCode(), nil
+		}),
+	})
+}
+
+func listSalesChannels(ctx context.Context, client medusa.ClientWithResponsesInterface, offset int) ([]sweepable, int, error) {
+	res, err := client.GetSalesChannelsWithResponse(ctx, &medusa.GetSalesChannelsParams{
+		Q:      ptr(testAccNamePrefix),
+		Offset: &offset,
+		Limit:  ptr(importPageSize),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if res.JSON200 == nil {
+		return nil, 0, listError(res.StatusCode(), res.Body)
+	}
+
+	var objects []sweepable
+	for _, channel := range res.JSON200.SalesChannels {
+		objects = append(objects, sweepable{ID: channel.Id, Name: channel.Name})
+	}
+	return objects, res.JSON200.Count, nil
+}
+
+func TestAccSalesChannelResource(t *testing.T) {
+	h := newTestAccHarness(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		CheckDestroy: h.checkDestroy(t, "medusa_sales_channel", func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error) {
+			content, err := client.GetSalesChannelsSalesChannelWithResponse(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return content.StatusCode(), nil
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: h.providerConfig() + `
+resource "medusa_sales_channel" "test" {
+  name        = "tf-acc-sales-channel"
+  description = "created by an acceptance test"
+  is_disabled = false
+
+  metadata = {
+    erp_id = "42"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("medusa_sales_channel.test", "id"),
+					resource.TestCheckResourceAttr("medusa_sales_channel.test", "name", "tf-acc-sales-channel"),
+					resource.TestCheckResourceAttr("medusa_sales_channel.test", "description", "created by an acceptance test"),
+					resource.TestCheckResourceAttr("medusa_sales_channel.test", "is_disabled", "false"),
+					resource.TestCheckResourceAttr("medusa_sales_channel.test", "metadata.erp_id", "42"),
+				),
+			},
+			{
+				ResourceName:      "medusa_sales_channel.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported resources do not manage any metadata keys yet.
+				ImportStateVerifyIgnore: []string{"metadata"},
+			},
+			{
+				ResourceName:            "medusa_sales_channel.test",
+				ImportState:             true,
+				ImportStateId:           "name:tf-acc-sales-channel",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata"},
+			},
+			{
+				Config: h.providerConfig() + `
+resource "medusa_sales_channel" "test" {
+  name        = "tf-acc-sales-channel-updated"
+  description = "updated by an acceptance test"
+  is_disabled = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("medusa_sales_channel.test", "name", "tf-acc-sales-channel-updated"),
+					resource.TestCheckResourceAttr("medusa_sales_channel.test", "description", "updated by an acceptance test"),
+					resource.TestCheckResourceAttr("medusa_sales_channel.test", "is_disabled", "true"),
+					resource.TestCheckNoResourceAttr("medusa_sales_channel.test", "metadata"),
+				),
+			},
+		},
+	})
+}
+
+// The default sales channel is taken over rather than created, and reset on
+// destroy. It is not named with testAccNamePrefix, so the sweeper never
+// tries to delete it.
+func TestAccSalesChannelResourceAdopt(t *testing.T) {
+	h := newTestAccHarness(t)
+
+	defaultChannel := func(check func(channel medusa.SalesChannel) error) func(*terraform.State) error {
+		return func(*terraform.State) error {
+			ctx := context.Background()
+			client := h.client(t)
+
+			store, err := client.GetStoreWithResponse(ctx)
+			if err != nil {
+				return err
+			}
+			if store.JSON200 == nil || store.JSON200.Store.DefaultSalesChannelId == nil {
+				return fmt.Errorf("could not read the default sales channel: %s", store.Body)
+			}
+			res, err := client.GetSalesChannelsSalesChannelWithResponse(ctx, *store.JSON200.Store.DefaultSalesChannelId)
+			if err != nil {
+				return err
+			}
+			if res.JSON200 == nil {
+				return fmt.Errorf("could not read the default sales channel: %s", res.Body)
+			}
+			return check(res.JSON200.SalesChannel)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		CheckDestroy: defaultChannel(func(channel medusa.SalesChannel) error {
+			if channel.Name != defaultSalesChannelName || channel.IsDisabled {
+				return fmt.Errorf("expected the default sales channel to be reset, got %q (disabled: %t)", channel.Name, channel.IsDisabled)
+			}
+			return nil
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: h.providerConfig() + `
+resource "medusa_sales_channel" "test" {
+  name           = "Web"
+  description    = "Adopted by an acceptance test"
+  is_disabled    = true
+  adopt_existing = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("medusa_sales_channel.test", "adopt_existing", "true"),
+					defaultChannel(func(channel medusa.SalesChannel) error {
+						if channel.Name != "Web" || !channel.IsDisabled {
+							return fmt.Errorf("expected the default sales channel to be adopted, got %q (disabled: %t)", channel.Name, channel.IsDisabled)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
@@ -1,30 +1,115 @@
 package internal
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
 )
 
 // shippingProfileResourceModel maps the resource schema data.
 type shippingProfileResourceModel struct {
-	ID   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
-	Type types.String `tfsdk:"type"`
+	ID       types.String            `tfsdk:"id"`
+	Name     types.String            `tfsdk:"name"`
+	Type     types.String            `tfsdk:"type"`
+	Metadata map[string]types.String `tfsdk:"metadata"`
+
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
+}
+
+// builtinShippingProfileNames are the names Medusa gives the shipping
+// profiles it creates on install, by type.
+var builtinShippingProfileNames = map[string]string{
+	"default":   "Default Shipping Profile",
+	"gift_card": "Gift Card Profile",
+}
+
+// shippingProfileSettings are the settings of a built-in profile before
+// Terraform adopted it, kept in private state to restore them on destroy.
+type shippingProfileSettings struct {
+	Name     string         `json:"name"`
+	Metadata map[string]any `json:"metadata"`
+}
+
+// shippingProfileSettingsKey is the private state key of the original
+// settings.
+const shippingProfileSettingsKey = "original_settings"
+
+func newShippingProfileSettings(c *medusa.AdminShippingProfilesRes) (*shippingProfileSettings, error) {
+	if c == nil {
+		return nil, fmt.Errorf("shipping_profile is nil")
+	}
+
+	settings := &shippingProfileSettings{Name: c.ShippingProfile.Name}
+	if c.ShippingProfile.Metadata != nil {
+		settings.Metadata = *c.ShippingProfile.Metadata
+	}
+	return settings, nil
+}
+
+// readShippingProfileSettings decodes the original settings from private
+// state, or returns nil when there are none.
+func readShippingProfileSettings(b []byte) (*shippingProfileSettings, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+
+	var settings shippingProfileSettings
+	if err := json.Unmarshal(b, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
 }
 
 func (m *shippingProfileResourceModel) toCreateInput() medusa.AdminPostShippingProfilesReq {
 	return medusa.AdminPostShippingProfilesReq{
-		Name: m.Name.ValueString(),
-		Type: m.Type.ValueString(),
+		Name:     m.Name.ValueString(),
+		Type:     m.Type.ValueString(),
+		Metadata: utils.ConvertToMetadata(m.Metadata),
+	}
+}
+
+func (m *shippingProfileResourceModel) toUpdateInput(prior *shippingProfileResourceModel) medusa.AdminPostShippingProfilesProfileReq {
+	return medusa.AdminPostShippingProfilesProfileReq{
+		Name:     utils.ChangedString(m.Name, prior.Name),
+		Type:     utils.ChangedString(m.Type, prior.Type),
+		Metadata: utils.ConvertToMetadataUpdate(m.Metadata, prior.Metadata),
 	}
 }
 
-func (m *shippingProfileResourceModel) toUpdateInput() medusa.AdminPostShippingProfilesProfileReq {
+// toResetInput returns the request that gives an adopted built-in profile
+// back its original name, and the metadata keys the resource managed their
+// original values, removing those that did not exist before. Without
+// original settings, for a profile adopted by an earlier version, the name
+// Medusa gives the profile on install is restored and the managed keys are
+// removed.
+func (m *shippingProfileResourceModel) toResetInput(original *shippingProfileSettings) medusa.AdminPostShippingProfilesProfileReq {
+	if original == nil {
+		name := builtinShippingProfileNames[m.Type.ValueString()]
+		return medusa.AdminPostShippingProfilesProfileReq{
+			Name:     &name,
+			Metadata: utils.ConvertToMetadataUpdate(nil, m.Metadata),
+		}
+	}
+
+	var metadata *map[string]any
+	if len(m.Metadata) > 0 {
+		values := make(map[string]any, len(m.Metadata))
+		for k := range m.Metadata {
+			if v, ok := original.Metadata[k]; ok {
+				values[k] = v
+			} else {
+				values[k] = ""
+			}
+		}
+		metadata = &values
+	}
+
 	return medusa.AdminPostShippingProfilesProfileReq{
-		Name: m.Name.ValueStringPointer(),
-		Type: m.Type.ValueStringPointer(),
+		Name:     &original.Name,
+		Metadata: metadata,
 	}
 }
 
@@ -36,6 +121,7 @@ func (m *shippingProfileResourceModel) fromRemote(c *medusa.AdminShippingProfile
 	m.ID = types.StringValue(c.ShippingProfile.Id)
 	m.Name = types.StringValue(c.ShippingProfile.Name)
 	m.Type = types.StringValue(c.ShippingProfile.Type)
+	m.Metadata = utils.ConvertToTerraformMetadata(m.Metadata, c.ShippingProfile.Metadata)
 
 	return nil
 }
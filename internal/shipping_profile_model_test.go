@@ -0,0 +1,183 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+func TestShippingProfileModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	tests := []struct {
+		name   string
+		create shippingProfileResourceModel
+		update shippingProfileResourceModel
+	}{
+		{
+			name:   "custom",
+			create: shippingProfileResourceModel{Name: types.StringValue("Bulky"), Type: types.StringValue("custom")},
+			update: shippingProfileResourceModel{Name: types.StringValue("Bulky goods"), Type: types.StringValue("custom")},
+		},
+		{
+			name:   "metadata",
+			create: shippingProfileResourceModel{Name: types.StringValue("Bulky"), Type: types.StringValue("custom"), Metadata: testMetadata("erp_id", "42", "channel", "web")},
+			update: shippingProfileResourceModel{Name: types.StringValue("Bulky goods"), Type: types.StringValue("custom"), Metadata: testMetadata("erp_id", "43")},
+		},
+		{
+			name:   "type change",
+			create: shippingProfileResourceModel{Name: types.StringValue("Vouchers"), Type: types.StringValue("custom")},
+			update: shippingProfileResourceModel{Name: types.StringValue("Vouchers"), Type: types.StringValue("gift_card")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created, err := client.PostShippingProfilesWithResponse(ctx, tt.create.toCreateInput())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			state := shippingProfileResourceModel{Metadata: tt.create.Metadata}
+			if err := state.fromRemote(created.JSON200); err != nil {
+				t.Fatalf("%s: %s", err, created.Body)
+			}
+			tt.create.ID = state.ID
+			checkModel(t, tt.create, state)
+
+			prior := state
+			updated, err := client.PostShippingProfilesProfileWithResponse(ctx, state.ID.ValueString(), tt.update.toUpdateInput(&prior))
+			if err != nil {
+				t.Fatal(err)
+			}
+			state = shippingProfileResourceModel{Metadata: tt.update.Metadata}
+			if err := state.fromRemote(updated.JSON200); err != nil {
+				t.Fatalf("%s: %s", err, updated.Body)
+			}
+			tt.update.ID = state.ID
+			checkModel(t, tt.update, state)
+		})
+	}
+}
+
+func TestShippingProfileModelProperties(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	checkModelProperty(t, func(name string) bool {
+		plan := shippingProfileResourceModel{Name: quickString(name), Type: types.StringValue("custom")}
+
+		created, err := client.PostShippingProfilesWithResponse(ctx, plan.toCreateInput())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var state shippingProfileResourceModel
+		if err := state.fromRemote(created.JSON200); err != nil {
+			t.Fatalf("%s: %s", err, created.Body)
+		}
+		plan.ID = state.ID
+
+		diff := modelDiff(plan, state)
+		for _, d := range diff {
+			t.Log(d)
+		}
+		return len(diff) == 0
+	})
+}
+
+func TestShippingProfileModelResetInput(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	list, err := client.GetShippingProfilesWithResponse(ctx)
+	if err != nil || list.JSON200 == nil {
+		t.Fatalf("could not list the shipping profiles: %v", err)
+	}
+	var id string
+	for _, profile := range list.JSON200.ShippingProfiles {
+		if profile.Type == "default" {
+			id = profile.Id
+		}
+	}
+
+	seed := func(t *testing.T) {
+		t.Helper()
+
+		res, err := client.PostShippingProfilesProfileWithResponse(ctx, id, medusa.AdminPostShippingProfilesProfileReq{
+			Name:     ptr("Standard"),
+			Metadata: &map[string]any{"erp_id": "42", "owner": "ops"},
+		})
+		if err != nil || res.JSON200 == nil {
+			t.Fatalf("could not seed the shipping profile: %v", err)
+		}
+	}
+	seed(t)
+
+	read, err := client.GetShippingProfilesProfileWithResponse(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	original, err := newShippingProfileSettings(read.JSON200)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := shippingProfileResourceModel{
+		ID:       types.StringValue(id),
+		Name:     types.StringValue("Adopted"),
+		Type:     types.StringValue("default"),
+		Metadata: testMetadata("erp_id", "43", "region", "eu"),
+	}
+
+	tests := []struct {
+		name     string
+		original *shippingProfileSettings
+		want     shippingProfileSettings
+	}{
+		{
+			name:     "restore",
+			original: original,
+			want: shippingProfileSettings{
+				Name:     "Standard",
+				Metadata: map[string]any{"erp_id": "42", "owner": "ops"},
+			},
+		},
+		{
+			name: "adopted without original settings",
+			want: shippingProfileSettings{
+				Name:     builtinShippingProfileNames["default"],
+				Metadata: map[string]any{"owner": "ops"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seed(t)
+			if _, err := client.PostShippingProfilesProfileWithResponse(ctx, id, state.toUpdateInput(&shippingProfileResourceModel{})); err != nil {
+				t.Fatal(err)
+			}
+
+			reset, err := client.PostShippingProfilesProfileWithResponse(ctx, id, state.toResetInput(tt.original))
+			if err != nil || reset.JSON200 == nil {
+				t.Fatalf("could not reset the shipping profile: %v", err)
+			}
+
+			read, err := client.GetShippingProfilesProfileWithResponse(ctx, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := newShippingProfileSettings(read.JSON200)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, d := range modelDiff(tt.want, *got) {
+				t.Error(d)
+			}
+		})
+	}
+}
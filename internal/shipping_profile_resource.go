@@ -2,26 +2,32 @@ package internal
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
-
 	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &shippingProfileResource{}
-	_ resource.ResourceWithConfigure   = &shippingProfileResource{}
-	_ resource.ResourceWithImportState = &shippingProfileResource{}
+	_ resource.Resource                   = &shippingProfileResource{}
+	_ resource.ResourceWithConfigure      = &shippingProfileResource{}
+	_ resource.ResourceWithImportState    = &shippingProfileResource{}
+	_ resource.ResourceWithValidateConfig = &shippingProfileResource{}
 )
 
 // NewShippingProfileResource is a helper function to simplify the provider implementation.
@@ -31,7 +37,7 @@ func NewShippingProfileResource() resource.Resource {
 
 // shippingProfileResource is the resource implementation.
 type shippingProfileResource struct {
-	client medusa.ClientWithResponsesInterface
+	client utils.Client
 }
 
 // Metadata returns the data source type name.
@@ -56,23 +62,31 @@ func (r *shippingProfileResource) Schema(_ context.Context, _ resource.SchemaReq
 				Required:    true,
 			},
 			"type": schema.StringAttribute{
-				Description: "The type of the shipping profile.",
+				Description: "The type of the shipping profile. The default and gift_card profiles are created by Medusa on install, so they should be adopted with adopt_existing. Setting adopt_existing on a profile of those types that is already managed adopts it in place.",
 				Required:    true,
 				Validators: []validator.String{
 					stringvalidator.OneOf("default", "gift_card", "custom"),
 				},
 			},
+			"metadata":       metadataAttribute("shipping profile"),
+			"adopt_existing": shippingProfileAdoptAttribute(),
 		},
 	}
 }
 
 // Configure adds the provider configured client to the data source.
-func (r *shippingProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (r *shippingProfileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	r.client = utils.GetClient(req.ProviderData)
+	client, d := utils.GetClient(req.ProviderData)
+	if d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	r.client = client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -85,16 +99,49 @@ func (r *shippingProfileResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	// Generate API request body from plan
-	input := plan.toCreateInput()
+	var resource *medusa.AdminShippingProfilesRes
+	var original []byte
+	if plan.AdoptExisting.ValueBool() {
+		// Take over the built-in profile, and set it to the plan.
+		candidates, err := r.findByType(ctx, plan.Type.ValueString())
+		if err == nil {
+			var id string
+			if id, err = adoptCandidate(plan.Type.ValueString()+" shipping profile", candidates); err == nil {
+				plan.ID = types.StringValue(id)
+			}
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating shipping_profile",
+				"Could not adopt the built-in shipping_profile: "+err.Error(),
+			)
+			return
+		}
 
-	content, err := r.client.PostShippingProfilesWithResponse(ctx, input)
-	if d := utils.CheckCreateError("shipping_profile", content, err); d != nil {
-		resp.Diagnostics.Append(d)
-		return
-	}
+		// Keep the settings of the profile, to restore them on destroy
+		var d diag.Diagnostic
+		if _, original, d = r.readSettings(ctx, plan.ID.ValueString()); d != nil {
+			resp.Diagnostics.Append(d)
+			return
+		}
 
-	resource := content.JSON200
+		content, err := r.client.PostShippingProfilesProfileWithResponse(ctx, plan.ID.ValueString(), plan.toUpdateInput(&shippingProfileResourceModel{}))
+		if d := utils.CheckUpdateError("shipping_profile", content, err); d != nil {
+			resp.Diagnostics.Append(d)
+			return
+		}
+		resource = content.JSON200
+	} else {
+		// Generate API request body from plan
+		input := plan.toCreateInput()
+
+		content, err := r.client.PostShippingProfilesWithResponse(ctx, input)
+		if d := utils.CheckCreateError("shipping_profile", content, err); d != nil {
+			resp.Diagnostics.Append(d)
+			return
+		}
+		resource = content.JSON200
+	}
 	tflog.Debug(ctx, spew.Sdump(resource))
 
 	// Map response body to schema
@@ -112,6 +159,11 @@ func (r *shippingProfileResource) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if original != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, shippingProfileSettingsKey, original)...)
+	}
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.ShippingProfile.UpdatedAt)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -141,6 +193,7 @@ func (r *shippingProfileResource) Read(ctx context.Context, req resource.ReadReq
 		)
 		return
 	}
+	state.AdoptExisting = stateFlag(state.AdoptExisting)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -148,6 +201,8 @@ func (r *shippingProfileResource) Read(ctx context.Context, req resource.ReadReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.ShippingProfile.UpdatedAt)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -160,8 +215,28 @@ func (r *shippingProfileResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	// Retrieve values from state, to know the metadata keys to remove
+	var state shippingProfileResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check that the shipping profile did not change since Terraform last read it
+	checkConcurrentChange(ctx, r.client, req.Private, "shipping profile", plan.ID.ValueString(), func() (time.Time, *diag.ErrorDiagnostic) {
+		content, err := r.client.GetShippingProfilesProfileWithResponse(ctx, plan.ID.ValueString())
+		if d := utils.CheckGetError("shipping_profile", plan.ID.ValueString(), content, err); d != nil {
+			return time.Time{}, d
+		}
+		return content.JSON200.ShippingProfile.UpdatedAt, nil
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Generate API request body from plan
-	input := plan.toUpdateInput()
+	input := plan.toUpdateInput(&state)
 
 	content, err := r.client.PostShippingProfilesProfileWithResponse(ctx, plan.ID.ValueString(), input)
 	if d := utils.CheckUpdateError("shipping_profile", content, err); d != nil {
@@ -186,6 +261,8 @@ func (r *shippingProfileResource) Update(ctx context.Context, req resource.Updat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.ShippingProfile.UpdatedAt)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -198,6 +275,30 @@ func (r *shippingProfileResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	// An adopted built-in profile cannot be deleted, so it is reset instead.
+	if state.AdoptExisting.ValueBool() {
+		b, diags := req.Private.GetKey(ctx, shippingProfileSettingsKey)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		original, err := readShippingProfileSettings(b)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting shipping_profile",
+				"Could not read the original settings of the shipping_profile, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		content, err := r.client.PostShippingProfilesProfileWithResponse(ctx, state.ID.ValueString(), state.toResetInput(original))
+		if d := utils.CheckUpdateError("shipping_profile", content, err); d != nil {
+			resp.Diagnostics.Append(d)
+		}
+		return
+	}
+
 	content, err := r.client.DeleteShippingProfilesProfileWithResponse(ctx, state.ID.ValueString())
 	if d := utils.CheckDeleteError("shipping_profile", content, err); d != nil {
 		resp.Diagnostics.Append(d)
@@ -205,7 +306,135 @@ func (r *shippingProfileResource) Delete(ctx context.Context, req resource.Delet
 	}
 }
 
+// ValidateConfig checks that a built-in profile exists for the type to
+// adopt, and warns when the types Medusa creates on install are not adopted.
+func (r *shippingProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var adopt types.Bool
+	var profileType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("adopt_existing"), &adopt)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &profileType)...)
+	if resp.Diagnostics.HasError() || adopt.IsUnknown() || profileType.IsUnknown() || profileType.IsNull() {
+		return
+	}
+
+	_, builtin := builtinShippingProfileNames[profileType.ValueString()]
+	switch {
+	case adopt.ValueBool() && !builtin:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("adopt_existing"),
+			"No built-in shipping profile",
+			fmt.Sprintf("Medusa only creates the default and gift_card shipping profiles, so a %q profile cannot be adopted.", profileType.ValueString()),
+		)
+	case !adopt.ValueBool() && builtin:
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("adopt_existing"),
+			"Built-in shipping profile not adopted",
+			fmt.Sprintf("Medusa creates the %s shipping profile on install, and does not allow to delete profiles of that type, "+
+				"so destroying this resource fails. Set adopt_existing = true to take over the built-in profile, "+
+				"which adopts a profile already in the state without replacing it.", profileType.ValueString()),
+		)
+	}
+}
+
+// shippingProfileAdoptAttribute returns the adopt_existing attribute of the
+// shipping profiles. Profiles of the built-in types could be managed without
+// it, and Medusa does not allow to delete them, so setting it on such a
+// profile adopts it in place instead of replacing it.
+func shippingProfileAdoptAttribute() schema.BoolAttribute {
+	attribute := adoptExistingAttribute(
+		"the shipping profile of the type that Medusa creates on install, which only exists for the default and gift_card types,",
+		"gives the profile back the name and metadata values it had before",
+	)
+	attribute.PlanModifiers = []planmodifier.Bool{
+		boolplanmodifier.RequiresReplaceIf(
+			requiresReplaceUnlessAdopted,
+			"Changing adopt_existing replaces the resource, unless a profile of a built-in type is adopted.",
+			"Changing `adopt_existing` replaces the resource, unless a profile of a built-in type is adopted.",
+		),
+	}
+	return attribute
+}
+
+// requiresReplaceUnlessAdopted replaces the profile when adopt_existing
+// changes, unless it is set on a profile of a built-in type.
+func requiresReplaceUnlessAdopted(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+	var profileType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("type"), &profileType)...)
+	_, builtin := builtinShippingProfileNames[profileType.ValueString()]
+	resp.RequiresReplace = !builtin || !req.PlanValue.ValueBool()
+}
+
+// ImportState imports a shipping profile by id, or by its type as
+// type:<type>, which is unique for the default and gift card profiles. A
+// built-in profile is imported as adopted, and its current settings are
+// restored when the resource is destroyed.
 func (r *shippingProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importState(ctx, req, resp, "shipping profile", map[string]importLookup{
+		"type": r.findByType,
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, original, d := r.readSettings(ctx, id.ValueString())
+	if d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+	if _, ok := builtinShippingProfileNames[profile.ShippingProfile.Type]; !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_existing"), true)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, shippingProfileSettingsKey, original)...)
+}
+
+// readSettings returns the shipping profile together with its current
+// settings, encoded for private state.
+func (r *shippingProfileResource) readSettings(ctx context.Context, id string) (*medusa.AdminShippingProfilesRes, []byte, diag.Diagnostic) {
+	content, err := r.client.GetShippingProfilesProfileWithResponse(ctx, id)
+	if err == nil && content.JSON200 == nil {
+		err = fmt.Errorf("status code: %d (%s)", content.StatusCode(), content.Body)
+	}
+
+	var b []byte
+	if err == nil {
+		var settings *shippingProfileSettings
+		if settings, err = newShippingProfileSettings(content.JSON200); err == nil {
+			b, err = json.Marshal(settings)
+		}
+	}
+	if err != nil {
+		return nil, nil, diag.NewErrorDiagnostic(
+			"Error reading shipping_profile",
+			"Could not read the current settings of the shipping_profile, unexpected error: "+err.Error(),
+		)
+	}
+
+	return content.JSON200, b, nil
+}
+
+func (r *shippingProfileResource) findByType(ctx context.Context, profileType string) ([]importCandidate, error) {
+	// The shipping profiles are not paginated.
+	content, err := r.client.GetShippingProfilesWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if content.JSON200 == nil {
+		return nil, listError(content.StatusCode(), content.Body)
+	}
+
+	var matches []importCandidate
+	for _, profile := range content.JSON200.ShippingProfiles {
+		if profile.Type == profileType {
+			matches = append(matches, importCandidate{ID: profile.Id, Label: profile.Name})
+		}
+	}
+	return matches, nil
 }
@@ -0,0 +1,309 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+func init() {
+	resource.AddTestSweepers("medusa_shipping_profile", &resource.Sweeper{
+		Name:         "medusa_shipping_profile",
+		Dependencies: []string{"medusa_shipping_option"},
+		F: sweep("medusa_shipping_profile", listShippingProfiles, func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error) {
+			res, err := client.DeleteShippingProfilesProfileWithResponse(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return res.StatusCode(), nil
+		}),
+	})
+}
+
+// listShippingProfiles returns all shipping profiles, as the endpoint is not
+// paginated.
+func listShippingProfiles(ctx context.Context, client medusa.ClientWithResponsesInterface, _ int) ([]sweepable, int, error) {
+	res, err := client.GetShippingProfilesWithResponse(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if res.JSON200 == nil {
+		return nil, 0, listError(res.StatusCode(), res.Body)
+	}
+
+	var objects []sweepable
+	for _, profile := range res.JSON200.ShippingProfiles {
+		objects = append(objects, sweepable{ID: profile.Id, Name: profile.Name})
+	}
+	return objects, len(objects), nil
+}
+
+func TestAccShippingProfileResource(t *testing.T) {
+	h := newTestAccHarness(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		CheckDestroy: h.checkDestroy(t, "medusa_shipping_profile", func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error) {
+			content, err := client.GetShippingProfilesProfileWithResponse(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return content.StatusCode(), nil
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: h.providerConfig() + `
+resource "medusa_shipping_profile" "test" {
+  name = "tf-acc-shipping-profile"
+  type = "custom"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("medusa_shipping_profile.test", "id"),
+					resource.TestCheckResourceAttr("medusa_shipping_profile.test", "name", "tf-acc-shipping-profile"),
+					resource.TestCheckResourceAttr("medusa_shipping_profile.test", "type", "custom"),
+				),
+			},
+			{
+				ResourceName:      "medusa_shipping_profile.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: h.providerConfig() + `
+resource "medusa_shipping_profile" "test" {
+  name = "tf-acc-shipping-profile-updated"
+  type = "custom"
+}
+`,
+				Check: resource.TestCheckResourceAttr("medusa_shipping_profile.test", "name", "tf-acc-shipping-profile-updated"),
+			},
+		},
+	})
+}
+
+// The default profile is taken over rather than created, and given back the
+// name and metadata it had before on destroy. It is not named with
+// testAccNamePrefix, so the sweeper never tries to delete it.
+func TestAccShippingProfileResourceAdopt(t *testing.T) {
+	h := newTestAccHarness(t)
+	t.Cleanup(func() {
+		if err := testAccUpdateBuiltinShippingProfile(t, h, "default", builtinShippingProfileNames["default"], map[string]any{"owner": ""}); err != nil {
+			t.Error(err)
+		}
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		CheckDestroy: func(*terraform.State) error {
+			return testAccCheckBuiltinShippingProfile(t, h, "default", "Standard", map[string]any{"owner": "ops", "managed_by": nil})
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: h.providerConfig() + `
+resource "medusa_shipping_profile" "test" {
+  name           = "Bulky goods"
+  type           = "custom"
+  adopt_existing = true
+}
+`,
+				ExpectError: regexp.MustCompile(`No built-in shipping profile`),
+			},
+			{
+				PreConfig: func() {
+					if err := testAccUpdateBuiltinShippingProfile(t, h, "default", "Standard", map[string]any{"owner": "ops"}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: h.providerConfig() + `
+resource "medusa_shipping_profile" "test" {
+  name           = "Adopted Shipping Profile"
+  type           = "default"
+  adopt_existing = true
+  metadata = {
+    owner      = "terraform"
+    managed_by = "terraform"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("medusa_shipping_profile.test", "name", "Adopted Shipping Profile"),
+					resource.TestCheckResourceAttr("medusa_shipping_profile.test", "adopt_existing", "true"),
+					func(*terraform.State) error {
+						return testAccCheckBuiltinShippingProfile(t, h, "default", "Adopted Shipping Profile", map[string]any{"owner": "terraform", "managed_by": "terraform"})
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestShippingProfileResourceValidateConfig(t *testing.T) {
+	r := &shippingProfileResource{}
+	s := testResourceSchema(t, r)
+
+	tests := []struct {
+		name        string
+		profileType string
+		adopt       types.Bool
+		wantErr     bool
+		wantWarning bool
+	}{
+		{"custom", "custom", types.BoolNull(), false, false},
+		{"adopted custom", "custom", types.BoolValue(true), true, false},
+		{"adopted default", "default", types.BoolValue(true), false, false},
+		{"default not adopted", "default", types.BoolNull(), false, true},
+		{"gift card not adopted", "gift_card", types.BoolValue(false), false, true},
+		{"unknown adopt", "default", types.BoolUnknown(), false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := testPlan(t, s, shippingProfileResourceModel{
+				Name:          types.StringValue("Profile"),
+				Type:          types.StringValue(tt.profileType),
+				AdoptExisting: tt.adopt,
+			})
+
+			var resp fwresource.ValidateConfigResponse
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: plan.Raw},
+			}, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, resp.Diagnostics)
+			}
+			if (resp.Diagnostics.WarningsCount() > 0) != tt.wantWarning {
+				t.Errorf("expected warning %t, got %v", tt.wantWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// Setting adopt_existing on a profile of a built-in type that is already
+// managed adopts it in place, as Medusa does not allow to delete it.
+func TestShippingProfileResourceAdoptInPlace(t *testing.T) {
+	ctx := context.Background()
+	s := testResourceSchema(t, &shippingProfileResource{})
+	modifier := s.Attributes["adopt_existing"].(schema.BoolAttribute).PlanModifiers[0]
+
+	tests := []struct {
+		name        string
+		profileType string
+		prior       types.Bool
+		adopt       types.Bool
+		wantReplace bool
+	}{
+		{"default adopted", "default", types.BoolValue(false), types.BoolValue(true), false},
+		{"gift card adopted", "gift_card", types.BoolNull(), types.BoolValue(true), false},
+		{"default given up", "default", types.BoolValue(true), types.BoolValue(false), true},
+		{"custom adopted", "custom", types.BoolValue(false), types.BoolValue(true), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := shippingProfileResourceModel{
+				ID:            types.StringValue("sp_01"),
+				Name:          types.StringValue("Profile"),
+				Type:          types.StringValue(tt.profileType),
+				AdoptExisting: tt.prior,
+			}
+			plan := state
+			plan.AdoptExisting = tt.adopt
+
+			req := planmodifier.BoolRequest{
+				Path:        path.Root("adopt_existing"),
+				State:       testState(t, s, state),
+				Plan:        testPlan(t, s, plan),
+				StateValue:  tt.prior,
+				PlanValue:   tt.adopt,
+				ConfigValue: tt.adopt,
+			}
+			resp := planmodifier.BoolResponse{PlanValue: tt.adopt}
+			modifier.PlanModifyBool(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+			if resp.RequiresReplace != tt.wantReplace {
+				t.Errorf("expected replace %t, got %t", tt.wantReplace, resp.RequiresReplace)
+			}
+		})
+	}
+}
+
+// testAccBuiltinShippingProfile returns the single profile of the type.
+func testAccBuiltinShippingProfile(t *testing.T, h *testAccHarness, profileType string) (*medusa.ShippingProfile, error) {
+	res, err := h.client(t).GetShippingProfilesWithResponse(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, listError(res.StatusCode(), res.Body)
+	}
+
+	var profiles []medusa.ShippingProfile
+	for _, profile := range res.JSON200.ShippingProfiles {
+		if profile.Type == profileType {
+			profiles = append(profiles, profile)
+		}
+	}
+	if len(profiles) != 1 {
+		return nil, fmt.Errorf("expected a single %s shipping profile, got %d", profileType, len(profiles))
+	}
+	return &profiles[0], nil
+}
+
+// testAccUpdateBuiltinShippingProfile sets the name and metadata of the
+// profile of the type, as if they were changed outside of Terraform.
+func testAccUpdateBuiltinShippingProfile(t *testing.T, h *testAccHarness, profileType, name string, metadata map[string]any) error {
+	profile, err := testAccBuiltinShippingProfile(t, h, profileType)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.client(t).PostShippingProfilesProfileWithResponse(context.Background(), profile.Id, medusa.AdminPostShippingProfilesProfileReq{
+		Name:     &name,
+		Metadata: &metadata,
+	})
+	if err != nil {
+		return err
+	}
+	if res.JSON200 == nil {
+		return fmt.Errorf("could not update the %s shipping profile, status code: %d (%s)", profileType, res.StatusCode(), res.Body)
+	}
+	return nil
+}
+
+// testAccCheckBuiltinShippingProfile checks that there is a single profile
+// of the type, with the given name and metadata values. A nil value checks
+// that the key does not exist.
+func testAccCheckBuiltinShippingProfile(t *testing.T, h *testAccHarness, profileType, name string, metadata map[string]any) error {
+	profile, err := testAccBuiltinShippingProfile(t, h, profileType)
+	if err != nil {
+		return err
+	}
+	if profile.Name != name {
+		return fmt.Errorf("expected the %s shipping profile to be named %q, got %q", profileType, name, profile.Name)
+	}
+
+	var got map[string]any
+	if profile.Metadata != nil {
+		got = *profile.Metadata
+	}
+	for key, want := range metadata {
+		if value, ok := got[key]; want == nil && ok || want != nil && value != want {
+			return fmt.Errorf("expected the metadata key %s of the %s shipping profile to be %v, got %v", key, profileType, want, value)
+		}
+	}
+	return nil
+}
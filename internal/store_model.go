@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -10,31 +11,147 @@ import (
 
 // storeResourceModel maps the resource schema data.
 type storeResourceModel struct {
-	ID                  types.String   `tfsdk:"id"`
-	Name                types.String   `tfsdk:"name"`
-	DefaultCurrencyCode types.String   `tfsdk:"default_currency_code"`
-	Currencies          []types.String `tfsdk:"currencies"`
-	SwapLinkTemplate    types.String   `tfsdk:"swap_link_template"`
-	PaymentLinkTemplate types.String   `tfsdk:"payment_link_template"`
-	InviteLinkTemplate  types.String   `tfsdk:"invite_link_template"`
+	ID                  types.String            `tfsdk:"id"`
+	Name                types.String            `tfsdk:"name"`
+	DefaultCurrencyCode types.String            `tfsdk:"default_currency_code"`
+	Currencies          []types.String          `tfsdk:"currencies"`
+	SwapLinkTemplate    types.String            `tfsdk:"swap_link_template"`
+	PaymentLinkTemplate types.String            `tfsdk:"payment_link_template"`
+	InviteLinkTemplate  types.String            `tfsdk:"invite_link_template"`
+	Metadata            map[string]types.String `tfsdk:"metadata"`
+	ResetOnDestroy      types.Bool              `tfsdk:"reset_on_destroy"`
+	DeletionProtection  types.Bool              `tfsdk:"deletion_protection"`
+
+	DefaultSalesChannelID types.String `tfsdk:"default_sales_channel_id"`
+	DefaultLocationID     types.String `tfsdk:"default_location_id"`
+	PaymentProviders      types.Set    `tfsdk:"payment_providers"`
+	FulfillmentProviders  types.Set    `tfsdk:"fulfillment_providers"`
+}
+
+// storeSettings are the settings of the store before Terraform adopted it,
+// kept in private state to restore them on destroy.
+type storeSettings struct {
+	Name                string         `json:"name"`
+	DefaultCurrencyCode string         `json:"default_currency_code"`
+	Currencies          []string       `json:"currencies"`
+	SwapLinkTemplate    *string        `json:"swap_link_template"`
+	PaymentLinkTemplate *string        `json:"payment_link_template"`
+	InviteLinkTemplate  *string        `json:"invite_link_template"`
+	Metadata            map[string]any `json:"metadata"`
+}
+
+// storeSettingsKey is the private state key of the original settings.
+const storeSettingsKey = "original_settings"
+
+// storeTemplatesKey is the private state key of the link templates set in
+// the configuration, which are cleared once they are removed from it.
+const storeTemplatesKey = "configured_templates"
+
+// storeTemplates are the attributes of the link templates.
+var storeTemplates = []string{"swap_link_template", "payment_link_template", "invite_link_template"}
+
+func newStoreSettings(c *medusa.AdminExtendedStoresRes) (*storeSettings, error) {
+	if c == nil {
+		return nil, fmt.Errorf("store is nil")
+	}
+
+	settings := &storeSettings{
+		Name:                c.Store.Name,
+		DefaultCurrencyCode: c.Store.DefaultCurrencyCode,
+		Currencies: utils.ExtractIDs(c.Store.Currencies, func(currency medusa.Currency) string {
+			return currency.Code
+		}),
+		SwapLinkTemplate:    c.Store.SwapLinkTemplate,
+		PaymentLinkTemplate: c.Store.PaymentLinkTemplate,
+		InviteLinkTemplate:  c.Store.InviteLinkTemplate,
+	}
+	if c.Store.Metadata != nil {
+		settings.Metadata = *c.Store.Metadata
+	}
+
+	return settings, nil
 }
 
-func (m *storeResourceModel) toUpdateInput() medusa.AdminPostStoreReq {
+// readStoreSettings decodes the original settings from private state, or
+// returns nil when there are none.
+func readStoreSettings(b []byte) (*storeSettings, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+
+	var settings storeSettings
+	if err := json.Unmarshal(b, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (m *storeResourceModel) toUpdateInput(prior *storeResourceModel) medusa.AdminPostStoreReq {
 	return medusa.AdminPostStoreReq{
-		Name:                m.Name.ValueStringPointer(),
-		DefaultCurrencyCode: m.DefaultCurrencyCode.ValueStringPointer(),
-		Currencies:          utils.ConvertToPointerStringSlice(m.Currencies),
-		SwapLinkTemplate:    m.SwapLinkTemplate.ValueStringPointer(),
-		PaymentLinkTemplate: m.PaymentLinkTemplate.ValueStringPointer(),
-		InviteLinkTemplate:  m.InviteLinkTemplate.ValueStringPointer(),
+		Name:                utils.ChangedString(m.Name, prior.Name),
+		DefaultCurrencyCode: utils.ChangedCode(m.DefaultCurrencyCode, prior.DefaultCurrencyCode),
+		Currencies:          utils.ChangedCodes(m.Currencies, prior.Currencies),
+		SwapLinkTemplate:    utils.ChangedString(m.SwapLinkTemplate, prior.SwapLinkTemplate),
+		PaymentLinkTemplate: utils.ChangedString(m.PaymentLinkTemplate, prior.PaymentLinkTemplate),
+		InviteLinkTemplate:  utils.ChangedString(m.InviteLinkTemplate, prior.InviteLinkTemplate),
+		Metadata:            utils.ConvertToMetadataUpdate(m.Metadata, prior.Metadata),
 	}
 }
 
-func (m *storeResourceModel) toDeleteInput() medusa.AdminPostStoreReq {
+// toDeleteInput returns the request that gives the store back on destroy.
+// With reset_on_destroy the link templates and managed metadata keys are
+// cleared, otherwise the original settings are restored. Without original
+// settings, for a store adopted by an earlier version, only the managed
+// metadata keys are removed.
+func (m *storeResourceModel) toDeleteInput(original *storeSettings) medusa.AdminPostStoreReq {
+	empty := ""
+	if m.ResetOnDestroy.ValueBool() {
+		return medusa.AdminPostStoreReq{
+			SwapLinkTemplate:    &empty,
+			PaymentLinkTemplate: &empty,
+			InviteLinkTemplate:  &empty,
+			Metadata:            utils.ConvertToMetadataUpdate(nil, m.Metadata),
+		}
+	}
+
+	if original == nil {
+		return medusa.AdminPostStoreReq{
+			Metadata: utils.ConvertToMetadataUpdate(nil, m.Metadata),
+		}
+	}
+
+	// A template that was not set is sent as an empty string, as null
+	// leaves it unchanged.
+	restore := func(template *string) *string {
+		if template == nil {
+			return &empty
+		}
+		return template
+	}
+
+	// Managed keys get their original value back, or are removed when they
+	// did not exist before.
+	var metadata *map[string]any
+	if len(m.Metadata) > 0 {
+		values := make(map[string]any, len(m.Metadata))
+		for k := range m.Metadata {
+			if v, ok := original.Metadata[k]; ok {
+				values[k] = v
+			} else {
+				values[k] = ""
+			}
+		}
+		metadata = &values
+	}
+
 	return medusa.AdminPostStoreReq{
-		SwapLinkTemplate:    nil,
-		PaymentLinkTemplate: nil,
-		InviteLinkTemplate:  nil,
+		Name:                &original.Name,
+		DefaultCurrencyCode: &original.DefaultCurrencyCode,
+		Currencies:          &original.Currencies,
+		SwapLinkTemplate:    restore(original.SwapLinkTemplate),
+		PaymentLinkTemplate: restore(original.PaymentLinkTemplate),
+		InviteLinkTemplate:  restore(original.InviteLinkTemplate),
+		Metadata:            metadata,
 	}
 }
 
@@ -52,15 +169,39 @@ func (m *storeResourceModel) fromGetRemote(c *medusa.AdminExtendedStoresRes) err
 
 	m.ID = types.StringValue(c.Store.Id)
 	m.Name = types.StringValue(c.Store.Name)
-	m.DefaultCurrencyCode = types.StringValue(c.Store.DefaultCurrencyCode)
-	m.Currencies = utils.ConvertToTerraformStringSlice(currencyCodes)
-	m.SwapLinkTemplate = types.StringPointerValue(c.Store.SwapLinkTemplate)
-	m.PaymentLinkTemplate = types.StringPointerValue(c.Store.PaymentLinkTemplate)
-	m.InviteLinkTemplate = types.StringPointerValue(c.Store.InviteLinkTemplate)
+	m.DefaultCurrencyCode = utils.ConvertToTerraformCode(m.DefaultCurrencyCode, c.Store.DefaultCurrencyCode)
+	m.Currencies = utils.ConvertToTerraformCodes(m.Currencies, currencyCodes)
+	m.SwapLinkTemplate = utils.ConvertToTerraformOptionalString(m.SwapLinkTemplate, c.Store.SwapLinkTemplate)
+	m.PaymentLinkTemplate = utils.ConvertToTerraformOptionalString(m.PaymentLinkTemplate, c.Store.PaymentLinkTemplate)
+	m.InviteLinkTemplate = utils.ConvertToTerraformOptionalString(m.InviteLinkTemplate, c.Store.InviteLinkTemplate)
+	m.Metadata = utils.ConvertToTerraformMetadata(m.Metadata, c.Store.Metadata)
+	m.DefaultSalesChannelID = types.StringPointerValue(c.Store.DefaultSalesChannelId)
+	m.DefaultLocationID = types.StringPointerValue(c.Store.DefaultLocationId)
+	m.fromProviders(c)
 
 	return nil
 }
 
+// fromProviders sets the installed providers, which only the get response
+// holds.
+func (m *storeResourceModel) fromProviders(c *medusa.AdminExtendedStoresRes) {
+	paymentProviders := utils.ExtractIDs(
+		&c.Store.PaymentProviders,
+		func(provider medusa.PaymentProvider) string {
+			return provider.Id
+		},
+	)
+	fulfillmentProviders := utils.ExtractIDs(
+		&c.Store.FulfillmentProviders,
+		func(provider medusa.FulfillmentProvider) string {
+			return provider.Id
+		},
+	)
+
+	m.PaymentProviders = utils.ConvertToTerraformStringSet(paymentProviders)
+	m.FulfillmentProviders = utils.ConvertToTerraformStringSet(fulfillmentProviders)
+}
+
 func (m *storeResourceModel) fromUpdateRemote(c *medusa.AdminStoresRes) error {
 	if c == nil {
 		return fmt.Errorf("store is nil")
@@ -75,11 +216,14 @@ func (m *storeResourceModel) fromUpdateRemote(c *medusa.AdminStoresRes) error {
 
 	m.ID = types.StringValue(c.Store.Id)
 	m.Name = types.StringValue(c.Store.Name)
-	m.DefaultCurrencyCode = types.StringValue(c.Store.DefaultCurrencyCode)
-	m.Currencies = utils.ConvertToTerraformStringSlice(currencyCodes)
-	m.SwapLinkTemplate = types.StringPointerValue(c.Store.SwapLinkTemplate)
-	m.PaymentLinkTemplate = types.StringPointerValue(c.Store.PaymentLinkTemplate)
-	m.InviteLinkTemplate = types.StringPointerValue(c.Store.InviteLinkTemplate)
+	m.DefaultCurrencyCode = utils.ConvertToTerraformCode(m.DefaultCurrencyCode, c.Store.DefaultCurrencyCode)
+	m.Currencies = utils.ConvertToTerraformCodes(m.Currencies, currencyCodes)
+	m.SwapLinkTemplate = utils.ConvertToTerraformOptionalString(m.SwapLinkTemplate, c.Store.SwapLinkTemplate)
+	m.PaymentLinkTemplate = utils.ConvertToTerraformOptionalString(m.PaymentLinkTemplate, c.Store.PaymentLinkTemplate)
+	m.InviteLinkTemplate = utils.ConvertToTerraformOptionalString(m.InviteLinkTemplate, c.Store.InviteLinkTemplate)
+	m.Metadata = utils.ConvertToTerraformMetadata(m.Metadata, c.Store.Metadata)
+	m.DefaultSalesChannelID = types.StringPointerValue(c.Store.DefaultSalesChannelId)
+	m.DefaultLocationID = types.StringPointerValue(c.Store.DefaultLocationId)
 
 	return nil
 }
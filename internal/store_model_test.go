@@ -0,0 +1,268 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+)
+
+func TestStoreModelRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	tests := []struct {
+		name   string
+		update storeResourceModel
+	}{
+		{
+			name: "name and currencies",
+			update: storeResourceModel{
+				Name:                types.StringValue("My Store"),
+				DefaultCurrencyCode: types.StringValue("eur"),
+				Currencies:          utils.ConvertToTerraformStringSlice([]string{"eur", "usd"}),
+				SwapLinkTemplate:    types.StringNull(),
+				PaymentLinkTemplate: types.StringNull(),
+				InviteLinkTemplate:  types.StringNull(),
+			},
+		},
+		{
+			name: "metadata",
+			update: storeResourceModel{
+				Name:                types.StringValue("My Store"),
+				DefaultCurrencyCode: types.StringValue("eur"),
+				Currencies:          utils.ConvertToTerraformStringSlice([]string{"eur", "usd"}),
+				SwapLinkTemplate:    types.StringNull(),
+				PaymentLinkTemplate: types.StringNull(),
+				InviteLinkTemplate:  types.StringNull(),
+				Metadata:            testMetadata("erp_id", "43"),
+			},
+		},
+		{
+			name: "upper case currencies",
+			update: storeResourceModel{
+				Name:                types.StringValue("My Store"),
+				DefaultCurrencyCode: types.StringValue("EUR"),
+				Currencies:          utils.ConvertToTerraformStringSlice([]string{"EUR", "usd"}),
+				SwapLinkTemplate:    types.StringNull(),
+				PaymentLinkTemplate: types.StringNull(),
+				InviteLinkTemplate:  types.StringNull(),
+			},
+		},
+		{
+			name: "link templates",
+			update: storeResourceModel{
+				Name:                types.StringValue("My Store"),
+				DefaultCurrencyCode: types.StringValue("usd"),
+				Currencies:          utils.ConvertToTerraformStringSlice([]string{"usd"}),
+				SwapLinkTemplate:    types.StringValue("https://example.com/swaps/{cart_id}"),
+				PaymentLinkTemplate: types.StringValue("https://example.com/payments/{cart_id}"),
+				InviteLinkTemplate:  types.StringValue("https://example.com/invites/{invite_token}"),
+			},
+		},
+	}
+
+	// The store is a singleton, so every case updates the state of the
+	// previous one.
+	var prior storeResourceModel
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated, err := client.PostStoreWithResponse(ctx, tt.update.toUpdateInput(&prior))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The codes of the plan are kept when they only differ in
+			// case.
+			state := storeResourceModel{
+				DefaultCurrencyCode:  tt.update.DefaultCurrencyCode,
+				Currencies:           tt.update.Currencies,
+				Metadata:             tt.update.Metadata,
+				PaymentProviders:     types.SetNull(types.StringType),
+				FulfillmentProviders: types.SetNull(types.StringType),
+			}
+			if err := state.fromUpdateRemote(updated.JSON200); err != nil {
+				t.Fatalf("%s: %s", err, updated.Body)
+			}
+			tt.update.ID = state.ID
+			tt.update.DefaultSalesChannelID = types.StringValue("sc_default")
+			tt.update.DefaultLocationID = types.StringNull()
+			tt.update.PaymentProviders = state.PaymentProviders
+			tt.update.FulfillmentProviders = state.FulfillmentProviders
+			checkModel(t, tt.update, state)
+
+			// Only the get response holds the providers.
+			tt.update.PaymentProviders = utils.ConvertToTerraformStringSet([]string{"manual"})
+			tt.update.FulfillmentProviders = utils.ConvertToTerraformStringSet([]string{"manual"})
+
+			read, err := client.GetStoreWithResponse(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := state.fromGetRemote(read.JSON200); err != nil {
+				t.Fatalf("%s: %s", err, read.Body)
+			}
+			checkModel(t, tt.update, state)
+			prior = state
+		})
+	}
+}
+
+func TestStoreModelProperties(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	checkModelProperty(t, func(name, swap, payment, invite string) bool {
+		plan := storeResourceModel{
+			Name:                quickString(name),
+			DefaultCurrencyCode: types.StringValue("usd"),
+			Currencies:          utils.ConvertToTerraformStringSlice([]string{"usd"}),
+			SwapLinkTemplate:    quickString(swap),
+			PaymentLinkTemplate: quickString(payment),
+			InviteLinkTemplate:  quickString(invite),
+
+			PaymentProviders:     types.SetNull(types.StringType),
+			FulfillmentProviders: types.SetNull(types.StringType),
+		}
+
+		updated, err := client.PostStoreWithResponse(ctx, plan.toUpdateInput(&storeResourceModel{}))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		state := storeResourceModel{
+			PaymentProviders:     types.SetNull(types.StringType),
+			FulfillmentProviders: types.SetNull(types.StringType),
+		}
+		if err := state.fromUpdateRemote(updated.JSON200); err != nil {
+			t.Fatalf("%s: %s", err, updated.Body)
+		}
+		plan.ID = state.ID
+		plan.DefaultSalesChannelID = state.DefaultSalesChannelID
+		plan.DefaultLocationID = state.DefaultLocationID
+
+		diff := modelDiff(plan, state)
+		for _, d := range diff {
+			t.Log(d)
+		}
+		return len(diff) == 0
+	})
+}
+
+func TestStoreModelDeleteInput(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeTestAccHarness(t).client(t)
+
+	seed := func(t *testing.T) {
+		t.Helper()
+
+		res, err := client.PostStoreWithResponse(ctx, medusa.AdminPostStoreReq{
+			Name:                ptr("Medusa Store"),
+			DefaultCurrencyCode: ptr("usd"),
+			Currencies:          &[]string{"usd"},
+			SwapLinkTemplate:    ptr(""),
+			PaymentLinkTemplate: ptr(""),
+			InviteLinkTemplate:  ptr("https://example.com/invites/{invite_token}"),
+			Metadata:            &map[string]any{"erp_id": "42", "owner": "ops", "region": ""},
+		})
+		if err != nil || res.JSON200 == nil {
+			t.Fatalf("could not seed the store: %v", err)
+		}
+	}
+	seed(t)
+
+	read, err := client.GetStoreWithResponse(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	original, err := newStoreSettings(read.JSON200)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan := storeResourceModel{
+		Name:                types.StringValue("Adopted"),
+		DefaultCurrencyCode: types.StringValue("eur"),
+		Currencies:          utils.ConvertToTerraformStringSlice([]string{"eur"}),
+		SwapLinkTemplate:    types.StringValue("https://example.com/swaps/{cart_id}"),
+		PaymentLinkTemplate: types.StringNull(),
+		InviteLinkTemplate:  types.StringNull(),
+		Metadata:            testMetadata("erp_id", "43", "region", "eu"),
+	}
+
+	tests := []struct {
+		name     string
+		reset    bool
+		original *storeSettings
+		want     storeSettings
+	}{
+		{
+			name:     "restore",
+			original: original,
+			want: storeSettings{
+				Name:                "Medusa Store",
+				DefaultCurrencyCode: "usd",
+				Currencies:          []string{"usd"},
+				SwapLinkTemplate:    ptr(""),
+				PaymentLinkTemplate: ptr(""),
+				InviteLinkTemplate:  ptr("https://example.com/invites/{invite_token}"),
+				Metadata:            map[string]any{"erp_id": "42", "owner": "ops"},
+			},
+		},
+		{
+			name:  "reset",
+			reset: true,
+			want: storeSettings{
+				Name:                "Adopted",
+				DefaultCurrencyCode: "eur",
+				Currencies:          []string{"eur"},
+				SwapLinkTemplate:    ptr(""),
+				PaymentLinkTemplate: ptr(""),
+				InviteLinkTemplate:  ptr(""),
+				Metadata:            map[string]any{"owner": "ops"},
+			},
+		},
+		{
+			name: "adopted without original settings",
+			want: storeSettings{
+				Name:                "Adopted",
+				DefaultCurrencyCode: "eur",
+				Currencies:          []string{"eur"},
+				SwapLinkTemplate:    ptr("https://example.com/swaps/{cart_id}"),
+				PaymentLinkTemplate: ptr(""),
+				InviteLinkTemplate:  ptr("https://example.com/invites/{invite_token}"),
+				Metadata:            map[string]any{"owner": "ops"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seed(t)
+			if _, err := client.PostStoreWithResponse(ctx, plan.toUpdateInput(&storeResourceModel{})); err != nil {
+				t.Fatal(err)
+			}
+
+			state := plan
+			state.ResetOnDestroy = types.BoolValue(tt.reset)
+			deleted, err := client.PostStoreWithResponse(ctx, state.toDeleteInput(tt.original))
+			if err != nil || deleted.JSON200 == nil {
+				t.Fatalf("could not destroy the store: %v", err)
+			}
+
+			read, err := client.GetStoreWithResponse(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := newStoreSettings(read.JSON200)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, d := range modelDiff(tt.want, *got) {
+				t.Error(d)
+			}
+		})
+	}
+}
@@ -2,25 +2,37 @@ package internal
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
-
 	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"github.com/ikhvost/terraform-provider-medusa/internal/utils"
+	"github.com/ikhvost/terraform-provider-medusa/internal/validators"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &storeResource{}
-	_ resource.ResourceWithConfigure   = &storeResource{}
-	_ resource.ResourceWithImportState = &storeResource{}
+	_ resource.Resource                   = &storeResource{}
+	_ resource.ResourceWithConfigure      = &storeResource{}
+	_ resource.ResourceWithImportState    = &storeResource{}
+	_ resource.ResourceWithModifyPlan     = &storeResource{}
+	_ resource.ResourceWithUpgradeState   = &storeResource{}
+	_ resource.ResourceWithValidateConfig = &storeResource{}
 )
 
 // NewStoreResource is a helper function to simplify the provider implementation.
@@ -30,7 +42,7 @@ func NewStoreResource() resource.Resource {
 
 // storeResource is the resource implementation.
 type storeResource struct {
-	client medusa.ClientWithResponsesInterface
+	client utils.Client
 }
 
 // Metadata returns the data source type name.
@@ -41,6 +53,7 @@ func (r *storeResource) Metadata(_ context.Context, req resource.MetadataRequest
 // Schema defines the schema for the data source.
 func (r *storeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "A store indicates the general configurations and details about the commerce store.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -56,41 +69,92 @@ func (r *storeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 			},
 			"default_currency_code": schema.StringAttribute{
-				Description: "The default currency code of the store.",
+				Description: "The ISO 4217 code of the default currency of the store. The code is not case sensitive.",
 				Required:    true,
+				Validators: []validator.String{
+					validators.CurrencyCode(),
+				},
 			},
-			"currencies": schema.ListAttribute{
-				Description: "Array of available currencies in the store. each currency is in 3 character iso code format.",
+			"currencies": schema.SetAttribute{
+				Description: "The ISO 4217 codes of the currencies available in the store. The codes are not case sensitive.",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
+				Validators: []validator.Set{
+					validators.CurrencyCodeSet(),
+				},
 			},
 			"swap_link_template": schema.StringAttribute{
-				Description: "A template for swap links.",
+				Description: "A template for swap links. When it is not configured, the template of the store is kept, unless an earlier configuration set it and it is cleared.",
 				Optional:    true,
 				Computed:    true,
 			},
 			"payment_link_template": schema.StringAttribute{
-				Description: "A template for payment links.",
+				Description: "A template for payment links. When it is not configured, the template of the store is kept, unless an earlier configuration set it and it is cleared.",
 				Optional:    true,
 				Computed:    true,
 			},
 			"invite_link_template": schema.StringAttribute{
-				Description: "A template for invite links.",
+				Description: "A template for invite links. When it is not configured, the template of the store is kept, unless an earlier configuration set it and it is cleared.",
 				Optional:    true,
 				Computed:    true,
 			},
+			"metadata":            metadataAttribute("store"),
+			"deletion_protection": deletionProtectionAttribute("store"),
+			"reset_on_destroy": schema.BoolAttribute{
+				Description: "The store cannot be deleted, so destroying the resource restores the settings the store had before Terraform adopted it. " +
+					"When true, destroying the resource instead clears the link templates and the managed metadata keys, and leaves the name and currencies as they are.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"default_sales_channel_id": schema.StringAttribute{
+				Description: "The id of the sales channel that products are added to by default.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_location_id": schema.StringAttribute{
+				Description: "The id of the stock location used by default.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"payment_providers": schema.SetAttribute{
+				Description: "The ids of the payment providers installed in the store.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fulfillment_providers": schema.SetAttribute{
+				Description: "The ids of the fulfillment providers installed in the store.",
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
 
 // Configure adds the provider configured client to the data source.
-func (r *storeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+func (r *storeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
 	}
 
-	r.client = utils.GetClient(req.ProviderData)
+	client, d := utils.GetClient(req.ProviderData)
+	if d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	r.client = client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -103,8 +167,16 @@ func (r *storeResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// Keep the settings the store has before it is adopted, to restore them
+	// on destroy
+	current, original, d := r.readSettings(ctx)
+	if d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
 	// Generate API request body from plan
-	input := plan.toUpdateInput()
+	input := plan.toUpdateInput(&storeResourceModel{})
 
 	content, err := r.client.PostStoreWithResponse(ctx, input)
 	if d := utils.CheckCreateError("store", content, err); d != nil {
@@ -115,7 +187,8 @@ func (r *storeResource) Create(ctx context.Context, req resource.CreateRequest,
 	resource := content.JSON200
 	tflog.Debug(ctx, spew.Sdump(resource))
 
-	// Map response body to schema
+	// Map response body to schema. The providers are not changed by the
+	// update, so they are taken from the store before it.
 	if err := plan.fromUpdateRemote(resource); err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating store",
@@ -123,6 +196,7 @@ func (r *storeResource) Create(ctx context.Context, req resource.CreateRequest,
 		)
 		return
 	}
+	plan.fromProviders(current)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -130,6 +204,10 @@ func (r *storeResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, storeSettingsKey, original)...)
+	resp.Diagnostics.Append(recordConfiguredTemplates(ctx, req.Config, resp.Private)...)
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.Store.UpdatedAt)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -160,12 +238,20 @@ func (r *storeResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	// State written before reset_on_destroy existed holds null
+	if state.ResetOnDestroy.IsNull() {
+		state.ResetOnDestroy = types.BoolValue(false)
+	}
+	state.DeletionProtection = stateFlag(state.DeletionProtection)
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.Store.UpdatedAt)...)
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -178,8 +264,36 @@ func (r *storeResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Generate API request body from plan
-	input := plan.toUpdateInput()
+	// Retrieve values from state, to know the metadata keys to remove
+	var state storeResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only attributes kept in the Terraform state changed, so there is
+	// nothing to send to Medusa
+	input := plan.toUpdateInput(&state)
+	if utils.NoChanges(input) {
+		state.ResetOnDestroy = plan.ResetOnDestroy
+		state.DeletionProtection = plan.DeletionProtection
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		resp.Diagnostics.Append(recordConfiguredTemplates(ctx, req.Config, resp.Private)...)
+		return
+	}
+
+	// Check that the store did not change since Terraform last read it
+	checkConcurrentChange(ctx, r.client, req.Private, "store", plan.ID.ValueString(), func() (time.Time, *diag.ErrorDiagnostic) {
+		content, err := r.client.GetStoreWithResponse(ctx)
+		if d := utils.CheckGetError("store", plan.ID.ValueString(), content, err); d != nil {
+			return time.Time{}, d
+		}
+		return content.JSON200.Store.UpdatedAt, nil
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	content, err := r.client.PostStoreWithResponse(ctx, input)
 	if d := utils.CheckUpdateError("store", content, err); d != nil {
@@ -204,6 +318,9 @@ func (r *storeResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(recordConfiguredTemplates(ctx, req.Config, resp.Private)...)
+	resp.Diagnostics.Append(recordUpdatedAt(ctx, resp.Private, resource.Store.UpdatedAt)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -216,8 +333,28 @@ func (r *storeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	// Generate API request body from plan
-	input := state.toDeleteInput()
+	if checkDeletionProtection(ctx, req.State, &resp.Diagnostics, "store") {
+		return
+	}
+
+	// Retrieve the settings the store had before it was adopted
+	b, diags := req.Private.GetKey(ctx, storeSettingsKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	original, err := readStoreSettings(b)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting store",
+			"Could not read the original settings of the store, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Generate API request body from state
+	input := state.toDeleteInput(original)
 
 	content, err := r.client.PostStoreWithResponse(ctx, input)
 	if d := utils.CheckDeleteError("store", content, err); d != nil {
@@ -226,7 +363,180 @@ func (r *storeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 }
 
+// ValidateConfig checks that the default currency is one of the currencies
+// of the store, which Medusa only rejects on apply.
+func (r *storeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var defaultCurrencyCode types.String
+	var currencies types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("default_currency_code"), &defaultCurrencyCode)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("currencies"), &currencies)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without currencies the default currency is checked against the
+	// currencies of the store on apply.
+	if currencies.IsNull() || currencies.IsUnknown() || defaultCurrencyCode.IsUnknown() {
+		return
+	}
+
+	var codes []string
+	for _, element := range currencies.Elements() {
+		c, ok := element.(types.String)
+		if !ok || c.IsUnknown() {
+			return
+		}
+		if strings.EqualFold(c.ValueString(), defaultCurrencyCode.ValueString()) {
+			return
+		}
+		codes = append(codes, c.ValueString())
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("default_currency_code"),
+		"Invalid default currency",
+		fmt.Sprintf("The default currency %q must be one of the currencies of the store: %s.",
+			defaultCurrencyCode.ValueString(), strings.Join(codes, ", ")),
+	)
+}
+
+// ModifyPlan warns when a protected store would be destroyed, clears the
+// link templates removed from the configuration, and shows that creating
+// the store adopts the existing store, whose id is therefore known before
+// apply.
+func (r *storeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnDeletionProtection(ctx, req, resp, "store")
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// A template that is not configured keeps the value of the store, unless
+	// it was configured before and is cleared.
+	if !req.State.Raw.IsNull() {
+		configured, diags := configuredTemplates(ctx, req.Private)
+		resp.Diagnostics.Append(diags...)
+		for _, name := range storeTemplates {
+			var template types.String
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &template)...)
+			if !template.IsNull() {
+				continue
+			}
+			if configured[name] {
+				template = types.StringNull()
+			} else {
+				resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &template)...)
+			}
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), template)...)
+		}
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Adopting the existing store",
+		"Medusa has a single store, which cannot be created or deleted. Terraform will adopt it and overwrite its settings. "+
+			"Destroying the resource restores the current settings, or clears them when reset_on_destroy is true.",
+	)
+
+	// The client is not configured yet when the provider configuration is
+	// unknown.
+	if r.client == nil {
+		return
+	}
+
+	content, err := r.client.GetStoreWithResponse(ctx)
+	if err != nil || content.JSON200 == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), content.JSON200.Store.Id)...)
+}
+
+// ImportState adopts the store by id. Its current settings are restored
+// when the resource is destroyed.
 func (r *storeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reset_on_destroy"), false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, original, d := r.readSettings(ctx)
+	if d != nil {
+		resp.Diagnostics.Append(d)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, storeSettingsKey, original)...)
+}
+
+// recordConfiguredTemplates keeps the link templates set in the
+// configuration in private state.
+func recordConfiguredTemplates(ctx context.Context, config tfsdk.Config, private privateState) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configured := []string{}
+	for _, name := range storeTemplates {
+		var template types.String
+		diags.Append(config.GetAttribute(ctx, path.Root(name), &template)...)
+		if !template.IsNull() {
+			configured = append(configured, name)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	b, err := json.Marshal(configured)
+	if err != nil {
+		diags.AddError("Error recording link templates", "Could not encode the configured link templates, unexpected error: "+err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, storeTemplatesKey, b)
+}
+
+// configuredTemplates returns the link templates set in the configuration
+// when the store was last created or updated.
+func configuredTemplates(ctx context.Context, private privateState) (map[string]bool, diag.Diagnostics) {
+	configured := map[string]bool{}
+	b, diags := private.GetKey(ctx, storeTemplatesKey)
+	if diags.HasError() || len(b) == 0 {
+		return configured, diags
+	}
+
+	var names []string
+	if err := json.Unmarshal(b, &names); err != nil {
+		diags.AddError("Error reading link templates", "Could not decode the configured link templates, unexpected error: "+err.Error())
+		return configured, diags
+	}
+	for _, name := range names {
+		configured[name] = true
+	}
+	return configured, diags
+}
+
+// readSettings returns the store together with its current settings,
+// encoded for private state.
+func (r *storeResource) readSettings(ctx context.Context) (*medusa.AdminExtendedStoresRes, []byte, diag.Diagnostic) {
+	content, err := r.client.GetStoreWithResponse(ctx)
+	if err == nil && content.JSON200 == nil {
+		err = fmt.Errorf("status code: %d (%s)", content.StatusCode(), content.Body)
+	}
+
+	var b []byte
+	if err == nil {
+		var settings *storeSettings
+		if settings, err = newStoreSettings(content.JSON200); err == nil {
+			b, err = json.Marshal(settings)
+		}
+	}
+	if err != nil {
+		return nil, nil, diag.NewErrorDiagnostic(
+			"Error reading store",
+			"Could not read the current settings of the store, unexpected error: "+err.Error(),
+		)
+	}
+
+	return content.JSON200, b, nil
 }
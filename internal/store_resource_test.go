@@ -0,0 +1,150 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// The store is a singleton that cannot be deleted, so destroying it restores
+// the settings it had before the test.
+func TestAccStoreResource(t *testing.T) {
+	h := newTestAccHarness(t)
+
+	var original *storeSettings
+	readSettings := func() (*storeSettings, error) {
+		content, err := h.client(t).GetStoreWithResponse(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		return newStoreSettings(content.JSON200)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: h.providerFactories(),
+		CheckDestroy: func(*terraform.State) error {
+			restored, err := readSettings()
+			if err != nil {
+				return err
+			}
+			if restored.Name != original.Name || restored.DefaultCurrencyCode != original.DefaultCurrencyCode {
+				return fmt.Errorf("expected the store to be restored to %s (%s), got %s (%s)",
+					original.Name, original.DefaultCurrencyCode, restored.Name, restored.DefaultCurrencyCode)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					var err error
+					if original, err = readSettings(); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: h.providerConfig() + `
+resource "medusa_store" "test" {
+  name                  = "tf-acc-store"
+  default_currency_code = "eur"
+  currencies            = ["eur"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("medusa_store.test", "id"),
+					resource.TestCheckResourceAttr("medusa_store.test", "name", "tf-acc-store"),
+					resource.TestCheckResourceAttr("medusa_store.test", "default_currency_code", "eur"),
+					resource.TestCheckResourceAttr("medusa_store.test", "currencies.#", "1"),
+					resource.TestCheckResourceAttr("medusa_store.test", "reset_on_destroy", "false"),
+					resource.TestCheckResourceAttrSet("medusa_store.test", "default_sales_channel_id"),
+					resource.TestCheckTypeSetElemAttr("medusa_store.test", "payment_providers.*", "manual"),
+					resource.TestCheckTypeSetElemAttr("medusa_store.test", "fulfillment_providers.*", "manual"),
+				),
+			},
+			{
+				ResourceName:      "medusa_store.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: h.providerConfig() + `
+resource "medusa_store" "test" {
+  name                  = "tf-acc-store-updated"
+  default_currency_code = "eur"
+  currencies            = ["eur"]
+  swap_link_template    = "https://example.com/swap/{cart_id}"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("medusa_store.test", "name", "tf-acc-store-updated"),
+					resource.TestCheckResourceAttr("medusa_store.test", "swap_link_template", "https://example.com/swap/{cart_id}"),
+				),
+			},
+			{
+				// Removing a configured template clears it.
+				Config: h.providerConfig() + `
+resource "medusa_store" "test" {
+  name                  = "tf-acc-store-updated"
+  default_currency_code = "eur"
+  currencies            = ["eur"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("medusa_store.test", "swap_link_template"),
+					func(*terraform.State) error {
+						current, err := readSettings()
+						if err != nil {
+							return err
+						}
+						if current.SwapLinkTemplate != nil && *current.SwapLinkTemplate != "" {
+							return fmt.Errorf("expected the swap link template to be cleared, got %q", *current.SwapLinkTemplate)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestStoreResourceValidateConfig(t *testing.T) {
+	r := &storeResource{}
+	s := testResourceSchema(t, r)
+
+	tests := []struct {
+		name            string
+		defaultCurrency types.String
+		currencies      []types.String
+		wantErr         bool
+	}{
+		{"member", types.StringValue("eur"), []types.String{types.StringValue("usd"), types.StringValue("eur")}, false},
+		{"different case", types.StringValue("EUR"), []types.String{types.StringValue("eur")}, false},
+		{"not a member", types.StringValue("usd"), []types.String{types.StringValue("eur")}, true},
+		{"currencies of the store", types.StringValue("usd"), nil, false},
+		{"unknown currency", types.StringValue("usd"), []types.String{types.StringUnknown()}, false},
+		{"unknown default currency", types.StringUnknown(), []types.String{types.StringValue("eur")}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := testPlan(t, s, storeResourceModel{
+				DefaultCurrencyCode:  tt.defaultCurrency,
+				Currencies:           tt.currencies,
+				PaymentProviders:     types.SetNull(types.StringType),
+				FulfillmentProviders: types.SetNull(types.StringType),
+			})
+
+			var resp fwresource.ValidateConfigResponse
+			r.ValidateConfig(context.Background(), fwresource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: plan.Raw},
+			}, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
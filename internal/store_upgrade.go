@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UpgradeState migrates state written by earlier versions of the schema.
+func (r *storeResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return stateUpgraders(
+		migrateState(storeSchemaV0(), upgradeStoreStateV0),
+	)
+}
+
+// storeResourceModelV0 is the model of schema version 0, which stored the
+// currencies as a list.
+type storeResourceModelV0 struct {
+	ID                  types.String            `tfsdk:"id"`
+	Name                types.String            `tfsdk:"name"`
+	DefaultCurrencyCode types.String            `tfsdk:"default_currency_code"`
+	Currencies          []types.String          `tfsdk:"currencies"`
+	SwapLinkTemplate    types.String            `tfsdk:"swap_link_template"`
+	PaymentLinkTemplate types.String            `tfsdk:"payment_link_template"`
+	InviteLinkTemplate  types.String            `tfsdk:"invite_link_template"`
+	Metadata            map[string]types.String `tfsdk:"metadata"`
+}
+
+// storeSchemaV0 is the schema of version 0, with only what is needed to
+// read the state.
+func storeSchemaV0() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":                    schema.StringAttribute{Computed: true},
+			"name":                  schema.StringAttribute{Optional: true, Computed: true},
+			"default_currency_code": schema.StringAttribute{Required: true},
+			"currencies":            schema.ListAttribute{Optional: true, Computed: true, ElementType: types.StringType},
+			"swap_link_template":    schema.StringAttribute{Optional: true, Computed: true},
+			"payment_link_template": schema.StringAttribute{Optional: true, Computed: true},
+			"invite_link_template":  schema.StringAttribute{Optional: true, Computed: true},
+			"metadata":              schema.MapAttribute{Optional: true, ElementType: types.StringType},
+		},
+	}
+}
+
+// upgradeStoreStateV0 turns the currency list of version 0 into a set.
+func upgradeStoreStateV0(_ context.Context, prior storeResourceModelV0) (storeResourceModel, diag.Diagnostics) {
+	return storeResourceModel{
+		ID:                  prior.ID,
+		Name:                prior.Name,
+		DefaultCurrencyCode: prior.DefaultCurrencyCode,
+		Currencies:          uniqueStrings(prior.Currencies),
+		SwapLinkTemplate:    prior.SwapLinkTemplate,
+		PaymentLinkTemplate: prior.PaymentLinkTemplate,
+		InviteLinkTemplate:  prior.InviteLinkTemplate,
+		Metadata:            prior.Metadata,
+
+		PaymentProviders:     types.SetNull(types.StringType),
+		FulfillmentProviders: types.SetNull(types.StringType),
+	}, nil
+}
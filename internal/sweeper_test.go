@@ -0,0 +1,169 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+// Sweepers delete the objects that acceptance tests leave behind when they
+// die mid-run. They only touch objects named with testAccNamePrefix and run
+// against the admin API configured for recording:
+//
+//	go test ./internal -v -sweep=local
+//
+// The sweep region is not used, as a Medusa server has no regions in the
+// Terraform sense.
+
+func init() {
+	resource.AddTestSweepers("medusa_shipping_option", &resource.Sweeper{
+		Name: "medusa_shipping_option",
+		F:    sweepShippingOptions,
+	})
+}
+
+// sweepable is an object found by a sweeper.
+type sweepable struct {
+	ID   string
+	Name string
+}
+
+// sweepClient returns a client logged in to the admin API.
+func sweepClient(ctx context.Context) (medusa.ClientWithResponsesInterface, error) {
+	factory := &clientFactory{httpClient: http.DefaultClient}
+	client, diags := factory.New(ctx, testAccCredentials())
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to create client: %v", diags)
+	}
+	return client, nil
+}
+
+// sweep returns a sweeper function that lists all objects and deletes the
+// ones named with the test prefix. Objects are only deleted after listing,
+// so deletions do not shift the pages.
+func sweep(
+	resourceType string,
+	list func(ctx context.Context, client medusa.ClientWithResponsesInterface, offset int) ([]sweepable, int, error),
+	del func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error),
+) func(string) error {
+	return func(_ string) error {
+		ctx := context.Background()
+
+		client, err := sweepClient(ctx)
+		if err != nil {
+			return err
+		}
+
+		objects, err := findAll(func(offset int) ([]sweepable, int, error) {
+			return list(ctx, client, offset)
+		})
+		if err != nil {
+			return fmt.Errorf("unable to list %s: %w", resourceType, err)
+		}
+
+		return sweepObjects(ctx, client, resourceType, testAccObjects(objects), del)
+	}
+}
+
+// sweepObjects deletes the objects in order and returns all failures.
+func sweepObjects(
+	ctx context.Context,
+	client medusa.ClientWithResponsesInterface,
+	resourceType string,
+	objects []sweepable,
+	del func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error),
+) error {
+	var errs []error
+	for _, object := range objects {
+		status, err := del(ctx, client, object.ID)
+		if err == nil && status != http.StatusOK && status != http.StatusNotFound {
+			err = fmt.Errorf("status code %d", status)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to delete %s %s (%s): %w", resourceType, object.ID, object.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func testAccObjects(objects []sweepable) []sweepable {
+	var result []sweepable
+	for _, object := range objects {
+		if strings.HasPrefix(object.Name, testAccNamePrefix) {
+			result = append(result, object)
+		}
+	}
+	return result
+}
+
+// sweepShippingOptions deletes shipping options named with the test prefix
+// or attached to a test region or shipping profile, as those block deleting
+// the region or profile.
+func sweepShippingOptions(_ string) error {
+	ctx := context.Background()
+
+	client, err := sweepClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	owners := map[string]bool{}
+	regions, err := findAll(func(offset int) ([]sweepable, int, error) {
+		return listRegions(ctx, client, offset)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list medusa_region: %w", err)
+	}
+	for _, region := range testAccObjects(regions) {
+		owners[region.ID] = true
+	}
+	profiles, _, err := listShippingProfiles(ctx, client, 0)
+	if err != nil {
+		return fmt.Errorf("unable to list medusa_shipping_profile: %w", err)
+	}
+	for _, profile := range testAccObjects(profiles) {
+		owners[profile.ID] = true
+	}
+
+	leaked, err := findAll(func(offset int) ([]sweepable, int, error) {
+		res, err := client.GetShippingOptionsWithResponse(ctx, &medusa.GetShippingOptionsParams{
+			Offset: &offset,
+			Limit:  ptr(importPageSize),
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		if res.JSON200 == nil {
+			return nil, 0, listError(res.StatusCode(), res.Body)
+		}
+
+		var objects []sweepable
+		for _, option := range res.JSON200.ShippingOptions {
+			if strings.HasPrefix(option.Name, testAccNamePrefix) || owners[option.RegionId] || owners[option.ProfileId] {
+				objects = append(objects, sweepable{ID: option.Id, Name: option.Name})
+			}
+		}
+		return objects, res.JSON200.Count, nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to list medusa_shipping_option: %w", err)
+	}
+
+	return sweepObjects(ctx, client, "medusa_shipping_option", leaked,
+		func(ctx context.Context, client medusa.ClientWithResponsesInterface, id string) (int, error) {
+			res, err := client.DeleteShippingOptionsOptionWithResponse(ctx, id)
+			if err != nil {
+				return 0, err
+			}
+			return res.StatusCode(), nil
+		})
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
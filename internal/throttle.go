@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+// NewThrottleTransport limits the requests sent through the inner transport
+// to requestsPerSecond, with at most maxConcurrent requests in flight. A zero
+// value disables the corresponding limit.
+func NewThrottleTransport(innerTransport http.RoundTripper, requestsPerSecond float64, maxConcurrent int) http.RoundTripper {
+	t := &ThrottleTransport{
+		transport: innerTransport,
+	}
+
+	if requestsPerSecond > 0 {
+		burst := int(math.Max(1, math.Ceil(requestsPerSecond)))
+		t.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+
+	return t
+}
+
+// ThrottleTransport is an http.RoundTripper that applies a token bucket rate
+// limit and a cap on concurrent requests.
+type ThrottleTransport struct {
+	transport http.RoundTripper
+	limiter   *rate.Limiter
+	slots     chan struct{}
+}
+
+func (t *ThrottleTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+	start := time.Now()
+
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			t.release()
+			return nil, err
+		}
+	}
+
+	if wait := time.Since(start); wait >= time.Millisecond {
+		tflog.Debug(ctx, "Throttled Medusa API request", map[string]any{
+			"method":  request.Method,
+			"path":    request.URL.Path,
+			"wait_ms": wait.Milliseconds(),
+		})
+	}
+
+	response, err := t.transport.RoundTrip(request)
+	if err != nil || t.slots == nil {
+		t.release()
+		return response, err
+	}
+
+	// Keep the slot until the caller is done reading the response.
+	response.Body = &releasingBody{ReadCloser: response.Body, release: t.release}
+	return response, nil
+}
+
+func (t *ThrottleTransport) release() {
+	if t.slots != nil {
+		<-t.slots
+	}
+}
+
+// releasingBody calls release once the response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
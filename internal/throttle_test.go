@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// The requests after the burst are spread out to the configured rate.
+func TestThrottleTransportRate(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	// A burst of 20 requests, then 10 more at 20 per second.
+	client := &http.Client{Transport: NewThrottleTransport(http.DefaultTransport, 20, 0)}
+	start := time.Now()
+	for i := 0; i < 30; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	elapsed := time.Since(start)
+
+	if requests.Load() != 30 {
+		t.Errorf("expected 30 requests, got %d", requests.Load())
+	}
+	if elapsed < 400*time.Millisecond || elapsed > 2*time.Second {
+		t.Errorf("expected the requests to take about 500ms, took %s", elapsed)
+	}
+}
+
+// No more than the configured number of requests are in flight, counting a
+// request until its response body is closed.
+func TestThrottleTransportMaxConcurrent(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		for {
+			highest := maxInFlight.Load()
+			if n <= highest || maxInFlight.CompareAndSwap(highest, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		inFlight.Add(-1)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewThrottleTransport(http.DefaultTransport, 0, 2)}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				errs <- err
+				return
+			}
+			defer resp.Body.Close()
+			if _, err := io.ReadAll(resp.Body); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+	if got := maxInFlight.Load(); got != 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", got)
+	}
+}
+
+// A request waiting for the limiter or a free slot stops when its context is
+// cancelled, without reaching the server.
+func TestThrottleTransportCancelled(t *testing.T) {
+	tests := []struct {
+		name              string
+		requestsPerSecond float64
+		maxConcurrent     int
+	}{
+		{name: "rate limit", requestsPerSecond: 0.1},
+		{name: "concurrency cap", maxConcurrent: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: NewThrottleTransport(http.DefaultTransport, tt.requestsPerSecond, tt.maxConcurrent)}
+
+			// The first request takes the only token or slot, and keeps the
+			// slot as long as its body is open.
+			first, err := client.Get(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer first.Body.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			start := time.Now()
+			_, err = client.Do(request)
+			if err == nil {
+				t.Error("expected the request to stop with its context")
+			}
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Errorf("expected the request to stop when its context ends, waited %s", elapsed)
+			}
+			if requests.Load() != 1 {
+				t.Errorf("expected only the first request to reach the server, got %d", requests.Load())
+			}
+		})
+	}
+}
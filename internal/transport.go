@@ -0,0 +1,157 @@
+package internal
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// transportConfig holds the connection and logging settings of the HTTP client.
+type transportConfig struct {
+	CACertPEM          string
+	CACertFile         string
+	ClientCert         string
+	ClientKey          string
+	InsecureSkipVerify bool
+	ProxyURL           string
+	RequestTimeout     time.Duration
+	Headers            map[string]string
+	DebugHTTP          bool
+	DebugBodySize      int
+}
+
+// newBaseTransport returns the transport that talks to the network, set up
+// with the TLS and proxy settings of the configuration.
+func newBaseTransport(cfg transportConfig) (http.RoundTripper, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	var rt http.RoundTripper = transport
+	if cfg.RequestTimeout > 0 {
+		rt = NewTimeoutTransport(rt, cfg.RequestTimeout)
+	}
+	if len(cfg.Headers) > 0 {
+		rt = NewHeaderTransport(rt, cfg.Headers)
+	}
+
+	return rt, nil
+}
+
+// newTLSConfig returns nil when the configuration does not change any of the
+// TLS defaults.
+func newTLSConfig(cfg transportConfig) (*tls.Config, error) {
+	if cfg.CACertPEM == "" && cfg.CACertFile == "" && cfg.ClientCert == "" && !cfg.InsecureSkipVerify {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// Skipping verification is an explicit opt-in of the user.
+		InsecureSkipVerify: cfg.InsecureSkipVerify, //nolint:gosec
+	}
+
+	if cfg.CACertPEM != "" || cfg.CACertFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if cfg.CACertPEM != "" && !pool.AppendCertsFromPEM([]byte(cfg.CACertPEM)) {
+			return nil, errors.New("ca_cert_pem does not contain a PEM encoded certificate")
+		}
+
+		if cfg.CACertFile != "" {
+			pem, err := os.ReadFile(cfg.CACertFile)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read ca_cert_file: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("%s does not contain a PEM encoded certificate", cfg.CACertFile)
+			}
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	if cfg.ClientCert != "" || cfg.ClientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(cfg.ClientCert), []byte(cfg.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// NewHeaderTransport adds the given headers to every request that does not
+// set them already.
+func NewHeaderTransport(innerTransport http.RoundTripper, headers map[string]string) http.RoundTripper {
+	return &HeaderTransport{
+		transport: innerTransport,
+		headers:   headers,
+	}
+}
+
+type HeaderTransport struct {
+	transport http.RoundTripper
+	headers   map[string]string
+}
+
+func (t *HeaderTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	request = request.Clone(request.Context())
+	for key, value := range t.headers {
+		if request.Header.Get(key) == "" {
+			request.Header.Set(key, value)
+		}
+	}
+	return t.transport.RoundTrip(request)
+}
+
+// NewTimeoutTransport limits the time of a single request, from sending it
+// until the response body is closed.
+func NewTimeoutTransport(innerTransport http.RoundTripper, timeout time.Duration) http.RoundTripper {
+	return &TimeoutTransport{
+		transport: innerTransport,
+		timeout:   timeout,
+	}
+}
+
+type TimeoutTransport struct {
+	transport http.RoundTripper
+	timeout   time.Duration
+}
+
+func (t *TimeoutTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(request.Context(), t.timeout)
+
+	response, err := t.transport.RoundTrip(request.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return response, err
+	}
+
+	response.Body = &releasingBody{ReadCloser: response.Body, release: cancel}
+	return response, nil
+}
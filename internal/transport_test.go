@@ -0,0 +1,171 @@
+package internal
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewTLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	badFile := filepath.Join(dir, "bad.pem")
+	if err := os.WriteFile(caFile, []byte(caPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(badFile, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		cfg         transportConfig
+		wantNil     bool
+		wantErr     string
+		wantTrusted bool
+	}{
+		{name: "defaults", wantNil: true},
+		{name: "insecure", cfg: transportConfig{InsecureSkipVerify: true}, wantTrusted: true},
+		{name: "ca pem", cfg: transportConfig{CACertPEM: caPEM}, wantTrusted: true},
+		{name: "ca file", cfg: transportConfig{CACertFile: caFile}, wantTrusted: true},
+		{name: "bad ca pem", cfg: transportConfig{CACertPEM: "not a certificate"}, wantErr: "ca_cert_pem does not contain a PEM encoded certificate"},
+		{name: "missing ca file", cfg: transportConfig{CACertFile: filepath.Join(dir, "missing.pem")}, wantErr: "unable to read ca_cert_file"},
+		{name: "bad ca file", cfg: transportConfig{CACertFile: badFile}, wantErr: badFile + " does not contain a PEM encoded certificate"},
+		{name: "bad client certificate", cfg: transportConfig{ClientCert: "cert", ClientKey: "key"}, wantErr: "invalid client certificate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tlsConfig, err := newTLSConfig(tt.cfg)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("expected error %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (tlsConfig == nil) != tt.wantNil {
+				t.Fatalf("expected nil config %v, got %v", tt.wantNil, tlsConfig)
+			}
+
+			// The test server is only trusted with the settings that name
+			// its certificate or skip verification.
+			transport, err := newBaseTransport(tt.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if (err == nil) != tt.wantTrusted {
+				t.Errorf("expected the server to be trusted %v, got %v", tt.wantTrusted, err)
+			}
+		})
+	}
+}
+
+func TestNewBaseTransportProxy(t *testing.T) {
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.String())
+	}))
+	defer proxy.Close()
+
+	transport, err := newBaseTransport(transportConfig{ProxyURL: proxy.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := (&http.Client{Transport: transport}).Get("http://medusa.invalid/admin/store")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if len(proxied) != 1 || proxied[0] != "http://medusa.invalid/admin/store" {
+		t.Errorf("expected the request to go through the proxy, got %v", proxied)
+	}
+
+	_, err = newBaseTransport(transportConfig{ProxyURL: "http://proxy.example.com:port"})
+	if err == nil || !strings.Contains(err.Error(), "invalid proxy URL") {
+		t.Errorf("expected an invalid proxy URL error, got %v", err)
+	}
+}
+
+// The configured headers are added to the requests that do not set them.
+func TestHeaderTransport(t *testing.T) {
+	var got http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewHeaderTransport(http.DefaultTransport, map[string]string{
+		"X-Tenant":      "acme",
+		"Authorization": "Basic configured",
+	})}
+
+	request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request.Header.Set("Authorization", "Bearer token")
+	resp, err := client.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if got.Get("X-Tenant") != "acme" {
+		t.Errorf("expected the configured header, got %q", got.Get("X-Tenant"))
+	}
+	if got.Get("Authorization") != "Bearer token" {
+		t.Errorf("expected the header of the request to be kept, got %q", got.Get("Authorization"))
+	}
+	if request.Header.Get("X-Tenant") != "" {
+		t.Error("expected the request to be left as it is")
+	}
+}
+
+// The timeout covers the whole request, but not the time after the body is
+// closed.
+func TestTimeoutTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTimeoutTransport(http.DefaultTransport, 100*time.Millisecond)}
+
+	_, err := client.Get(server.URL + "/slow")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the request to time out, got %v", err)
+	}
+
+	resp, err := client.Get(server.URL + "/fast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil || string(body) != "ok" {
+		t.Errorf("expected the body to be read before the timeout, got %q: %v", body, err)
+	}
+}
@@ -0,0 +1,83 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// stateMigration upgrades state from one schema version to the next. It is
+// created with migrateState, which keeps the prior and next models typed.
+type stateMigration struct {
+	schema  schema.Schema
+	read    func(ctx context.Context, state tfsdk.State) (any, diag.Diagnostics)
+	migrate func(ctx context.Context, prior any) (any, diag.Diagnostics)
+}
+
+// migrateState returns the migration from the version described by prior,
+// read into a P, to the next version N. The model of a prior version must be
+// kept as it was, as the framework requires the model to match the schema.
+func migrateState[P, N any](prior schema.Schema, migrate func(ctx context.Context, prior P) (N, diag.Diagnostics)) stateMigration {
+	return stateMigration{
+		schema: prior,
+		read: func(ctx context.Context, state tfsdk.State) (any, diag.Diagnostics) {
+			var model P
+			diags := state.Get(ctx, &model)
+			return model, diags
+		},
+		migrate: func(ctx context.Context, prior any) (any, diag.Diagnostics) {
+			model, ok := prior.(P)
+			if !ok {
+				var diags diag.Diagnostics
+				diags.AddError(
+					"Unable to Upgrade Resource State",
+					fmt.Sprintf("The state migrations are out of order: expected %T, got %T. "+
+						"Please report this issue to the provider developers.", model, prior),
+				)
+				return nil, diags
+			}
+			return migrate(ctx, model)
+		},
+	}
+}
+
+// stateUpgraders returns the upgraders of a resource from its migrations,
+// where migrations[i] upgrades version i to version i+1. The schema version
+// of the resource is therefore len(migrations). The framework upgrades in a
+// single step, so the upgrader of each version runs all later migrations in
+// turn and the last one must return the current model.
+func stateUpgraders(migrations ...stateMigration) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, len(migrations))
+
+	for version := range migrations {
+		prior := migrations[version].schema
+		remaining := migrations[version:]
+
+		upgraders[int64(version)] = resource.StateUpgrader{
+			PriorSchema: &prior,
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				state, diags := remaining[0].read(ctx, *req.State)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				for _, m := range remaining {
+					state, diags = m.migrate(ctx, state)
+					resp.Diagnostics.Append(diags...)
+					if resp.Diagnostics.HasError() {
+						return
+					}
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		}
+	}
+
+	return upgraders
+}
@@ -0,0 +1,183 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// The golden states in testdata/state/<type>/v<version>.json hold a state as
+// Terraform stored it with each schema version. Every one of them must
+// upgrade to the state of the current version, so a schema change that
+// breaks existing state without a migration fails here. When the schema
+// version is bumped, add the state of the new version next to the others.
+
+func TestStateUpgrades(t *testing.T) {
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New())()
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for typeName, s := range schemas.ResourceSchemas {
+		t.Run(typeName, func(t *testing.T) {
+			files, err := filepath.Glob(filepath.Join("testdata", "state", typeName, "v*.json"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(files) != int(s.Version)+1 {
+				t.Fatalf("expected a golden state for each of the versions 0 to %d, got %v", s.Version, files)
+			}
+
+			want, err := (&tfprotov6.RawState{JSON: testGoldenState(t, typeName, s.Version)}).Unmarshal(s.ValueType())
+			if err != nil {
+				t.Fatalf("the golden state of the current version %d does not match the schema: %s", s.Version, err)
+			}
+
+			for version := int64(0); version <= s.Version; version++ {
+				t.Run(fmt.Sprintf("v%d", version), func(t *testing.T) {
+					got := testUpgradeState(t, typeName, version, testGoldenState(t, typeName, version))
+					if diff, err := want.Diff(got); err != nil || len(diff) > 0 {
+						t.Errorf("upgraded state differs from the golden state of version %d: %v %v", s.Version, diff, err)
+					}
+				})
+			}
+		})
+	}
+}
+
+func testGoldenState(t *testing.T, typeName string, version int64) []byte {
+	t.Helper()
+
+	b, err := os.ReadFile(filepath.Join("testdata", "state", typeName, fmt.Sprintf("v%d.json", version)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+type testModelV0 struct {
+	Size types.String `tfsdk:"size"`
+}
+
+type testModelV1 struct {
+	Size types.Int64 `tfsdk:"size"`
+}
+
+type testModelV2 struct {
+	Size  types.Int64  `tfsdk:"size"`
+	Label types.String `tfsdk:"label"`
+}
+
+func TestStateUpgraders(t *testing.T) {
+	ctx := context.Background()
+
+	schemaV0 := schema.Schema{Attributes: map[string]schema.Attribute{
+		"size": schema.StringAttribute{Optional: true},
+	}}
+	schemaV1 := schema.Schema{Attributes: map[string]schema.Attribute{
+		"size": schema.Int64Attribute{Optional: true},
+	}}
+	schemaV2 := schema.Schema{Version: 2, Attributes: map[string]schema.Attribute{
+		"size":  schema.Int64Attribute{Optional: true},
+		"label": schema.StringAttribute{Computed: true},
+	}}
+
+	var calls []string
+	upgraders := stateUpgraders(
+		migrateState(schemaV0, func(_ context.Context, prior testModelV0) (testModelV1, diag.Diagnostics) {
+			calls = append(calls, "v0")
+			return testModelV1{Size: types.Int64Value(int64(len(prior.Size.ValueString())))}, nil
+		}),
+		migrateState(schemaV1, func(_ context.Context, prior testModelV1) (testModelV2, diag.Diagnostics) {
+			calls = append(calls, "v1")
+			return testModelV2{Size: prior.Size, Label: types.StringValue("migrated")}, nil
+		}),
+	)
+
+	tests := []struct {
+		version   int64
+		prior     any
+		wantCalls []string
+		wantSize  int64
+	}{
+		{0, testModelV0{Size: types.StringValue("xxl")}, []string{"v0", "v1"}, 3},
+		{1, testModelV1{Size: types.Int64Value(5)}, []string{"v1"}, 5},
+	}
+
+	if len(upgraders) != len(tests) {
+		t.Fatalf("expected %d upgraders, got %d", len(tests), len(upgraders))
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("v%d", tt.version), func(t *testing.T) {
+			calls = nil
+			upgrader := upgraders[tt.version]
+
+			prior := tfsdk.State{Schema: *upgrader.PriorSchema, Raw: tftypes.NewValue(upgrader.PriorSchema.Type().TerraformType(ctx), nil)}
+			if diags := prior.Set(ctx, tt.prior); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			resp := resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaV2, Raw: tftypes.NewValue(schemaV2.Type().TerraformType(ctx), nil)}}
+			upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{State: &prior}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+
+			var got testModelV2
+			if diags := resp.State.Get(ctx, &got); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if got.Size.ValueInt64() != tt.wantSize || got.Label.ValueString() != "migrated" {
+				t.Errorf("unexpected upgraded state %+v", got)
+			}
+			if fmt.Sprint(calls) != fmt.Sprint(tt.wantCalls) {
+				t.Errorf("expected migrations %v, got %v", tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestStateUpgradersOutOfOrder(t *testing.T) {
+	ctx := context.Background()
+
+	schemaV0 := schema.Schema{Attributes: map[string]schema.Attribute{
+		"size": schema.StringAttribute{Optional: true},
+	}}
+	upgraders := stateUpgraders(
+		migrateState(schemaV0, func(_ context.Context, prior testModelV0) (testModelV0, diag.Diagnostics) {
+			return prior, nil
+		}),
+		// Expects a model the previous migration does not return.
+		migrateState(schemaV0, func(_ context.Context, prior testModelV1) (testModelV1, diag.Diagnostics) {
+			return prior, nil
+		}),
+	)
+
+	prior := tfsdk.State{Schema: schemaV0, Raw: tftypes.NewValue(schemaV0.Type().TerraformType(ctx), nil)}
+	if diags := prior.Set(ctx, testModelV0{Size: types.StringValue("m")}); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var resp resource.UpgradeStateResponse
+	upgraders[0].StateUpgrader(ctx, resource.UpgradeStateRequest{State: &prior}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected a diagnostic for migrations out of order")
+	}
+}
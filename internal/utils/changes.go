@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The update requests only carry the attributes whose plan value differs
+// from the prior state, so an update does not overwrite the changes made to
+// other attributes outside of Terraform. Like the Convert functions, they
+// return nil for a null or unknown plan value.
+
+// ChangedString returns the plan value when it differs from prior. A null
+// plan value clears the prior value with an empty string, as leaving the
+// attribute out of the request keeps it.
+func ChangedString(plan, prior types.String) *string {
+	if plan.Equal(prior) {
+		return nil
+	}
+	if plan.IsNull() && isKnown(prior) && prior.ValueString() != "" {
+		empty := ""
+		return &empty
+	}
+	return ConvertToPointerString(plan)
+}
+
+// ChangedBool returns the plan value when it differs from prior.
+func ChangedBool(plan, prior types.Bool) *bool {
+	if plan.Equal(prior) {
+		return nil
+	}
+	return ConvertToPointerBool(plan)
+}
+
+// ChangedNumber returns the plan value when it differs from prior.
+func ChangedNumber(plan, prior types.Number) *json.Number {
+	if plan.Equal(prior) {
+		return nil
+	}
+	return ConvertToPointerJSONNumber(plan)
+}
+
+// ChangedStringSlice returns the plan values when they differ from prior.
+func ChangedStringSlice(plan, prior []types.String) *[]string {
+	if equalStrings(plan, prior, func(a, b string) bool { return a == b }) {
+		return nil
+	}
+	return ConvertToPointerStringSlice(plan)
+}
+
+// ChangedCode returns the plan code when it differs from prior in more than
+// case.
+func ChangedCode(plan, prior types.String) *string {
+	if plan.Equal(prior) || (isKnown(plan) && isKnown(prior) && strings.EqualFold(plan.ValueString(), prior.ValueString())) {
+		return nil
+	}
+	return ConvertToPointerCode(plan)
+}
+
+// ChangedCodes returns the plan codes when they differ from prior in more
+// than case.
+func ChangedCodes(plan, prior []types.String) *[]string {
+	if equalStrings(plan, prior, strings.EqualFold) {
+		return nil
+	}
+	return ConvertToPointerCodes(plan)
+}
+
+func isKnown(s types.String) bool {
+	return !s.IsNull() && !s.IsUnknown()
+}
+
+// equalStrings reports whether the known values of a and b are equal, in
+// order.
+func equalStrings(a, b []types.String, equal func(a, b string) bool) bool {
+	if (a == nil) != (b == nil) || len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !isKnown(a[i]) || !isKnown(b[i]) || !equal(a[i].ValueString(), b[i].ValueString()) {
+			return false
+		}
+	}
+	return true
+}
+
+// NoChanges reports whether an update request carries no attribute, as when
+// only attributes kept in the Terraform state changed.
+func NoChanges(input any) bool {
+	b, err := json.Marshal(input)
+	return err == nil && string(b) == "{}"
+}
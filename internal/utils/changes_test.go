@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deref formats a pointer returned by the Changed functions.
+func deref[T any](p *T) string {
+	if p == nil {
+		return "nil"
+	}
+	return fmt.Sprint(*p)
+}
+
+func TestChanged(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"string unchanged", deref(ChangedString(types.StringValue("a"), types.StringValue("a"))), "nil"},
+		{"string changed", deref(ChangedString(types.StringValue("b"), types.StringValue("a"))), "b"},
+		{"string added", deref(ChangedString(types.StringValue("b"), types.StringNull())), "b"},
+		{"string unknown", deref(ChangedString(types.StringUnknown(), types.StringValue("a"))), "nil"},
+		{"string removed", deref(ChangedString(types.StringNull(), types.StringValue("a"))), ""},
+		{"empty string removed", deref(ChangedString(types.StringNull(), types.StringValue(""))), "nil"},
+		{"bool unchanged", deref(ChangedBool(types.BoolValue(false), types.BoolValue(false))), "nil"},
+		{"bool changed", deref(ChangedBool(types.BoolValue(true), types.BoolValue(false))), "true"},
+		{"number unchanged", deref(ChangedNumber(number(t, "12.5"), number(t, "12.5"))), "nil"},
+		{"number changed", deref(ChangedNumber(number(t, "20"), number(t, "12.5"))), "20"},
+		{"number decimals", deref(ChangedNumber(number(t, "12.3456789"), number(t, "12.5"))), "12.3456789"},
+		{"slice unchanged", deref(ChangedStringSlice(ConvertToTerraformStringSlice([]string{"a", "b"}), ConvertToTerraformStringSlice([]string{"a", "b"}))), "nil"},
+		{"slice reordered", deref(ChangedStringSlice(ConvertToTerraformStringSlice([]string{"b", "a"}), ConvertToTerraformStringSlice([]string{"a", "b"}))), "[b a]"},
+		{"slice emptied", deref(ChangedStringSlice([]types.String{}, ConvertToTerraformStringSlice([]string{"a"}))), "[]"},
+		{"slice removed", deref(ChangedStringSlice(nil, ConvertToTerraformStringSlice([]string{"a"}))), "nil"},
+		{"code case", deref(ChangedCode(types.StringValue("EUR"), types.StringValue("eur"))), "nil"},
+		{"code changed", deref(ChangedCode(types.StringValue("USD"), types.StringValue("eur"))), "usd"},
+		{"codes case", deref(ChangedCodes(ConvertToTerraformStringSlice([]string{"NL"}), ConvertToTerraformStringSlice([]string{"nl"}))), "nil"},
+		{"codes changed", deref(ChangedCodes(ConvertToTerraformStringSlice([]string{"NL", "BE"}), ConvertToTerraformStringSlice([]string{"nl"}))), "[nl be]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %s, want %s", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -1,18 +1,98 @@
 package utils
 
 import (
-	"github.com/ikhvost/medusajs-go-sdk/medusa"
+	"context"
+	"fmt"
 	"net/http"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
 )
 
-func GetClient(data any) medusa.ClientWithResponsesInterface {
-	c, ok := data.(medusa.ClientWithResponsesInterface)
-	if !ok {
-		panic("invalid client type")
+// Client is the connection to the Medusa admin API that the provider hands
+// to its resources. Besides the SDK calls it describes the server it talks
+// to. The clientfake package provides a generated fake for unit tests.
+type Client interface {
+	medusa.ClientWithResponsesInterface
+
+	// BaseURL returns the URL of the Medusa server.
+	BaseURL() string
+	// FeatureFlags returns the feature flags reported by the server. They
+	// are read from the store on the first call, so that configuring the
+	// provider does not cost a request.
+	FeatureFlags(ctx context.Context) (map[string]bool, error)
+}
+
+// NewClient wraps an SDK client with the description of its server. Without
+// featureFlags they are read from the server when first needed.
+func NewClient(client medusa.ClientWithResponsesInterface, baseURL string, featureFlags map[string]bool) Client {
+	c := &medusaClient{
+		ClientWithResponsesInterface: client,
+		baseURL:                      baseURL,
+		featureFlags:                 featureFlags,
+	}
+	if featureFlags != nil {
+		c.once.Do(func() {})
 	}
 	return c
 }
 
+type medusaClient struct {
+	medusa.ClientWithResponsesInterface
+
+	baseURL string
+
+	once         sync.Once
+	featureFlags map[string]bool
+	err          error
+}
+
+func (c *medusaClient) BaseURL() string {
+	return c.baseURL
+}
+
+func (c *medusaClient) FeatureFlags(ctx context.Context) (map[string]bool, error) {
+	c.once.Do(func() {
+		c.featureFlags, c.err = c.readFeatureFlags(ctx)
+	})
+	return c.featureFlags, c.err
+}
+
+func (c *medusaClient) readFeatureFlags(ctx context.Context) (map[string]bool, error) {
+	resp, err := c.GetStoreWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, fmt.Errorf("the server at %s has no /admin/store endpoint, "+
+			"while the provider is built for the Medusa v1 admin API", c.baseURL)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("reading the store failed with status %s: %s", resp.Status(), resp.Body)
+	}
+
+	featureFlags := make(map[string]bool, len(resp.JSON200.Store.FeatureFlags))
+	for _, flag := range resp.JSON200.Store.FeatureFlags {
+		featureFlags[flag.Key] = flag.Value
+	}
+	return featureFlags, nil
+}
+
+// GetClient returns the client passed by the provider as resource or data
+// source data, or a diagnostic when the data has an unexpected type.
+func GetClient(data any) (Client, *diag.ErrorDiagnostic) {
+	c, ok := data.(Client)
+	if !ok {
+		d := diag.NewErrorDiagnostic(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected utils.Client, got: %T. Please report this issue to the provider developers.", data),
+		)
+		return nil, &d
+	}
+	return c, nil
+}
+
 func CleanHeaders(headers http.Header, keep ...string) http.Header {
 	for key := range headers {
 		if !contains(keep, key) {
@@ -0,0 +1,88 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ikhvost/medusajs-go-sdk/medusa"
+)
+
+// The feature flags are read from the store once, when first needed.
+func TestClientFeatureFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		want    bool
+		wantErr string
+	}{
+		{
+			name:   "read",
+			status: http.StatusOK,
+			body:   `{"store":{"id":"store_01","feature_flags":[{"key":"sales_channels","value":true}]}}`,
+			want:   true,
+		},
+		{
+			name:    "no store endpoint",
+			status:  http.StatusNotFound,
+			body:    `{"type":"not_found","message":"Not Found"}`,
+			wantErr: "has no /admin/store endpoint",
+		},
+		{
+			name:    "server error",
+			status:  http.StatusInternalServerError,
+			body:    `{"message":"boom"}`,
+			wantErr: "reading the store failed with status 500",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			sdk, err := medusa.NewClientWithResponses(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			client := NewClient(sdk, server.URL, nil)
+			if requests != 0 {
+				t.Fatalf("expected no request before the feature flags are needed, got %d", requests)
+			}
+
+			for i := 0; i < 2; i++ {
+				flags, err := client.FeatureFlags(context.Background())
+				if tt.wantErr != "" {
+					if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+						t.Errorf("expected error %q, got %v", tt.wantErr, err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				if flags["sales_channels"] != tt.want {
+					t.Errorf("expected the feature flags of the store, got %v", flags)
+				}
+			}
+			if requests != 1 {
+				t.Errorf("expected the store to be read once, got %d requests", requests)
+			}
+		})
+	}
+
+	// Feature flags passed to the client are not read again.
+	client := NewClient(nil, "", map[string]bool{"sales_channels": true})
+	if flags, err := client.FeatureFlags(context.Background()); err != nil || !flags["sales_channels"] {
+		t.Errorf("expected the given feature flags, got %v: %v", flags, err)
+	}
+}
@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Medusa stores currency and country codes in lower case, while they are
+// commonly written in upper case. The codes of a plan are sent in lower
+// case, and the case of the plan or prior state is kept when the API returns
+// the same code, so configuring EUR does not show a change to eur.
+
+// ConvertToCode converts a code of a plan to the request value.
+func ConvertToCode(s types.String) string {
+	return strings.ToLower(s.ValueString())
+}
+
+// ConvertToPointerCode returns nil for a null or unknown code, like
+// ConvertToPointerString.
+func ConvertToPointerCode(s types.String) *string {
+	if s.IsNull() || s.IsUnknown() {
+		return nil
+	}
+	code := ConvertToCode(s)
+	return &code
+}
+
+// ConvertToCodes converts the codes of a plan to the request value.
+func ConvertToCodes(slice []types.String) []string {
+	if slice == nil {
+		return nil
+	}
+
+	result := make([]string, len(slice))
+	for i, v := range slice {
+		result[i] = ConvertToCode(v)
+	}
+	return result
+}
+
+// ConvertToPointerCodes returns nil for a null slice of codes.
+func ConvertToPointerCodes(slice []types.String) *[]string {
+	if slice == nil {
+		return nil
+	}
+	result := ConvertToCodes(slice)
+	return &result
+}
+
+// ConvertToTerraformCode converts a code of the API to the state value,
+// keeping the prior value when it only differs in case.
+func ConvertToTerraformCode(prior types.String, remote string) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() && strings.EqualFold(prior.ValueString(), remote) {
+		return prior
+	}
+	return types.StringValue(remote)
+}
+
+// ConvertToTerraformCodes converts the codes of the API to the state value,
+// keeping the prior values that only differ in case.
+func ConvertToTerraformCodes(prior []types.String, remote []string) []types.String {
+	byCode := make(map[string]types.String, len(prior))
+	for _, v := range prior {
+		if !v.IsNull() && !v.IsUnknown() {
+			byCode[strings.ToLower(v.ValueString())] = v
+		}
+	}
+
+	result := make([]types.String, len(remote))
+	for i, code := range remote {
+		if v, ok := byCode[strings.ToLower(code)]; ok {
+			result[i] = v
+		} else {
+			result[i] = types.StringValue(code)
+		}
+	}
+	return result
+}
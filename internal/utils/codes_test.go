@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConvertToCodes(t *testing.T) {
+	if got := ConvertToCodes([]types.String{types.StringValue("NL"), types.StringValue("be")}); !reflect.DeepEqual(got, []string{"nl", "be"}) {
+		t.Errorf("ConvertToCodes() = %#v", got)
+	}
+	if got := ConvertToPointerCodes(nil); got != nil {
+		t.Errorf("ConvertToPointerCodes(nil) = %#v, want nil", *got)
+	}
+	if got := ConvertToPointerCode(types.StringValue("EUR")); got == nil || *got != "eur" {
+		t.Errorf("ConvertToPointerCode() = %v, want eur", got)
+	}
+	if got := ConvertToPointerCode(types.StringUnknown()); got != nil {
+		t.Errorf("ConvertToPointerCode(unknown) = %q, want nil", *got)
+	}
+}
+
+func TestConvertToTerraformCode(t *testing.T) {
+	tests := []struct {
+		name   string
+		prior  types.String
+		remote string
+		want   types.String
+	}{
+		{"same", types.StringValue("eur"), "eur", types.StringValue("eur")},
+		{"different case", types.StringValue("EUR"), "eur", types.StringValue("EUR")},
+		{"changed", types.StringValue("EUR"), "usd", types.StringValue("usd")},
+		{"unknown", types.StringUnknown(), "eur", types.StringValue("eur")},
+		{"null", types.StringNull(), "eur", types.StringValue("eur")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertToTerraformCode(tt.prior, tt.remote); !got.Equal(tt.want) {
+				t.Errorf("ConvertToTerraformCode() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertToTerraformCodes(t *testing.T) {
+	prior := ConvertToTerraformStringSlice([]string{"NL", "be", "Lu"})
+	got := ConvertToTerraformCodes(prior, []string{"be", "de", "nl"})
+	want := ConvertToTerraformStringSlice([]string{"be", "de", "NL"})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ConvertToTerraformCodes() = %v, want %v", got, want)
+	}
+
+	if got := ConvertToTerraformCodes(nil, []string{"nl"}); !reflect.DeepEqual(got, ConvertToTerraformStringSlice([]string{"nl"})) {
+		t.Errorf("ConvertToTerraformCodes(nil) = %v", got)
+	}
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ConvertToMetadata converts the metadata of a plan to the request value
+// used on create. A null map is left out of the request.
+func ConvertToMetadata(plan map[string]types.String) *map[string]any {
+	if plan == nil {
+		return nil
+	}
+
+	result := make(map[string]any, len(plan))
+	for k, v := range plan {
+		result[k] = v.ValueString()
+	}
+
+	return &result
+}
+
+// ConvertToMetadataUpdate converts the metadata of a plan to the request
+// value used on update. Only the keys whose value differs from the prior
+// state are sent. Keys that are in the prior state but no longer in the plan
+// are sent as an empty string, which Medusa treats as a request to remove
+// them. Keys that were never managed are left untouched.
+func ConvertToMetadataUpdate(plan, prior map[string]types.String) *map[string]any {
+	result := map[string]any{}
+	for k := range prior {
+		if _, ok := plan[k]; !ok {
+			result[k] = ""
+		}
+	}
+	for k, v := range plan {
+		if p, ok := prior[k]; ok && p.Equal(v) {
+			continue
+		}
+		result[k] = v.ValueString()
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+	return &result
+}
+
+// ConvertToTerraformMetadata converts the metadata of the API to the state
+// value. Only the keys in managed, the metadata of the plan or prior state,
+// are kept, so metadata written by other integrations does not show up as
+// drift while a changed or removed managed key does. Values that are not
+// strings are stored as JSON.
+func ConvertToTerraformMetadata(managed map[string]types.String, remote *map[string]any) map[string]types.String {
+	if managed == nil {
+		return nil
+	}
+
+	result := make(map[string]types.String, len(managed))
+	if remote == nil {
+		return result
+	}
+
+	for k := range managed {
+		v, ok := (*remote)[k]
+		if !ok || v == nil {
+			continue
+		}
+		if s, ok := v.(string); ok {
+			result[k] = types.StringValue(s)
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			continue
+		}
+		result[k] = types.StringValue(string(b))
+	}
+
+	return result
+}
+
+// JSONBody encodes a request body for the raw body variants of the SDK
+// methods, used where the SDK request types lack fields Medusa accepts.
+func JSONBody(v any) (io.Reader, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(b), nil
+}
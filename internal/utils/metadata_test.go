@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func metadata(kv ...string) map[string]types.String {
+	result := map[string]types.String{}
+	for i := 0; i < len(kv); i += 2 {
+		result[kv[i]] = types.StringValue(kv[i+1])
+	}
+	return result
+}
+
+func TestConvertToMetadata(t *testing.T) {
+	if got := ConvertToMetadata(nil); got != nil {
+		t.Errorf("ConvertToMetadata(nil) = %#v, want nil", *got)
+	}
+
+	got := ConvertToMetadata(metadata("erp_id", "42"))
+	if want := (map[string]any{"erp_id": "42"}); got == nil || !reflect.DeepEqual(*got, want) {
+		t.Errorf("ConvertToMetadata() = %#v, want %#v", got, want)
+	}
+}
+
+func TestConvertToMetadataUpdate(t *testing.T) {
+	tests := []struct {
+		name  string
+		plan  map[string]types.String
+		prior map[string]types.String
+		want  map[string]any
+	}{
+		{"unmanaged", nil, nil, nil},
+		{"empty", metadata(), metadata(), nil},
+		{"added", metadata("a", "1"), nil, map[string]any{"a": "1"}},
+		{"changed", metadata("a", "2"), metadata("a", "1"), map[string]any{"a": "2"}},
+		{"unchanged", metadata("a", "1"), metadata("a", "1"), nil},
+		{"removed key", metadata("a", "1"), metadata("a", "1", "b", "2"), map[string]any{"b": ""}},
+		{"removed map", nil, metadata("a", "1"), map[string]any{"a": ""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ConvertToMetadataUpdate(tt.plan, tt.prior)
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("ConvertToMetadataUpdate() = %#v, want nil", *got)
+				}
+				return
+			}
+			if got == nil || !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("ConvertToMetadataUpdate() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertToTerraformMetadata(t *testing.T) {
+	remote := &map[string]any{
+		"erp_id":  "42",
+		"count":   float64(3),
+		"nested":  map[string]any{"a": true},
+		"foreign": "set by another integration",
+	}
+
+	tests := []struct {
+		name    string
+		managed map[string]types.String
+		remote  *map[string]any
+		want    map[string]types.String
+	}{
+		{"unmanaged", nil, remote, nil},
+		{"no remote metadata", metadata("erp_id", "42"), nil, metadata()},
+		{"managed keys only", metadata("erp_id", "1"), remote, metadata("erp_id", "42")},
+		{"removed remotely", metadata("erp_id", "42", "gone", "x"), remote, metadata("erp_id", "42")},
+		{"json values", metadata("count", "", "nested", ""), remote, metadata("count", "3", "nested", `{"a":true}`)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertToTerraformMetadata(tt.managed, tt.remote); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ConvertToTerraformMetadata() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONBody(t *testing.T) {
+	body, err := JSONBody(struct {
+		Name     string          `json:"name"`
+		Metadata *map[string]any `json:"metadata,omitempty"`
+	}{Name: "Europe", Metadata: ConvertToMetadata(metadata("a", "1"))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, _ := io.ReadAll(body)
+	if want := `{"name":"Europe","metadata":{"a":"1"}}`; string(b) != want {
+		t.Errorf("JSONBody() = %s, want %s", b, want)
+	}
+}
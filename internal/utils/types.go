@@ -1,10 +1,17 @@
 package utils
 
 import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"math/big"
+	"time"
 )
 
+// numberPrecision is the precision Terraform parses numbers with.
+const numberPrecision = 512
+
 func ConvertToStringSlice(slice []types.String) []string {
 	if slice == nil {
 		return nil
@@ -39,6 +46,55 @@ func ConvertToTerraformStringSlice(input []string) []types.String {
 	return result
 }
 
+// ConvertToTerraformStringSet converts a slice to a set value, for computed
+// set attributes whose plan value may be unknown.
+func ConvertToTerraformStringSet(input []string) types.Set {
+	elements := make([]attr.Value, len(input))
+	for i, v := range input {
+		elements[i] = types.StringValue(v)
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// ConvertToPointerBool returns nil for a null or unknown value, so an
+// Optional+Computed attribute left out of the configuration keeps the value
+// of the server.
+func ConvertToPointerBool(b types.Bool) *bool {
+	if b.IsUnknown() || b.IsNull() {
+		return nil
+	}
+	v := b.ValueBool()
+	return &v
+}
+
+// ConvertToPointerString returns nil for a null or unknown value, like
+// ConvertToPointerBool.
+func ConvertToPointerString(s types.String) *string {
+	if s.IsUnknown() || s.IsNull() {
+		return nil
+	}
+	v := s.ValueString()
+	return &v
+}
+
+// ConvertToTerraformOptionalString converts a string of the API that is
+// cleared by setting it to an empty string. An empty string is null, unless
+// the prior value is an empty string too.
+func ConvertToTerraformOptionalString(prior types.String, remote *string) types.String {
+	if remote != nil && *remote != "" {
+		return types.StringValue(*remote)
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && prior.ValueString() == "" {
+		return prior
+	}
+	return types.StringNull()
+}
+
+// ConvertToTerraformTime formats a timestamp of the API as RFC 3339.
+func ConvertToTerraformTime(t time.Time) types.String {
+	return types.StringValue(t.UTC().Format(time.RFC3339Nano))
+}
+
 func ConvertToFloat32(n types.Number) float32 {
 	if n.IsUnknown() || n.IsNull() {
 		return 0.0
@@ -57,6 +113,37 @@ func ConvertToPointerFloat32(n types.Number) *float32 {
 	return &floatVal
 }
 
-func ConvertToTerraformNumber(value float32) types.Number {
-	return types.NumberValue(big.NewFloat(float64(value)))
+// ConvertToJSONNumber formats a number as the decimal Terraform parsed, so
+// it is sent to the API without being rounded to a float.
+func ConvertToJSONNumber(n types.Number) json.Number {
+	if n.IsUnknown() || n.IsNull() {
+		return "0"
+	}
+	return json.Number(n.ValueBigFloat().Text('f', -1))
+}
+
+func ConvertToPointerJSONNumber(n types.Number) *json.Number {
+	if n.IsUnknown() || n.IsNull() {
+		return nil
+	}
+	value := ConvertToJSONNumber(n)
+	return &value
+}
+
+// ConvertToTerraformNumber parses a decimal read from the API, so a tax rate
+// of 12.345 is stored as 12.345. Medusa v1 keeps tax rates as a real, so the
+// prior value is kept when the API returned it rounded to a float32, as for a
+// tax rate of 12.3456789.
+func ConvertToTerraformNumber(prior types.Number, value json.Number) (types.Number, error) {
+	f, _, err := big.ParseFloat(value.String(), 10, numberPrecision, big.ToNearestEven)
+	if err != nil {
+		return types.NumberNull(), fmt.Errorf("invalid number %q: %w", value, err)
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		remote, _ := f.Float32()
+		if ConvertToFloat32(prior) == remote {
+			return prior, nil
+		}
+	}
+	return types.NumberValue(f), nil
 }
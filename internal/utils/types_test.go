@@ -0,0 +1,283 @@
+package utils
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// number parses a decimal the way Terraform parses numbers in configuration.
+func number(t *testing.T, decimal string) types.Number {
+	t.Helper()
+
+	f, _, err := big.ParseFloat(decimal, 10, numberPrecision, big.ToNearestEven)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.NumberValue(f)
+}
+
+func TestConvertToStringSlice(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []types.String
+		want  []string
+	}{
+		{"nil", nil, nil},
+		{"empty", []types.String{}, []string{}},
+		{"values", []types.String{types.StringValue("nl"), types.StringValue("")}, []string{"nl", ""}},
+		{"null element", []types.String{types.StringNull()}, []string{""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertToStringSlice(tt.input); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ConvertToStringSlice() = %#v, want %#v", got, tt.want)
+			}
+
+			got := ConvertToPointerStringSlice(tt.input)
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("ConvertToPointerStringSlice() = %#v, want nil", *got)
+				}
+				return
+			}
+			if got == nil || !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("ConvertToPointerStringSlice() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStringSliceRoundTrip(t *testing.T) {
+	roundTrip := func(input []string) bool {
+		got := ConvertToStringSlice(ConvertToTerraformStringSlice(input))
+		return len(got) == len(input) && (len(input) == 0 || reflect.DeepEqual(got, input))
+	}
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestConvertToTerraformStringSet(t *testing.T) {
+	got := ConvertToTerraformStringSet([]string{"manual", "stripe"})
+	want := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("stripe"), types.StringValue("manual")})
+	if !got.Equal(want) {
+		t.Errorf("ConvertToTerraformStringSet() = %s, want %s", got, want)
+	}
+
+	if empty := ConvertToTerraformStringSet(nil); empty.IsNull() || len(empty.Elements()) != 0 {
+		t.Errorf("ConvertToTerraformStringSet(nil) = %s, want an empty set", empty)
+	}
+}
+
+func TestConvertToPointerBool(t *testing.T) {
+	if got := ConvertToPointerBool(types.BoolUnknown()); got != nil {
+		t.Errorf("ConvertToPointerBool(unknown) = %v, want nil", *got)
+	}
+	if got := ConvertToPointerBool(types.BoolNull()); got != nil {
+		t.Errorf("ConvertToPointerBool(null) = %v, want nil", *got)
+	}
+	if got := ConvertToPointerBool(types.BoolValue(false)); got == nil || *got {
+		t.Errorf("ConvertToPointerBool(false) = %v, want false", got)
+	}
+}
+
+func TestConvertToPointerString(t *testing.T) {
+	if got := ConvertToPointerString(types.StringUnknown()); got != nil {
+		t.Errorf("ConvertToPointerString(unknown) = %q, want nil", *got)
+	}
+	if got := ConvertToPointerString(types.StringNull()); got != nil {
+		t.Errorf("ConvertToPointerString(null) = %q, want nil", *got)
+	}
+	if got := ConvertToPointerString(types.StringValue("")); got == nil || *got != "" {
+		t.Errorf("ConvertToPointerString(\"\") = %v, want an empty string", got)
+	}
+}
+
+func TestConvertToTerraformOptionalString(t *testing.T) {
+	empty, value := "", "a"
+	tests := []struct {
+		name   string
+		prior  types.String
+		remote *string
+		want   types.String
+	}{
+		{"set", types.StringNull(), &value, types.StringValue("a")},
+		{"missing", types.StringValue("a"), nil, types.StringNull()},
+		{"cleared", types.StringNull(), &empty, types.StringNull()},
+		{"cleared when unknown", types.StringUnknown(), &empty, types.StringNull()},
+		{"configured empty", types.StringValue(""), &empty, types.StringValue("")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertToTerraformOptionalString(tt.prior, tt.remote); !got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertToTerraformTime(t *testing.T) {
+	at := time.Date(2024, 3, 1, 12, 30, 0, 500, time.FixedZone("CET", 3600))
+	if got := ConvertToTerraformTime(at); got.ValueString() != "2024-03-01T11:30:00.0000005Z" {
+		t.Errorf("ConvertToTerraformTime() = %s", got)
+	}
+}
+
+func TestConvertToFloat32(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   types.Number
+		want    float32
+		wantNil bool
+	}{
+		{"null", types.NumberNull(), 0, true},
+		{"unknown", types.NumberUnknown(), 0, true},
+		{"zero", number(t, "0"), 0, false},
+		{"integer", number(t, "21"), 21, false},
+		{"decimal", number(t, "12.345"), 12.345, false},
+		{"maximum tax rate", number(t, "100"), 100, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertToFloat32(tt.input); got != tt.want {
+				t.Errorf("ConvertToFloat32() = %v, want %v", got, tt.want)
+			}
+
+			got := ConvertToPointerFloat32(tt.input)
+			if tt.wantNil != (got == nil) {
+				t.Fatalf("ConvertToPointerFloat32() = %v, want nil %v", got, tt.wantNil)
+			}
+			if got != nil && *got != tt.want {
+				t.Errorf("ConvertToPointerFloat32() = %v, want %v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertToJSONNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   types.Number
+		want    json.Number
+		wantNil bool
+	}{
+		{"null", types.NumberNull(), "0", true},
+		{"unknown", types.NumberUnknown(), "0", true},
+		{"zero", number(t, "0"), "0", false},
+		{"integer", number(t, "21"), "21", false},
+		{"decimal", number(t, "12.345"), "12.345", false},
+		{"more digits than a float32", number(t, "12.3456789"), "12.3456789", false},
+		{"more digits than a float64", number(t, "33.33333333333333333"), "33.33333333333333333", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ConvertToJSONNumber(tt.input); got != tt.want {
+				t.Errorf("ConvertToJSONNumber() = %s, want %s", got, tt.want)
+			}
+
+			got := ConvertToPointerJSONNumber(tt.input)
+			if tt.wantNil != (got == nil) {
+				t.Fatalf("ConvertToPointerJSONNumber() = %v, want nil %v", got, tt.wantNil)
+			}
+			if got != nil && *got != tt.want {
+				t.Errorf("ConvertToPointerJSONNumber() = %s, want %s", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertToTerraformNumber(t *testing.T) {
+	tests := []struct {
+		prior types.Number
+		input json.Number
+		want  string
+	}{
+		{types.NumberNull(), "0", "0"},
+		{types.NumberNull(), "21", "21"},
+		{types.NumberNull(), "12.345", "12.345"},
+		{types.NumberNull(), "0.1", "0.1"},
+		{types.NumberNull(), "12.3456789", "12.3456789"},
+		{types.NumberUnknown(), "12.345", "12.345"},
+		{number(t, "12.3456789"), "12.345679", "12.3456789"},
+		{number(t, "33.333333333333"), "33.333332", "33.333333333333"},
+		{number(t, "12.3456789"), "12.5", "12.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got, err := ConvertToTerraformNumber(tt.prior, tt.input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := number(t, tt.want); !got.Equal(want) {
+				t.Errorf("ConvertToTerraformNumber(%s, %s) = %s, want %s", tt.prior, tt.input, got, want)
+			}
+		})
+	}
+
+	if _, err := ConvertToTerraformNumber(types.NumberNull(), "twelve"); err == nil {
+		t.Error("expected an error for an invalid number")
+	}
+}
+
+// TestNumberRoundTrip checks that a value read from the API is sent back
+// unchanged.
+func TestNumberRoundTrip(t *testing.T) {
+	roundTrip := func(value float64) bool {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return true
+		}
+		decimal := json.Number(strconv.FormatFloat(value, 'f', -1, 64))
+		n, err := ConvertToTerraformNumber(types.NumberNull(), decimal)
+		return err == nil && ConvertToJSONNumber(n) == decimal
+	}
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// FuzzTaxRateRoundTrip checks that a tax rate written in the configuration
+// with up to three decimals is sent as written, and stored unchanged after
+// Medusa returns it from its real column.
+func FuzzTaxRateRoundTrip(f *testing.F) {
+	for _, seed := range []uint32{0, 1, 12345, 21000, 99999, 100000} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, thousandths uint32) {
+		thousandths %= 100001
+		decimal := strconv.FormatFloat(float64(thousandths)/1000, 'f', -1, 64)
+
+		planned := number(t, decimal)
+		sent := ConvertToJSONNumber(planned)
+		if sent.String() != decimal {
+			t.Errorf("tax rate %s was sent as %s", decimal, sent)
+		}
+
+		stored, err := sent.Float64()
+		if err != nil {
+			t.Fatal(err)
+		}
+		remote := json.Number(strconv.FormatFloat(float64(float32(stored)), 'f', -1, 32))
+		state, err := ConvertToTerraformNumber(types.NumberNull(), remote)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !state.Equal(planned) {
+			t.Errorf("tax rate %s became %s", planned, state)
+		}
+	})
+}
@@ -0,0 +1,17 @@
+package validators
+
+import "regexp"
+
+// handlePattern matches the URL safe handles Medusa derives from names:
+// lower case letters and digits, in words separated by single hyphens.
+var handlePattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// HandleFormat describes the handles IsHandle accepts.
+const HandleFormat = "a handle of lower case letters and digits, in words separated by single hyphens"
+
+// IsHandle reports whether value is a handle, such as summer-sale. Medusa
+// itself accepts any handle, so objects created outside of Terraform may
+// have handles that are not.
+func IsHandle(value string) bool {
+	return handlePattern.MatchString(value)
+}
@@ -0,0 +1,30 @@
+package validators
+
+import "testing"
+
+func TestIsHandle(t *testing.T) {
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{"shoes", true},
+		{"summer-sale-2024", true},
+		{"2024", true},
+		{"", false},
+		{"Shoes", false},
+		{"summer sale", false},
+		{"summer_sale", false},
+		{"-summer", false},
+		{"summer-", false},
+		{"summer--sale", false},
+		{"schoenen-größe", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := IsHandle(tt.value); got != tt.want {
+				t.Errorf("expected %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Package validators holds the attribute validators shared by the
+// resources, so that invalid values are reported on plan instead of by
+// Medusa on apply.
+package validators
+
+import (
+	"bufio"
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	//go:embed data/iso4217.txt
+	iso4217 string
+	//go:embed data/iso3166-1.txt
+	iso3166 string
+
+	currencies = parseTable(iso4217)
+	countries  = parseTable(iso3166)
+)
+
+// parseTable parses a table of codes and names separated by a tab, one per
+// line, into names by upper case code. Lines starting with # are comments.
+func parseTable(table string) map[string]string {
+	codes := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(table))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		code, name, _ := strings.Cut(line, "\t")
+		codes[code] = name
+	}
+	return codes
+}
+
+var _ validator.String = codeValidator{}
+
+// codeValidator validates that a string is one of the codes of a table.
+// The case of the value is ignored: Medusa stores codes in lower case, and
+// the resources keep the case of the configuration when it only differs in
+// case from the value Medusa returns.
+type codeValidator struct {
+	standard string
+	codes    map[string]string
+}
+
+// CurrencyCode returns a validator which ensures that a string is an ISO
+// 4217 currency code, such as eur or USD.
+func CurrencyCode() validator.String {
+	return codeValidator{standard: "ISO 4217 currency code", codes: currencies}
+}
+
+// CountryCode returns a validator which ensures that a string is an ISO
+// 3166-1 alpha-2 country code, such as nl or GB.
+func CountryCode() validator.String {
+	return codeValidator{standard: "ISO 3166-1 alpha-2 country code", codes: countries}
+}
+
+// CurrencyCodeSet returns a validator which ensures that every element of a
+// set is an ISO 4217 currency code.
+func CurrencyCodeSet() validator.Set {
+	return setvalidator.ValueStringsAre(CurrencyCode())
+}
+
+// CurrencyCodeList returns a validator which ensures that every element of a
+// list is an ISO 4217 currency code.
+func CurrencyCodeList() validator.List {
+	return listvalidator.ValueStringsAre(CurrencyCode())
+}
+
+// CountryCodeSet returns a validator which ensures that every element of a
+// set is an ISO 3166-1 alpha-2 country code.
+func CountryCodeSet() validator.Set {
+	return setvalidator.ValueStringsAre(CountryCode())
+}
+
+// CountryCodeList returns a validator which ensures that every element of a
+// list is an ISO 3166-1 alpha-2 country code.
+func CountryCodeList() validator.List {
+	return listvalidator.ValueStringsAre(CountryCode())
+}
+
+func (v codeValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be an %s", v.standard)
+}
+
+func (v codeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v codeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if trimmed := strings.TrimSpace(value); trimmed != value {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got %q, which has leading or trailing whitespace. Use %q instead.",
+				req.Path, v.Description(ctx), value, trimmed),
+		)
+		return
+	}
+
+	if _, ok := v.codes[strings.ToUpper(value)]; !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got %q.", req.Path, v.Description(ctx), value),
+		)
+	}
+}
@@ -0,0 +1,95 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTables(t *testing.T) {
+	if len(currencies) < 150 || len(countries) != 249 {
+		t.Fatalf("unexpected table sizes: %d currencies, %d countries", len(currencies), len(countries))
+	}
+	for code := range currencies {
+		if len(code) != 3 || strings.ToUpper(code) != code {
+			t.Errorf("invalid currency code %q", code)
+		}
+	}
+	for code := range countries {
+		if len(code) != 2 || strings.ToUpper(code) != code {
+			t.Errorf("invalid country code %q", code)
+		}
+	}
+}
+
+func TestCodeValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		wantErr   string
+	}{
+		{"currency", CurrencyCode(), types.StringValue("eur"), ""},
+		{"currency upper case", CurrencyCode(), types.StringValue("USD"), ""},
+		{"currency whitespace", CurrencyCode(), types.StringValue("eur "), `Use "eur" instead`},
+		{"currency unknown code", CurrencyCode(), types.StringValue("eru"), "ISO 4217 currency code"},
+		{"currency symbol", CurrencyCode(), types.StringValue("€"), "ISO 4217 currency code"},
+		{"country", CountryCode(), types.StringValue("nl"), ""},
+		{"country upper case", CountryCode(), types.StringValue("GB"), ""},
+		{"country not alpha-2", CountryCode(), types.StringValue("UK"), "ISO 3166-1 alpha-2 country code"},
+		{"country alpha-3", CountryCode(), types.StringValue("nld"), "ISO 3166-1 alpha-2 country code"},
+		{"null", CountryCode(), types.StringNull(), ""},
+		{"unknown", CountryCode(), types.StringUnknown(), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := validator.StringResponse{}
+			tt.validator.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("code"),
+				ConfigValue: tt.value,
+			}, &resp)
+
+			var got string
+			for _, d := range resp.Diagnostics.Errors() {
+				got += d.Detail()
+			}
+			if (tt.wantErr == "") != (got == "") || !strings.Contains(got, tt.wantErr) {
+				t.Errorf("expected error %q, got %q", tt.wantErr, got)
+			}
+		})
+	}
+}
+
+func TestCodeSetValidators(t *testing.T) {
+	set := func(codes ...string) types.Set {
+		elements := make([]attr.Value, len(codes))
+		for i, code := range codes {
+			elements[i] = types.StringValue(code)
+		}
+		return types.SetValueMust(types.StringType, elements)
+	}
+
+	resp := validator.SetResponse{}
+	CountryCodeSet().ValidateSet(context.Background(), validator.SetRequest{
+		Path:        path.Root("countries"),
+		ConfigValue: set("nl", "UK", "BE"),
+	}, &resp)
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Errorf("expected an error for UK only, got %v", resp.Diagnostics)
+	}
+
+	resp = validator.SetResponse{}
+	CurrencyCodeSet().ValidateSet(context.Background(), validator.SetRequest{
+		Path:        path.Root("currencies"),
+		ConfigValue: set("eur", "USD"),
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("expected no errors, got %v", resp.Diagnostics)
+	}
+}
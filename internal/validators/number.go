@@ -0,0 +1,46 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.Number = numberBetweenValidator{}
+
+// numberBetweenValidator validates that a number is in a closed range.
+type numberBetweenValidator struct {
+	min, max *big.Float
+}
+
+// NumberBetween returns a validator which ensures that a number is at least
+// min and at most max. The number may be a decimal, unlike the values
+// checked by the float64 and int64 validators.
+func NumberBetween(min, max float64) validator.Number {
+	return numberBetweenValidator{min: big.NewFloat(min), max: big.NewFloat(max)}
+}
+
+func (v numberBetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %s and %s", v.min.Text('g', -1), v.max.Text('g', -1))
+}
+
+func (v numberBetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v numberBetweenValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueBigFloat()
+	if value.Cmp(v.min) < 0 || value.Cmp(v.max) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value.Text('g', -1)),
+		)
+	}
+}
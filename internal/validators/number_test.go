@@ -0,0 +1,43 @@
+package validators
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNumberBetween(t *testing.T) {
+	tests := []struct {
+		value   string
+		wantErr bool
+	}{
+		{"0", false},
+		{"21", false},
+		{"12.5", false},
+		{"100", false},
+		{"-0.5", true},
+		{"100.01", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			f, _, err := big.ParseFloat(tt.value, 10, 512, big.ToNearestEven)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp := validator.NumberResponse{}
+			NumberBetween(0, 100).ValidateNumber(context.Background(), validator.NumberRequest{
+				Path:        path.Root("tax_rate"),
+				ConfigValue: types.NumberValue(f),
+			}, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("expected error %t, got %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -27,7 +27,7 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), func() provider.Provider {
 		var options = []internal.OptionFunc{
-			//We allow 10 retries of a failed request
+			//We allow 10 retries of a failed request unless max_retries is configured
 			internal.WithRetryableClient(10),
 			internal.WithDebugClient(),
 		}